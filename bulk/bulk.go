@@ -0,0 +1,296 @@
+// Package bulk runs many Harvest API calls, such as importing a month of time
+// entries or archiving hundreds of clients, with bounded concurrency.
+//
+// Operations wrap calls to any service of a harvest.APIClient. The Executor
+// runs them on a fixed number of workers, keeps the request rate within
+// Harvest's quota, retries calls that were rate limited anyway, and reports a
+// result per operation plus a summary:
+//
+//	ops := make([]bulk.Operation, 0, len(clients))
+//	for _, c := range clients {
+//		ops = append(ops, bulk.Op(strconv.FormatInt(c.GetID(), 10),
+//			func(ctx context.Context) (*harvest.Client, *http.Response, error) {
+//				return service.Client.Update(ctx, c.GetID(), &harvest.ClientUpdateRequest{IsActive: harvest.Bool(false)})
+//			}))
+//	}
+//
+//	report, err := bulk.New(bulk.WithCheckpoint(bulk.FileCheckpoint("archive.checkpoint"))).Run(ctx, ops)
+//
+// With a checkpoint, operations that completed in an earlier run are skipped,
+// so an interrupted run can be resumed by running the same operations again.
+package bulk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/becoded/go-harvest/harvest"
+)
+
+// DefaultConcurrency is the number of operations run at the same time.
+const DefaultConcurrency = 4
+
+// DefaultMaxRetries is the number of times a rate-limited call is retried.
+const DefaultMaxRetries = 3
+
+// An Operation is a single API call of a bulk run.
+type Operation struct {
+	// Key identifies the operation in results and checkpoints. It must be
+	// unique within a run and stable across runs to resume. Defaults to the
+	// index of the operation.
+	Key string
+	// Call makes the API call and returns the decoded response.
+	Call func(ctx context.Context) (interface{}, *http.Response, error)
+}
+
+// Op returns an Operation for a service method that returns a value, such as
+// a create or update.
+func Op[T any](key string, call func(ctx context.Context) (T, *http.Response, error)) Operation {
+	return Operation{
+		Key: key,
+		Call: func(ctx context.Context) (interface{}, *http.Response, error) {
+			return call(ctx)
+		},
+	}
+}
+
+// DeleteOp returns an Operation for a service method that only returns a
+// response, such as a delete.
+func DeleteOp(key string, call func(ctx context.Context) (*http.Response, error)) Operation {
+	return Operation{
+		Key: key,
+		Call: func(ctx context.Context) (interface{}, *http.Response, error) {
+			resp, err := call(ctx)
+
+			return nil, resp, err
+		},
+	}
+}
+
+// Result is the outcome of one operation.
+type Result struct {
+	Key string
+	// Value is the decoded response of the call.
+	Value    interface{}
+	Response *http.Response
+	Err      error
+	// CheckpointErr is set when the call succeeded but couldn't be recorded
+	// in the checkpoint, so a resumed run would make it again.
+	CheckpointErr error
+	// Skipped is set when the checkpoint recorded the operation as done.
+	Skipped bool
+	// Attempts is the number of calls made, including retries.
+	Attempts int
+}
+
+// Summary counts the results of a run.
+type Summary struct {
+	Total     int
+	Succeeded int
+	Failed    int
+	Skipped   int
+	// Unrecorded counts the succeeded operations with a CheckpointErr.
+	Unrecorded int
+	Elapsed    time.Duration
+}
+
+// Report holds the results of a run, in the order of the operations.
+type Report struct {
+	Results []Result
+	Summary Summary
+}
+
+// Failed returns the results of the operations that failed.
+func (r *Report) Failed() []Result {
+	var failed []Result
+
+	for _, res := range r.Results {
+		if res.Err != nil {
+			failed = append(failed, res)
+		}
+	}
+
+	return failed
+}
+
+// Executor runs operations. Create one with New.
+type Executor struct {
+	concurrency int
+	maxRetries  int
+	rateLimit   int
+	rateWindow  time.Duration
+	checkpoint  Checkpoint
+}
+
+// Option configures an Executor.
+type Option func(*Executor)
+
+// WithConcurrency sets the number of operations run at the same time.
+func WithConcurrency(n int) Option {
+	return func(e *Executor) {
+		e.concurrency = max(n, 1)
+	}
+}
+
+// WithRateLimit sets the request quota to stay within. Lower it when other
+// clients share the same account.
+func WithRateLimit(requests int, window time.Duration) Option {
+	return func(e *Executor) {
+		e.rateLimit = requests
+		e.rateWindow = window
+	}
+}
+
+// WithMaxRetries sets the number of times a rate-limited call is retried.
+func WithMaxRetries(n int) Option {
+	return func(e *Executor) {
+		e.maxRetries = n
+	}
+}
+
+// WithCheckpoint records completed operations in cp and skips the ones it
+// already holds.
+func WithCheckpoint(cp Checkpoint) Option {
+	return func(e *Executor) {
+		e.checkpoint = cp
+	}
+}
+
+// New returns an Executor.
+func New(opts ...Option) *Executor {
+	e := &Executor{
+		concurrency: DefaultConcurrency,
+		maxRetries:  DefaultMaxRetries,
+		rateLimit:   harvest.DefaultRateLimit,
+		rateWindow:  harvest.DefaultRateWindow,
+	}
+
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	return e
+}
+
+// Run runs ops and reports their results. Failing operations don't stop the
+// run; their errors are in the results. Run only returns an error when the
+// checkpoint can't be loaded. If ctx is canceled, the operations that didn't
+// start yet fail with the context's error.
+func (e *Executor) Run(ctx context.Context, ops []Operation) (*Report, error) {
+	start := time.Now()
+
+	done := map[string]bool{}
+
+	if e.checkpoint != nil {
+		keys, err := e.checkpoint.Completed(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, k := range keys {
+			done[k] = true
+		}
+	}
+
+	report := &Report{Results: make([]Result, len(ops))}
+	limiter := newLimiter(e.rateLimit, e.rateWindow)
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+
+	for range min(e.concurrency, len(ops)) {
+		wg.Go(func() {
+			for i := range jobs {
+				report.Results[i] = e.run(ctx, limiter, ops[i], opKey(ops[i], i))
+			}
+		})
+	}
+
+	for i, op := range ops {
+		key := opKey(op, i)
+		if done[key] {
+			report.Results[i] = Result{Key: key, Skipped: true}
+
+			continue
+		}
+
+		jobs <- i
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	report.Summary = summarize(report.Results)
+	report.Summary.Elapsed = time.Since(start)
+
+	return report, nil
+}
+
+func opKey(op Operation, i int) string {
+	if op.Key != "" {
+		return op.Key
+	}
+
+	return strconv.Itoa(i)
+}
+
+// run calls op, retrying while it is rate limited, and records it in the
+// checkpoint when it succeeds. Each call's context carries its attempt, see
+// harvest.Attempt.
+func (e *Executor) run(ctx context.Context, limiter *limiter, op Operation, key string) Result {
+	res := Result{Key: key}
+
+	for {
+		if err := limiter.wait(ctx); err != nil {
+			res.Err = err
+
+			return res
+		}
+
+		res.Attempts++
+		res.Value, res.Response, res.Err = op.Call(harvest.WithAttempt(ctx, res.Attempts))
+
+		var rateErr *harvest.AbuseRateLimitError
+		if !errors.As(res.Err, &rateErr) || res.Attempts > e.maxRetries {
+			break
+		}
+
+		wait := e.rateWindow
+		if rateErr.RetryAfter != nil {
+			wait = *rateErr.RetryAfter
+		}
+
+		limiter.pause(wait)
+	}
+
+	if res.Err == nil && e.checkpoint != nil {
+		res.CheckpointErr = e.checkpoint.MarkCompleted(ctx, key)
+	}
+
+	return res
+}
+
+func summarize(results []Result) Summary {
+	s := Summary{Total: len(results)}
+
+	for _, res := range results {
+		switch {
+		case res.Skipped:
+			s.Skipped++
+		case res.Err != nil:
+			s.Failed++
+		default:
+			s.Succeeded++
+
+			if res.CheckpointErr != nil {
+				s.Unrecorded++
+			}
+		}
+	}
+
+	return s
+}
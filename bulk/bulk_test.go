@@ -0,0 +1,208 @@
+package bulk_test
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/becoded/go-harvest/bulk"
+	"github.com/becoded/go-harvest/harvest"
+	"github.com/becoded/go-harvest/harvesttest"
+)
+
+func createClients(service *harvest.APIClient, names ...string) []bulk.Operation {
+	ops := make([]bulk.Operation, 0, len(names))
+
+	for _, name := range names {
+		ops = append(ops, bulk.Op(name, func(ctx context.Context) (*harvest.Client, *http.Response, error) {
+			return service.Client.Create(ctx, &harvest.ClientCreateRequest{Name: harvest.String(name)})
+		}))
+	}
+
+	return ops
+}
+
+func TestExecutor_Run(t *testing.T) {
+	t.Parallel()
+
+	srv := harvesttest.NewServer()
+	defer srv.Close()
+
+	service := srv.Client()
+	ops := createClients(service, "Acme", "Globex", "", "Initech", "Umbrella")
+
+	old := srv.AddClient(&harvest.Client{Name: harvest.String("Old")})
+	ops = append(ops, bulk.DeleteOp("", func(ctx context.Context) (*http.Response, error) {
+		return service.Client.Delete(ctx, old.GetID())
+	}))
+
+	report, err := bulk.New(bulk.WithConcurrency(3)).Run(context.Background(), ops)
+	assert.NoError(t, err)
+	assert.Equal(t, bulk.Summary{Total: 6, Succeeded: 5, Failed: 1, Elapsed: report.Summary.Elapsed}, report.Summary)
+
+	assert.Equal(t, "Acme", report.Results[0].Value.(*harvest.Client).GetName())
+	assert.Equal(t, http.StatusCreated, report.Results[0].Response.StatusCode)
+	assert.Equal(t, "5", report.Results[5].Key, "keys default to the index")
+
+	failed := report.Failed()
+	assert.Len(t, failed, 1)
+	assert.Equal(t, "2", failed[0].Key)
+	assert.ErrorIs(t, failed[0].Err, harvest.ErrValidation)
+
+	list, _, err := service.Client.List(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Len(t, list.Clients, 4)
+}
+
+func TestExecutor_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	srv := harvesttest.NewServer()
+	defer srv.Close()
+
+	window := 100 * time.Millisecond
+	srv.SetRateLimit(2, window)
+
+	names := make([]string, 5)
+	for i := range names {
+		names[i] = "Client " + strconv.Itoa(i)
+	}
+
+	// The executor's window is a little longer to absorb the latency between
+	// starting a call and the server receiving it.
+	exec := bulk.New(
+		bulk.WithConcurrency(5),
+		bulk.WithRateLimit(2, window+50*time.Millisecond),
+		bulk.WithMaxRetries(0),
+	)
+
+	report, err := exec.Run(context.Background(), createClients(srv.Client(), names...))
+	assert.NoError(t, err)
+	assert.Equal(t, 5, report.Summary.Succeeded, "the quota is never exceeded")
+	assert.GreaterOrEqual(t, report.Summary.Elapsed, 2*window)
+}
+
+func TestExecutor_Retry(t *testing.T) {
+	t.Parallel()
+
+	var attempts []int
+
+	retryAfter := time.Millisecond
+	op := bulk.Op("", func(ctx context.Context) (*harvest.Client, *http.Response, error) {
+		attempts = append(attempts, harvest.Attempt(ctx))
+		if len(attempts) == 1 {
+			return nil, nil, &harvest.AbuseRateLimitError{RetryAfter: &retryAfter}
+		}
+
+		return &harvest.Client{}, nil, nil
+	})
+
+	report, err := bulk.New(bulk.WithMaxRetries(1)).Run(context.Background(), []bulk.Operation{op})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.Summary.Succeeded)
+	assert.Equal(t, 2, report.Results[0].Attempts)
+	assert.Equal(t, []int{1, 2}, attempts, "calls carry their attempt")
+}
+
+func TestExecutor_Resume(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		checkpoint bulk.Checkpoint
+	}{
+		{name: "memory", checkpoint: &bulk.MemoryCheckpoint{}},
+		{name: "file", checkpoint: bulk.FileCheckpoint(filepath.Join(t.TempDir(), "run.checkpoint"))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				calls atomic.Int64
+				fail  atomic.Bool
+			)
+
+			fail.Store(true)
+
+			ops := make([]bulk.Operation, 4)
+			for i := range ops {
+				ops[i] = bulk.Op("op-"+strconv.Itoa(i), func(context.Context) (int, *http.Response, error) {
+					calls.Add(1)
+
+					if i == 2 && fail.Load() {
+						return 0, nil, context.DeadlineExceeded
+					}
+
+					return i, nil, nil
+				})
+			}
+
+			exec := bulk.New(bulk.WithCheckpoint(tt.checkpoint))
+
+			report, err := exec.Run(context.Background(), ops)
+			assert.NoError(t, err)
+			assert.Equal(t, 3, report.Summary.Succeeded)
+			assert.Equal(t, 1, report.Summary.Failed)
+
+			fail.Store(false)
+
+			report, err = exec.Run(context.Background(), ops)
+			assert.NoError(t, err)
+			assert.Equal(t, 3, report.Summary.Skipped)
+			assert.Equal(t, 1, report.Summary.Succeeded)
+			assert.Equal(t, 2, report.Results[2].Value)
+			assert.Equal(t, int64(5), calls.Load(), "only the failed operation is repeated")
+		})
+	}
+}
+
+// brokenCheckpoint fails to record anything.
+type brokenCheckpoint struct{}
+
+func (brokenCheckpoint) Completed(context.Context) ([]string, error) { return nil, nil }
+
+func (brokenCheckpoint) MarkCompleted(context.Context, string) error { return os.ErrPermission }
+
+func TestExecutor_CheckpointFailure(t *testing.T) {
+	t.Parallel()
+
+	ops := []bulk.Operation{
+		bulk.Op("created", func(context.Context) (int, *http.Response, error) { return 7, nil, nil }),
+	}
+
+	report, err := bulk.New(bulk.WithCheckpoint(brokenCheckpoint{})).Run(context.Background(), ops)
+	assert.NoError(t, err)
+
+	res := report.Results[0]
+	assert.NoError(t, res.Err, "the call succeeded")
+	assert.Equal(t, 7, res.Value)
+	assert.ErrorIs(t, res.CheckpointErr, os.ErrPermission)
+	assert.Equal(t, 1, report.Summary.Succeeded)
+	assert.Equal(t, 1, report.Summary.Unrecorded)
+	assert.Empty(t, report.Failed())
+}
+
+func TestExecutor_Canceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	ops := []bulk.Operation{
+		bulk.Op("", func(context.Context) (int, *http.Response, error) { return 1, nil, nil }),
+	}
+
+	report, err := bulk.New().Run(ctx, ops)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, report.Results[0].Err, context.Canceled)
+	assert.Equal(t, 0, report.Results[0].Attempts)
+}
@@ -0,0 +1,94 @@
+package bulk
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"sync"
+)
+
+// A Checkpoint stores the keys of completed operations, so that a run can be
+// resumed without repeating them.
+type Checkpoint interface {
+	// Completed returns the keys recorded so far.
+	Completed(ctx context.Context) ([]string, error)
+	// MarkCompleted records key. It is called concurrently.
+	MarkCompleted(ctx context.Context, key string) error
+}
+
+// MemoryCheckpoint keeps completed keys in memory. It resumes runs within the
+// same process.
+type MemoryCheckpoint struct {
+	mu   sync.Mutex
+	keys []string
+}
+
+// Completed implements Checkpoint.
+func (c *MemoryCheckpoint) Completed(context.Context) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]string(nil), c.keys...), nil
+}
+
+// MarkCompleted implements Checkpoint.
+func (c *MemoryCheckpoint) MarkCompleted(_ context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.keys = append(c.keys, key)
+
+	return nil
+}
+
+// FileCheckpoint is a Checkpoint that appends completed keys to the file at
+// the path, one per line. The file is created when the first key is
+// recorded; a missing file means nothing was completed yet.
+type FileCheckpoint string
+
+var fileCheckpointMu sync.Mutex //nolint: gochecknoglobals
+
+// Completed implements Checkpoint.
+func (c FileCheckpoint) Completed(context.Context) ([]string, error) {
+	f, err := os.Open(string(c))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var keys []string
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if line := scanner.Text(); line != "" {
+			keys = append(keys, line)
+		}
+	}
+
+	return keys, scanner.Err()
+}
+
+// MarkCompleted implements Checkpoint.
+func (c FileCheckpoint) MarkCompleted(_ context.Context, key string) error {
+	fileCheckpointMu.Lock()
+	defer fileCheckpointMu.Unlock()
+
+	f, err := os.OpenFile(string(c), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600) //nolint: mnd
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.WriteString(key + "\n"); err != nil {
+		_ = f.Close()
+
+		return err
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,87 @@
+package bulk
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// limiter blocks callers so that at most limit calls start within any window.
+type limiter struct {
+	mu     sync.Mutex
+	limit  int
+	window time.Duration
+	sent   []time.Time
+	paused time.Time
+}
+
+func newLimiter(limit int, window time.Duration) *limiter {
+	return &limiter{limit: limit, window: window}
+}
+
+// wait blocks until a call may start, or ctx is done.
+func (l *limiter) wait(ctx context.Context) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		delay := l.reserve(time.Now())
+		if delay <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve records a call at now if the quota allows it, and otherwise
+// returns how long to wait before trying again.
+func (l *limiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Before(l.paused) {
+		return l.paused.Sub(now)
+	}
+
+	if l.limit <= 0 {
+		return 0
+	}
+
+	cutoff := now.Add(-l.window)
+
+	n := 0
+	for n < len(l.sent) && !l.sent[n].After(cutoff) {
+		n++
+	}
+
+	l.sent = l.sent[n:]
+
+	if len(l.sent) >= l.limit {
+		return l.sent[0].Sub(cutoff)
+	}
+
+	l.sent = append(l.sent, now)
+
+	return 0
+}
+
+// pause holds back all calls for d, after the API reported the quota as
+// used up.
+func (l *limiter) pause(d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if until := time.Now().Add(d); until.After(l.paused) {
+		l.paused = until
+	}
+}
@@ -0,0 +1,307 @@
+package export
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/becoded/go-harvest/harvest"
+)
+
+// perPage is the page size used when the list options don't set one.
+const perPage = 2000
+
+// TimeEntries writes the time entries matching opt to w as CSV, fetching
+// them a page at a time, and returns the number of rows written. opt may be
+// nil.
+func (e *Exporter) TimeEntries(ctx context.Context, w io.Writer, opt *harvest.TimeEntryListOptions) (int, error) {
+	f, err := e.prepare(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return writeCSV(e, w, e.timeEntries(ctx, opt), func(t *harvest.TimeEntry) []string {
+		return e.row(f, timeEntryFields(t))
+	})
+}
+
+// timeEntries returns a function that lists a page of the time entries
+// matching opt.
+func (e *Exporter) timeEntries(
+	ctx context.Context,
+	opt *harvest.TimeEntryListOptions,
+) func(page int) ([]*harvest.TimeEntry, *int, error) {
+	o := harvest.TimeEntryListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	o.ListOptions = withPerPage(o.ListOptions)
+
+	return func(page int) ([]*harvest.TimeEntry, *int, error) {
+		o.Page = page
+
+		list, _, err := e.client.Timesheet.List(ctx, &o)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return list.TimeEntries, list.NextPage, nil
+	}
+}
+
+// Expenses writes the expenses matching opt to w as CSV, fetching them a page
+// at a time, and returns the number of rows written. opt may be nil.
+func (e *Exporter) Expenses(ctx context.Context, w io.Writer, opt *harvest.ExpenseListOptions) (int, error) {
+	f, err := e.prepare(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	o := harvest.ExpenseListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	o.ListOptions = withPerPage(o.ListOptions)
+
+	return writeCSV(e, w, func(page int) ([]*harvest.Expense, *int, error) {
+		o.Page = page
+
+		list, _, err := e.client.Expense.List(ctx, &o)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return list.Expenses, list.NextPage, nil
+	}, func(x *harvest.Expense) []string {
+		return e.row(f, expenseFields(x))
+	})
+}
+
+// prepare checks the columns and returns the display settings.
+func (e *Exporter) prepare(ctx context.Context) (*harvest.Company, error) {
+	for _, c := range e.columns {
+		if _, ok := headers[c]; !ok {
+			return nil, fmt.Errorf("%w %q", ErrColumn, c)
+		}
+	}
+
+	return e.company(ctx)
+}
+
+// withPerPage sets the page size of o if it isn't set. All pages are
+// exported, whatever page o starts at.
+func withPerPage(o harvest.ListOptions) harvest.ListOptions {
+	if o.PerPage == 0 {
+		o.PerPage = perPage
+	}
+
+	return o
+}
+
+// writeCSV writes the header and the rows of every page, flushing after each
+// page so that large exports are streamed.
+func writeCSV[T any](
+	e *Exporter,
+	w io.Writer,
+	list func(page int) ([]T, *int, error),
+	row func(T) []string,
+) (int, error) {
+	cw := csv.NewWriter(w)
+
+	if e.header {
+		h := make([]string, len(e.columns))
+		for i, c := range e.columns {
+			h[i] = headers[c]
+		}
+
+		if err := cw.Write(h); err != nil {
+			return 0, err
+		}
+	}
+
+	n := 0
+	err := paginate(list, func(items []T) error {
+		for _, item := range items {
+			if err := cw.Write(row(item)); err != nil {
+				return err
+			}
+
+			n++
+		}
+
+		cw.Flush()
+
+		return cw.Error()
+	})
+	cw.Flush()
+
+	return n, err
+}
+
+// paginate calls fn with the items of each page, starting at page 1.
+func paginate[T any](list func(page int) ([]T, *int, error), fn func(items []T) error) error {
+	page := 1
+
+	for {
+		items, next, err := list(page)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(items); err != nil {
+			return err
+		}
+
+		if next == nil {
+			return nil
+		}
+
+		page = *next
+	}
+}
+
+// fields are the values of a record before formatting. Nil pointers are
+// exported as empty cells.
+type fields struct {
+	date         *harvest.Date
+	client       string
+	project      string
+	task         string
+	user         string
+	notes        string
+	hours        *float64
+	roundedHours *float64
+	billable     bool
+	rate         *float64
+	amount       *float64
+	invoiced     bool
+}
+
+func timeEntryFields(t *harvest.TimeEntry) fields {
+	f := fields{
+		date:     t.SpentDate,
+		client:   t.GetClient().GetName(),
+		project:  t.GetProject().GetName(),
+		task:     t.GetTask().GetName(),
+		user:     userName(t.GetUser()),
+		notes:    t.GetNotes(),
+		billable: t.GetBillable(),
+		rate:     t.BillableRate,
+		invoiced: t.GetIsBilled(),
+	}
+
+	if t.Hours != nil {
+		f.hours = harvest.Float64(t.Hours.Float64())
+	}
+
+	if t.RoundedHours != nil {
+		f.roundedHours = harvest.Float64(t.RoundedHours.Float64())
+	}
+
+	hours := f.roundedHours
+	if hours == nil {
+		hours = f.hours
+	}
+
+	switch {
+	case !f.billable:
+		f.amount = harvest.Float64(0)
+	case f.rate != nil && hours != nil:
+		f.amount = harvest.Float64(*f.rate * *hours)
+	}
+
+	return f
+}
+
+func expenseFields(x *harvest.Expense) fields {
+	return fields{
+		date:     x.SpentDate,
+		client:   x.GetClient().GetName(),
+		project:  x.GetProject().GetName(),
+		task:     x.GetExpenseCategory().GetName(),
+		user:     userName(x.GetUser()),
+		notes:    x.GetNotes(),
+		billable: x.GetBillable(),
+		rate:     x.GetExpenseCategory().UnitPrice,
+		amount:   x.TotalCost,
+		invoiced: x.GetIsBilled(),
+	}
+}
+
+// userName flattens a user: time entries and expenses carry only the full
+// name, users fetched on their own only the first and last name.
+func userName(u *harvest.User) string {
+	if name := u.GetName(); name != "" {
+		return name
+	}
+
+	if u.GetLastName() == "" {
+		return u.GetFirstName()
+	}
+
+	return u.GetFirstName() + " " + u.GetLastName()
+}
+
+func (e *Exporter) row(company *harvest.Company, f fields) []string {
+	number := func(v *float64) string {
+		if v == nil {
+			return ""
+		}
+
+		return company.FormatNumber(*v)
+	}
+
+	hours := func(v *float64) string {
+		if v == nil {
+			return ""
+		}
+
+		return company.FormatHours(harvest.Hours(*v))
+	}
+
+	yesNo := func(b bool) string {
+		if b {
+			return "Yes"
+		}
+
+		return "No"
+	}
+
+	row := make([]string, len(e.columns))
+
+	for i, c := range e.columns {
+		switch c {
+		case Date:
+			if f.date != nil {
+				row[i] = f.date.Format("2006-01-02")
+			}
+		case Client:
+			row[i] = f.client
+		case Project:
+			row[i] = f.project
+		case Task:
+			row[i] = f.task
+		case User:
+			row[i] = f.user
+		case Notes:
+			row[i] = f.notes
+		case Hours:
+			row[i] = hours(f.hours)
+		case RoundedHours:
+			row[i] = hours(f.roundedHours)
+		case Billable:
+			row[i] = yesNo(f.billable)
+		case Rate:
+			row[i] = number(f.rate)
+		case Amount:
+			row[i] = number(f.amount)
+		case Invoiced:
+			row[i] = yesNo(f.invoiced)
+		}
+	}
+
+	return row
+}
@@ -0,0 +1,193 @@
+package export_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/becoded/go-harvest/export"
+	"github.com/becoded/go-harvest/harvest"
+	"github.com/becoded/go-harvest/harvesttest"
+)
+
+func date(s string) *harvest.Date {
+	d, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+
+	return &harvest.Date{Time: d}
+}
+
+func TestExporter_TimeEntries(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := harvesttest.NewServer()
+
+	defer srv.Close()
+
+	srv.SetCompany(&harvest.Company{DecimalSymbol: harvest.String(","), ThousandsSeparator: harvest.String(".")})
+
+	acme := srv.AddClient(&harvest.Client{Name: harvest.String("Acme")})
+	website := srv.AddProject(&harvest.Project{Client: acme, Name: harvest.String("Website")})
+	dev := srv.AddTask(&harvest.Task{Name: harvest.String("Development")})
+	user := &harvest.User{ID: harvest.Int64(1), Name: harvest.String("Jane Doe")}
+
+	srv.AddTimeEntry(&harvest.TimeEntry{
+		SpentDate:    date("2024-03-04"),
+		User:         user,
+		Client:       acme,
+		Project:      website,
+		Task:         dev,
+		Notes:        harvest.String("Homepage, \"hero\" section"),
+		Hours:        harvest.HoursP(1.2),
+		RoundedHours: harvest.HoursP(1.25),
+		Billable:     harvest.Bool(true),
+		BillableRate: harvest.Float64(1000),
+		IsBilled:     harvest.Bool(true),
+	})
+	srv.AddTimeEntry(&harvest.TimeEntry{
+		SpentDate: date("2024-03-05"),
+		User:      user,
+		Client:    acme,
+		Project:   website,
+		Task:      dev,
+		Hours:     harvest.HoursP(0.5),
+		Billable:  harvest.Bool(false),
+	})
+
+	var b strings.Builder
+
+	n, err := export.New(srv.Client()).TimeEntries(ctx, &b, &harvest.TimeEntryListOptions{
+		ListOptions: harvest.ListOptions{PerPage: 1},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	assert.ElementsMatch(t, []string{
+		"Date,Client,Project,Task,User,Notes,Hours,Hours Rounded,Billable?,Billable Rate,Billable Amount,Invoiced?",
+		`2024-03-04,Acme,Website,Development,Jane Doe,"Homepage, ""hero"" section",` +
+			`"1,20","1,25",Yes,"1.000,00","1.250,00",Yes`,
+		`2024-03-05,Acme,Website,Development,Jane Doe,,"0,50",,No,,"0,00",No`,
+	}, lines)
+	assert.Equal(t, "Date,Client,Project,Task,User,Notes,Hours,Hours Rounded,Billable?,Billable Rate,"+
+		"Billable Amount,Invoiced?", lines[0])
+}
+
+func TestExporter_TimeEntries_hoursMinutes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := harvesttest.NewServer()
+
+	defer srv.Close()
+
+	srv.SetCompany(&harvest.Company{TimeFormat: harvest.String(harvest.TimeFormatHoursMinutes)})
+
+	srv.AddTimeEntry(&harvest.TimeEntry{
+		SpentDate:    date("2024-03-04"),
+		Hours:        harvest.HoursP(1.2),
+		RoundedHours: harvest.HoursP(1.25),
+		Billable:     harvest.Bool(true),
+		BillableRate: harvest.Float64(1000),
+	})
+
+	var b strings.Builder
+
+	e := export.New(srv.Client(),
+		export.WithColumns(export.Hours, export.RoundedHours, export.Rate, export.Amount),
+		export.WithHeader(false))
+
+	_, err := e.TimeEntries(ctx, &b, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "1:12,1:15,\"1,000.00\",\"1,250.00\"\n", b.String())
+}
+
+func TestExporter_Expenses(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := harvesttest.NewServer()
+
+	defer srv.Close()
+
+	acme := srv.AddClient(&harvest.Client{Name: harvest.String("Acme")})
+	website := srv.AddProject(&harvest.Project{Client: acme, Name: harvest.String("Website")})
+
+	srv.AddExpense(&harvest.Expense{
+		SpentDate: date("2024-03-04"),
+		User:      &harvest.User{ID: harvest.Int64(1), FirstName: harvest.String("Jane"), LastName: harvest.String("Doe")},
+		Client:    acme,
+		Project:   website,
+		ExpenseCategory: &harvest.ExpenseCategory{
+			Name:      harvest.String("Mileage"),
+			UnitPrice: harvest.Float64(0.5),
+		},
+		Units:     harvest.Float64(2400),
+		TotalCost: harvest.Float64(1200),
+		Billable:  harvest.Bool(true),
+	})
+
+	var b strings.Builder
+
+	e := export.New(srv.Client(),
+		export.WithColumns(export.Date, export.User, export.Task, export.Hours, export.Rate, export.Amount),
+		export.WithHeader(false),
+		export.WithNumberFormat(".", " "),
+		export.WithTimeFormat(harvest.TimeFormatDecimal))
+
+	n, err := e.Expenses(ctx, &b, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, "2024-03-04,Jane Doe,Mileage,,0.50,1 200.00\n", b.String())
+	assert.Equal(t, 1, srv.RequestCount(), "the display settings aren't read from the company settings")
+}
+
+func TestParseColumns(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		in      string
+		want    []export.Column
+		wantErr error
+	}{
+		{
+			name: "columns",
+			in:   "date, project,rounded_hours",
+			want: []export.Column{export.Date, export.Project, export.RoundedHours},
+		},
+		{
+			name:    "unknown column",
+			in:      "date,cost",
+			wantErr: export.ErrColumn,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := export.ParseColumns(tt.in)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExporter_unknownColumn(t *testing.T) {
+	t.Parallel()
+
+	srv := harvesttest.NewServer()
+	defer srv.Close()
+
+	e := export.New(srv.Client(), export.WithColumns(export.Date, "cost"))
+
+	_, err := e.TimeEntries(context.Background(), &strings.Builder{}, nil)
+	assert.ErrorIs(t, err, export.ErrColumn)
+}
@@ -0,0 +1,210 @@
+// Package export writes time entries and expenses to files for use outside
+// Harvest.
+//
+// CSV files follow Harvest's detailed time report: one row per record, nested
+// objects flattened to their names, hours formatted with the company's time
+// format and numbers with its decimal symbol and thousands separator:
+//
+//	e := export.New(service, export.WithColumns(export.Date, export.Project, export.Hours))
+//	n, err := e.TimeEntries(ctx, w, &harvest.TimeEntryListOptions{From: from, To: to})
+//
+// Time entries can also be written as an iCalendar feed, see ICS.
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/becoded/go-harvest/harvest"
+)
+
+// A Column is a field of the exported records.
+type Column string
+
+// Columns. For expenses, Task holds the expense category, Rate its unit price
+// and Amount the total cost; Hours and RoundedHours are empty.
+const (
+	Date         Column = "date"
+	Client       Column = "client"
+	Project      Column = "project"
+	Task         Column = "task"
+	User         Column = "user"
+	Notes        Column = "notes"
+	Hours        Column = "hours"
+	RoundedHours Column = "rounded_hours"
+	Billable     Column = "billable"
+	Rate         Column = "rate"
+	Amount       Column = "amount"
+	Invoiced     Column = "invoiced"
+)
+
+// DefaultColumns are the columns exported unless WithColumns is used.
+var DefaultColumns = []Column{ //nolint: gochecknoglobals
+	Date, Client, Project, Task, User, Notes, Hours, RoundedHours, Billable, Rate, Amount, Invoiced,
+}
+
+// headers are the CSV headers of the columns, as in Harvest's own export.
+var headers = map[Column]string{ //nolint: gochecknoglobals
+	Date:         "Date",
+	Client:       "Client",
+	Project:      "Project",
+	Task:         "Task",
+	User:         "User",
+	Notes:        "Notes",
+	Hours:        "Hours",
+	RoundedHours: "Hours Rounded",
+	Billable:     "Billable?",
+	Rate:         "Billable Rate",
+	Amount:       "Billable Amount",
+	Invoiced:     "Invoiced?",
+}
+
+// DefaultDayStart is the time of day the synthetic blocks of an ICS export
+// start at.
+const DefaultDayStart = 9 * time.Hour
+
+// ErrColumn is returned for a column that isn't one of the Column constants.
+var ErrColumn = errors.New("unknown column")
+
+// ParseColumns parses a comma-separated list of column names, such as
+// "date,project,hours", for columns read from a flag or a config file.
+func ParseColumns(s string) ([]Column, error) {
+	var cols []Column
+
+	for name := range strings.SplitSeq(s, ",") {
+		c := Column(strings.TrimSpace(name))
+		if _, ok := headers[c]; !ok {
+			return nil, fmt.Errorf("%w %q", ErrColumn, c)
+		}
+
+		cols = append(cols, c)
+	}
+
+	return cols, nil
+}
+
+// Exporter writes records fetched from Harvest. Create one with New.
+type Exporter struct {
+	client   *harvest.APIClient
+	columns  []Column
+	header   bool
+	loc      *time.Location
+	dayStart time.Duration
+
+	mu        sync.Mutex
+	display   harvest.Company
+	settings  *harvest.Company
+	locations map[int64]*time.Location
+	me        *harvest.User
+}
+
+// Option configures an Exporter.
+type Option func(*Exporter)
+
+// WithColumns sets the exported columns and their order. Defaults to
+// DefaultColumns.
+func WithColumns(cols ...Column) Option {
+	return func(e *Exporter) {
+		e.columns = cols
+	}
+}
+
+// WithHeader sets whether CSV files start with a header row. Defaults to true.
+func WithHeader(header bool) Option {
+	return func(e *Exporter) {
+		e.header = header
+	}
+}
+
+// WithNumberFormat sets the decimal symbol and thousands separator instead of
+// reading them from the company settings.
+func WithNumberFormat(decimalSymbol, thousandsSeparator string) Option {
+	return func(e *Exporter) {
+		e.display.DecimalSymbol = harvest.String(decimalSymbol)
+		e.display.ThousandsSeparator = harvest.String(thousandsSeparator)
+	}
+}
+
+// WithTimeFormat sets how hours are formatted, harvest.TimeFormatDecimal or
+// harvest.TimeFormatHoursMinutes, instead of reading it from the company
+// settings.
+func WithTimeFormat(timeFormat string) Option {
+	return func(e *Exporter) {
+		e.display.TimeFormat = harvest.String(timeFormat)
+	}
+}
+
+// WithLocation sets the time zone of entries whose user's time zone can't be
+// read, which is the case for other users' entries when the client isn't an
+// administrator. Defaults to UTC.
+func WithLocation(loc *time.Location) Option {
+	return func(e *Exporter) {
+		e.loc = loc
+	}
+}
+
+// WithDayStart sets the time of day the synthetic blocks of an ICS export
+// start at. Defaults to DefaultDayStart.
+func WithDayStart(d time.Duration) Option {
+	return func(e *Exporter) {
+		e.dayStart = d
+	}
+}
+
+// New returns an Exporter that uses client.
+func New(client *harvest.APIClient, opts ...Option) *Exporter {
+	e := &Exporter{
+		client:    client,
+		columns:   DefaultColumns,
+		header:    true,
+		loc:       time.UTC,
+		dayStart:  DefaultDayStart,
+		locations: map[int64]*time.Location{},
+	}
+
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	return e
+}
+
+// company returns the display settings numbers and hours are formatted with:
+// the ones set through options, completed from the company settings, which
+// are read once and then reused.
+func (e *Exporter) company(ctx context.Context) (*harvest.Company, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.settings != nil {
+		return e.settings, nil
+	}
+
+	settings := e.display
+	if settings.TimeFormat == nil || settings.DecimalSymbol == nil || settings.ThousandsSeparator == nil {
+		company, _, err := e.client.Company.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if settings.TimeFormat == nil {
+			settings.TimeFormat = company.TimeFormat
+		}
+
+		if settings.DecimalSymbol == nil && company.GetDecimalSymbol() != "" {
+			settings.DecimalSymbol = company.DecimalSymbol
+		}
+
+		if settings.ThousandsSeparator == nil {
+			settings.ThousandsSeparator = company.ThousandsSeparator
+		}
+	}
+
+	e.settings = &settings
+
+	return e.settings, nil
+}
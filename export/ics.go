@@ -0,0 +1,274 @@
+package export
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/becoded/go-harvest/harvest"
+)
+
+const (
+	// icsTime is the UTC DATE-TIME format of RFC 5545.
+	icsTime = "20060102T150405Z"
+	// icsLineLength is the maximum length of a content line in octets,
+	// excluding the line break.
+	icsLineLength = 75
+)
+
+// ICS writes the time entries matching opt to w as an RFC 5545 calendar with
+// an event per entry, and returns the number of events written. opt may be
+// nil. Events are written a page at a time as the entries are listed.
+//
+// Entries tracked with start and end times keep them. Duration-only entries
+// become synthetic blocks, stacked in the order they were created from the
+// day start (see WithDayStart), and are marked as transparent so that they
+// don't show as busy. Times are read in the time zone of the entry's user and
+// written in UTC. The summary is "Project / Task" and the description holds
+// the notes.
+func (e *Exporter) ICS(ctx context.Context, w io.Writer, opt *harvest.TimeEntryListOptions) (int, error) {
+	iw := &icsWriter{w: bufio.NewWriter(w)}
+
+	iw.line("BEGIN:VCALENDAR")
+	iw.line("VERSION:2.0")
+	iw.line("PRODID:-//becoded//go-harvest//EN")
+	iw.line("CALSCALE:GREGORIAN")
+	iw.line("METHOD:PUBLISH")
+	iw.line("X-WR-CALNAME:Harvest")
+
+	// cursors are where the next synthetic block of a user's day starts.
+	type day struct {
+		userID int64
+		date   string
+	}
+
+	cursors := map[day]time.Time{}
+	n := 0
+
+	// Entries are listed newest day first, so the entries of a day are held
+	// back until the next day starts and then stacked oldest first.
+	var run []*harvest.TimeEntry
+
+	write := func() error {
+		slices.SortStableFunc(run, func(a, b *harvest.TimeEntry) int {
+			return cmp.Or(
+				a.GetCreatedAt().Compare(b.GetCreatedAt()),
+				cmp.Compare(a.GetID(), b.GetID()),
+			)
+		})
+
+		for _, t := range run {
+			loc, err := e.location(ctx, t.GetUser().GetID())
+			if err != nil {
+				return err
+			}
+
+			start, end, synthetic := span(t, loc)
+			if synthetic {
+				key := day{userID: t.GetUser().GetID(), date: t.GetSpentDate().Format(time.DateOnly)}
+
+				if c, ok := cursors[key]; ok {
+					start = c
+				} else {
+					start = start.Add(e.dayStart)
+				}
+
+				end = start.Add(t.GetHours().Duration())
+				cursors[key] = end
+			}
+
+			iw.event(t, start, end, synthetic)
+			n++
+		}
+
+		run = run[:0]
+
+		return iw.err
+	}
+
+	err := paginate(e.timeEntries(ctx, opt), func(page []*harvest.TimeEntry) error {
+		for _, t := range page {
+			if t.SpentDate == nil {
+				continue
+			}
+
+			if len(run) > 0 && !run[0].GetSpentDate().Time.Equal(t.GetSpentDate().Time) {
+				if err := write(); err != nil {
+					return err
+				}
+			}
+
+			run = append(run, t)
+		}
+
+		return iw.flush()
+	})
+	if err == nil {
+		err = write()
+	}
+
+	if err != nil {
+		return n, err
+	}
+
+	iw.line("END:VCALENDAR")
+
+	return n, iw.flush()
+}
+
+// span returns when the entry started and ended on its day in loc. For an
+// entry without start and end times it returns midnight and synthetic.
+func span(t *harvest.TimeEntry, loc *time.Location) (time.Time, time.Time, bool) {
+	y, m, d := t.GetSpentDate().Date()
+	midnight := time.Date(y, m, d, 0, 0, 0, 0, loc)
+
+	if t.StartedTime == nil {
+		return midnight, midnight, true
+	}
+
+	at := func(clock *harvest.Time) time.Time {
+		return time.Date(y, m, d, clock.Hour(), clock.Minute(), 0, 0, loc)
+	}
+
+	start := at(t.StartedTime)
+	end := start.Add(t.GetHours().Duration())
+
+	if t.EndedTime != nil && at(t.EndedTime).After(start) {
+		end = at(t.EndedTime)
+	}
+
+	return start, end, false
+}
+
+// location returns the time zone of the user with the given ID, read once
+// and then reused.
+func (e *Exporter) location(ctx context.Context, userID int64) (*time.Location, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if loc, ok := e.locations[userID]; ok {
+		return loc, nil
+	}
+
+	loc := e.loc
+
+	if userID != 0 {
+		u, _, err := e.client.User.Get(ctx, userID)
+
+		// Only administrators can read other users, but anyone can read
+		// themselves.
+		var errResp *harvest.ErrorResponse
+		if errors.As(err, &errResp) && errResp.Response.StatusCode == http.StatusForbidden {
+			if e.me == nil {
+				if e.me, _, err = e.client.User.Current(ctx); err != nil {
+					return nil, err
+				}
+			}
+
+			u = nil
+			if e.me.GetID() == userID {
+				u = e.me
+			}
+		} else if err != nil {
+			return nil, err
+		}
+
+		if l, err := LoadTimezone(u.GetTimezone()); err == nil {
+			loc = l
+		}
+	}
+
+	e.locations[userID] = loc
+
+	return loc, nil
+}
+
+// icsWriter writes content lines, keeping the first error.
+type icsWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+// line writes a content line, folded to lines of at most 75 octets.
+func (iw *icsWriter) line(s string) {
+	if iw.err != nil {
+		return
+	}
+
+	var b strings.Builder
+
+	limit := icsLineLength
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+
+		b.WriteString(s[:cut])
+		b.WriteString("\r\n ")
+
+		s = s[cut:]
+		limit = icsLineLength - 1 // the continuation starts with a space
+	}
+
+	b.WriteString(s)
+	b.WriteString("\r\n")
+
+	_, iw.err = iw.w.WriteString(b.String())
+}
+
+func (iw *icsWriter) flush() error {
+	if iw.err != nil {
+		return iw.err
+	}
+
+	return iw.w.Flush()
+}
+
+func (iw *icsWriter) event(t *harvest.TimeEntry, start, end time.Time, synthetic bool) {
+	stamp := time.Now()
+	if t.UpdatedAt != nil {
+		stamp = *t.UpdatedAt
+	}
+
+	iw.line("BEGIN:VEVENT")
+	iw.line(fmt.Sprintf("UID:time-entry-%d@harvestapp.com", t.GetID()))
+	iw.line("DTSTAMP:" + stamp.UTC().Format(icsTime))
+	iw.line("DTSTART:" + start.UTC().Format(icsTime))
+	iw.line("DTEND:" + end.UTC().Format(icsTime))
+	iw.line("SUMMARY:" + escapeText(t.GetProject().GetName()+" / "+t.GetTask().GetName()))
+
+	if notes := t.GetNotes(); notes != "" {
+		iw.line("DESCRIPTION:" + escapeText(notes))
+	}
+
+	if client := t.GetClient().GetName(); client != "" {
+		iw.line("CATEGORIES:" + escapeText(client))
+	}
+
+	if synthetic {
+		iw.line("TRANSP:TRANSPARENT")
+	}
+
+	iw.line("END:VEVENT")
+}
+
+// escapeText escapes a TEXT value of RFC 5545.
+func escapeText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", "",
+	).Replace(s)
+}
@@ -0,0 +1,230 @@
+package export_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/becoded/go-harvest/export"
+	"github.com/becoded/go-harvest/harvest"
+	"github.com/becoded/go-harvest/harvesttest"
+)
+
+func clock(hour, minute int) *harvest.Time {
+	return &harvest.Time{Time: time.Date(0, 1, 1, hour, minute, 0, 0, time.UTC)}
+}
+
+// events returns the VEVENT blocks of an ICS file, unfolded, by UID.
+func events(ics string) map[string][]string {
+	ics = strings.ReplaceAll(ics, "\r\n ", "")
+	evs := map[string][]string{}
+
+	var ev []string
+
+	for line := range strings.SplitSeq(ics, "\r\n") {
+		switch {
+		case line == "BEGIN:VEVENT":
+			ev = []string{}
+		case line == "END:VEVENT":
+			evs[strings.TrimPrefix(ev[0], "UID:")] = ev[1:]
+			ev = nil
+		case ev != nil && !strings.HasPrefix(line, "DTSTAMP:"):
+			ev = append(ev, line)
+		}
+	}
+
+	return evs
+}
+
+func TestExporter_ICS(t *testing.T) {
+	t.Parallel()
+
+	srv := harvesttest.NewServer()
+	defer srv.Close()
+
+	acme := srv.AddClient(&harvest.Client{Name: harvest.String("Acme")})
+	website := srv.AddProject(&harvest.Project{Client: acme, Name: harvest.String("Website")})
+	dev := srv.AddTask(&harvest.Task{Name: harvest.String("Development")})
+
+	me := srv.CurrentUser()
+	jane := srv.AddUser(&harvest.User{
+		FirstName: harvest.String("Jane"),
+		Timezone:  harvest.String("Eastern Time (US & Canada)"),
+	})
+
+	add := func(user *harvest.User, e *harvest.TimeEntry) *harvest.TimeEntry {
+		e.User = &harvest.User{ID: user.ID, Name: user.FirstName}
+		e.Client = acme
+		e.Project = website
+		e.Task = dev
+		e.SpentDate = date("2024-03-04")
+
+		return srv.AddTimeEntry(e)
+	}
+
+	timed := add(me, &harvest.TimeEntry{
+		StartedTime: clock(8, 0),
+		EndedTime:   clock(9, 30),
+		Hours:       harvest.HoursP(1.5),
+	})
+	first := add(jane, &harvest.TimeEntry{
+		Hours: harvest.HoursP(2),
+		Notes: harvest.String("Homepage; hero, footer\nReview"),
+	})
+	second := add(jane, &harvest.TimeEntry{Hours: harvest.HoursP(0.25), Notes: harvest.String(strings.Repeat("ü", 50))})
+
+	var b strings.Builder
+
+	n, err := export.New(srv.Client()).ICS(context.Background(), &b, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	ics := b.String()
+	assert.True(t, strings.HasPrefix(ics, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:"))
+	assert.True(t, strings.HasSuffix(ics, "END:VCALENDAR\r\n"))
+
+	for line := range strings.SplitSeq(ics, "\r\n") {
+		assert.LessOrEqual(t, len(line), 75, "lines are folded")
+	}
+
+	evs := events(ics)
+	assert.Equal(t, []string{
+		"DTSTART:20240304T080000Z",
+		"DTEND:20240304T093000Z",
+		"SUMMARY:Website / Development",
+		"CATEGORIES:Acme",
+	}, evs[fmt.Sprintf("time-entry-%d@harvestapp.com", timed.GetID())])
+	assert.Equal(t, []string{
+		"DTSTART:20240304T140000Z", // 9:00 in New York
+		"DTEND:20240304T160000Z",
+		"SUMMARY:Website / Development",
+		`DESCRIPTION:Homepage\; hero\, footer\nReview`,
+		"CATEGORIES:Acme",
+		"TRANSP:TRANSPARENT",
+	}, evs[fmt.Sprintf("time-entry-%d@harvestapp.com", first.GetID())])
+
+	ev := evs[fmt.Sprintf("time-entry-%d@harvestapp.com", second.GetID())]
+	assert.Equal(t, "DTSTART:20240304T160000Z", ev[0], "synthetic blocks are stacked")
+	assert.Equal(t, "DTEND:20240304T161500Z", ev[1])
+	assert.Equal(t, "DESCRIPTION:"+strings.Repeat("ü", 50), ev[3])
+}
+
+// writes counts the writes made to it.
+type writes struct {
+	strings.Builder
+	n int
+}
+
+func (w *writes) Write(p []byte) (int, error) {
+	w.n++
+
+	return w.Builder.Write(p)
+}
+
+func TestExporter_ICS_pages(t *testing.T) {
+	t.Parallel()
+
+	srv := harvesttest.NewServer()
+	defer srv.Close()
+
+	me := &harvest.User{ID: srv.CurrentUser().ID}
+	monday := srv.AddTimeEntry(&harvest.TimeEntry{User: me, SpentDate: date("2024-03-04"), Hours: harvest.HoursP(1)})
+	first := srv.AddTimeEntry(&harvest.TimeEntry{User: me, SpentDate: date("2024-03-05"), Hours: harvest.HoursP(2)})
+	second := srv.AddTimeEntry(&harvest.TimeEntry{User: me, SpentDate: date("2024-03-05"), Hours: harvest.HoursP(1)})
+
+	var w writes
+
+	n, err := export.New(srv.Client()).ICS(context.Background(), &w, &harvest.TimeEntryListOptions{
+		ListOptions: harvest.ListOptions{PerPage: 1},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.Greater(t, w.n, 1, "pages are written as they arrive")
+
+	evs := events(w.String())
+	assert.Equal(t, "DTSTART:20240304T090000Z", evs[fmt.Sprintf("time-entry-%d@harvestapp.com", monday.GetID())][0])
+	assert.Equal(t, "DTSTART:20240305T090000Z", evs[fmt.Sprintf("time-entry-%d@harvestapp.com", first.GetID())][0])
+	assert.Equal(t, "DTSTART:20240305T110000Z", evs[fmt.Sprintf("time-entry-%d@harvestapp.com", second.GetID())][0],
+		"blocks are stacked across pages")
+}
+
+func TestExporter_ICS_notAdmin(t *testing.T) {
+	t.Parallel()
+
+	srv := harvesttest.NewServer()
+	defer srv.Close()
+
+	website := srv.AddProject(&harvest.Project{Name: harvest.String("Website")})
+	jane := srv.AddUser(&harvest.User{FirstName: harvest.String("Jane"), Timezone: harvest.String("Europe/Brussels")})
+	other := srv.AddUser(&harvest.User{FirstName: harvest.String("Other"), Timezone: harvest.String("Tokyo")})
+
+	srv.SetCurrentUser(jane.GetID())
+
+	for _, u := range []*harvest.User{jane, other} {
+		srv.AddTimeEntry(&harvest.TimeEntry{
+			User:      &harvest.User{ID: u.ID},
+			Project:   website,
+			SpentDate: date("2024-03-04"),
+			Hours:     harvest.HoursP(1),
+		})
+	}
+
+	// Only administrators can read other users.
+	client := srv.Client()
+	client.Use(func(next harvest.Doer) harvest.Doer {
+		return harvest.DoerFunc(func(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
+			if strings.Contains(req.URL.Path, "/users/") && !strings.HasSuffix(req.URL.Path, "/users/me") {
+				resp := &http.Response{StatusCode: http.StatusForbidden, Request: req}
+
+				return resp, &harvest.ErrorResponse{Response: resp}
+			}
+
+			return next.Do(ctx, req, v)
+		})
+	})
+
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	var b strings.Builder
+
+	_, err = export.New(client, export.WithLocation(newYork), export.WithDayStart(8*time.Hour)).
+		ICS(context.Background(), &b, nil)
+	assert.NoError(t, err)
+	assert.Contains(t, b.String(), "DTSTART:20240304T070000Z", "the current user's time zone is read")
+	assert.Contains(t, b.String(), "DTSTART:20240304T130000Z", "other users fall back to the location")
+}
+
+func TestLoadTimezone(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		want    string
+		wantErr error
+	}{
+		{name: "Eastern Time (US & Canada)", want: "America/New_York"},
+		{name: "Brussels", want: "Europe/Brussels"},
+		{name: "Europe/Paris", want: "Europe/Paris"},
+		{name: "Atlantis", wantErr: export.ErrTimezone},
+		{name: "", wantErr: export.ErrTimezone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			loc, err := export.LoadTimezone(tt.name)
+			assert.ErrorIs(t, err, tt.wantErr)
+
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, loc.String())
+			}
+		})
+	}
+}
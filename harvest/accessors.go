@@ -0,0 +1,4794 @@
+// Code generated by gen-accessors; DO NOT EDIT.
+// Instead, edit the struct definitions and run "mage generate".
+
+package harvest
+
+import "time"
+
+// GetRetryAfter returns the RetryAfter field if it's non-nil, zero value otherwise.
+func (a *AbuseRateLimitError) GetRetryAfter() time.Duration {
+	if a == nil || a.RetryAfter == nil {
+		return 0
+	}
+
+	return *a.RetryAfter
+}
+
+// GetAddress returns the Address field if it's non-nil, zero value otherwise.
+func (c *Client) GetAddress() string {
+	if c == nil || c.Address == nil {
+		return ""
+	}
+
+	return *c.Address
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (c *Client) GetCreatedAt() time.Time {
+	if c == nil || c.CreatedAt == nil {
+		return time.Time{}
+	}
+
+	return *c.CreatedAt
+}
+
+// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
+func (c *Client) GetCurrency() string {
+	if c == nil || c.Currency == nil {
+		return ""
+	}
+
+	return *c.Currency
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *Client) GetID() int64 {
+	if c == nil || c.ID == nil {
+		return 0
+	}
+
+	return *c.ID
+}
+
+// GetIsActive returns the IsActive field if it's non-nil, zero value otherwise.
+func (c *Client) GetIsActive() bool {
+	if c == nil || c.IsActive == nil {
+		return false
+	}
+
+	return *c.IsActive
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *Client) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+
+	return *c.Name
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (c *Client) GetUpdatedAt() time.Time {
+	if c == nil || c.UpdatedAt == nil {
+		return time.Time{}
+	}
+
+	return *c.UpdatedAt
+}
+
+// GetClient returns the Client field, or nil if ClientContact is nil.
+func (c *ClientContact) GetClient() *Client {
+	if c == nil {
+		return nil
+	}
+
+	return c.Client
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (c *ClientContact) GetCreatedAt() time.Time {
+	if c == nil || c.CreatedAt == nil {
+		return time.Time{}
+	}
+
+	return *c.CreatedAt
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (c *ClientContact) GetEmail() string {
+	if c == nil || c.Email == nil {
+		return ""
+	}
+
+	return *c.Email
+}
+
+// GetFax returns the Fax field if it's non-nil, zero value otherwise.
+func (c *ClientContact) GetFax() string {
+	if c == nil || c.Fax == nil {
+		return ""
+	}
+
+	return *c.Fax
+}
+
+// GetFirstName returns the FirstName field if it's non-nil, zero value otherwise.
+func (c *ClientContact) GetFirstName() string {
+	if c == nil || c.FirstName == nil {
+		return ""
+	}
+
+	return *c.FirstName
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *ClientContact) GetID() int64 {
+	if c == nil || c.ID == nil {
+		return 0
+	}
+
+	return *c.ID
+}
+
+// GetLastName returns the LastName field if it's non-nil, zero value otherwise.
+func (c *ClientContact) GetLastName() string {
+	if c == nil || c.LastName == nil {
+		return ""
+	}
+
+	return *c.LastName
+}
+
+// GetPhoneMobile returns the PhoneMobile field if it's non-nil, zero value otherwise.
+func (c *ClientContact) GetPhoneMobile() string {
+	if c == nil || c.PhoneMobile == nil {
+		return ""
+	}
+
+	return *c.PhoneMobile
+}
+
+// GetPhoneOffice returns the PhoneOffice field if it's non-nil, zero value otherwise.
+func (c *ClientContact) GetPhoneOffice() string {
+	if c == nil || c.PhoneOffice == nil {
+		return ""
+	}
+
+	return *c.PhoneOffice
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (c *ClientContact) GetTitle() string {
+	if c == nil || c.Title == nil {
+		return ""
+	}
+
+	return *c.Title
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (c *ClientContact) GetUpdatedAt() time.Time {
+	if c == nil || c.UpdatedAt == nil {
+		return time.Time{}
+	}
+
+	return *c.UpdatedAt
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (c *ClientContactCreateRequest) GetClientID() int64 {
+	if c == nil || c.ClientID == nil {
+		return 0
+	}
+
+	return *c.ClientID
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (c *ClientContactCreateRequest) GetEmail() string {
+	if c == nil || c.Email == nil {
+		return ""
+	}
+
+	return *c.Email
+}
+
+// GetFax returns the Fax field if it's non-nil, zero value otherwise.
+func (c *ClientContactCreateRequest) GetFax() string {
+	if c == nil || c.Fax == nil {
+		return ""
+	}
+
+	return *c.Fax
+}
+
+// GetFirstName returns the FirstName field if it's non-nil, zero value otherwise.
+func (c *ClientContactCreateRequest) GetFirstName() string {
+	if c == nil || c.FirstName == nil {
+		return ""
+	}
+
+	return *c.FirstName
+}
+
+// GetLastName returns the LastName field if it's non-nil, zero value otherwise.
+func (c *ClientContactCreateRequest) GetLastName() string {
+	if c == nil || c.LastName == nil {
+		return ""
+	}
+
+	return *c.LastName
+}
+
+// GetPhoneMobile returns the PhoneMobile field if it's non-nil, zero value otherwise.
+func (c *ClientContactCreateRequest) GetPhoneMobile() string {
+	if c == nil || c.PhoneMobile == nil {
+		return ""
+	}
+
+	return *c.PhoneMobile
+}
+
+// GetPhoneOffice returns the PhoneOffice field if it's non-nil, zero value otherwise.
+func (c *ClientContactCreateRequest) GetPhoneOffice() string {
+	if c == nil || c.PhoneOffice == nil {
+		return ""
+	}
+
+	return *c.PhoneOffice
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (c *ClientContactCreateRequest) GetTitle() string {
+	if c == nil || c.Title == nil {
+		return ""
+	}
+
+	return *c.Title
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (c *ClientContactUpdateRequest) GetClientID() int64 {
+	if c == nil || c.ClientID == nil {
+		return 0
+	}
+
+	return *c.ClientID
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (c *ClientContactUpdateRequest) GetEmail() string {
+	if c == nil || c.Email == nil {
+		return ""
+	}
+
+	return *c.Email
+}
+
+// GetFax returns the Fax field if it's non-nil, zero value otherwise.
+func (c *ClientContactUpdateRequest) GetFax() string {
+	if c == nil || c.Fax == nil {
+		return ""
+	}
+
+	return *c.Fax
+}
+
+// GetFirstName returns the FirstName field if it's non-nil, zero value otherwise.
+func (c *ClientContactUpdateRequest) GetFirstName() string {
+	if c == nil || c.FirstName == nil {
+		return ""
+	}
+
+	return *c.FirstName
+}
+
+// GetLastName returns the LastName field if it's non-nil, zero value otherwise.
+func (c *ClientContactUpdateRequest) GetLastName() string {
+	if c == nil || c.LastName == nil {
+		return ""
+	}
+
+	return *c.LastName
+}
+
+// GetPhoneMobile returns the PhoneMobile field if it's non-nil, zero value otherwise.
+func (c *ClientContactUpdateRequest) GetPhoneMobile() string {
+	if c == nil || c.PhoneMobile == nil {
+		return ""
+	}
+
+	return *c.PhoneMobile
+}
+
+// GetPhoneOffice returns the PhoneOffice field if it's non-nil, zero value otherwise.
+func (c *ClientContactUpdateRequest) GetPhoneOffice() string {
+	if c == nil || c.PhoneOffice == nil {
+		return ""
+	}
+
+	return *c.PhoneOffice
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (c *ClientContactUpdateRequest) GetTitle() string {
+	if c == nil || c.Title == nil {
+		return ""
+	}
+
+	return *c.Title
+}
+
+// GetAddress returns the Address field if it's non-nil, zero value otherwise.
+func (c *ClientCreateRequest) GetAddress() string {
+	if c == nil || c.Address == nil {
+		return ""
+	}
+
+	return *c.Address
+}
+
+// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
+func (c *ClientCreateRequest) GetCurrency() string {
+	if c == nil || c.Currency == nil {
+		return ""
+	}
+
+	return *c.Currency
+}
+
+// GetIsActive returns the IsActive field if it's non-nil, zero value otherwise.
+func (c *ClientCreateRequest) GetIsActive() bool {
+	if c == nil || c.IsActive == nil {
+		return false
+	}
+
+	return *c.IsActive
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *ClientCreateRequest) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+
+	return *c.Name
+}
+
+// GetAddress returns the Address field if it's non-nil, zero value otherwise.
+func (c *ClientUpdateRequest) GetAddress() string {
+	if c == nil || c.Address == nil {
+		return ""
+	}
+
+	return *c.Address
+}
+
+// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
+func (c *ClientUpdateRequest) GetCurrency() string {
+	if c == nil || c.Currency == nil {
+		return ""
+	}
+
+	return *c.Currency
+}
+
+// GetIsActive returns the IsActive field if it's non-nil, zero value otherwise.
+func (c *ClientUpdateRequest) GetIsActive() bool {
+	if c == nil || c.IsActive == nil {
+		return false
+	}
+
+	return *c.IsActive
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *ClientUpdateRequest) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+
+	return *c.Name
+}
+
+// GetApprovalFeature returns the ApprovalFeature field if it's non-nil, zero value otherwise.
+func (c *Company) GetApprovalFeature() bool {
+	if c == nil || c.ApprovalFeature == nil {
+		return false
+	}
+
+	return *c.ApprovalFeature
+}
+
+// GetBaseURI returns the BaseURI field if it's non-nil, zero value otherwise.
+func (c *Company) GetBaseURI() string {
+	if c == nil || c.BaseURI == nil {
+		return ""
+	}
+
+	return *c.BaseURI
+}
+
+// GetClock returns the Clock field if it's non-nil, zero value otherwise.
+func (c *Company) GetClock() string {
+	if c == nil || c.Clock == nil {
+		return ""
+	}
+
+	return *c.Clock
+}
+
+// GetColorScheme returns the ColorScheme field if it's non-nil, zero value otherwise.
+func (c *Company) GetColorScheme() string {
+	if c == nil || c.ColorScheme == nil {
+		return ""
+	}
+
+	return *c.ColorScheme
+}
+
+// GetDecimalSymbol returns the DecimalSymbol field if it's non-nil, zero value otherwise.
+func (c *Company) GetDecimalSymbol() string {
+	if c == nil || c.DecimalSymbol == nil {
+		return ""
+	}
+
+	return *c.DecimalSymbol
+}
+
+// GetEstimateFeature returns the EstimateFeature field if it's non-nil, zero value otherwise.
+func (c *Company) GetEstimateFeature() bool {
+	if c == nil || c.EstimateFeature == nil {
+		return false
+	}
+
+	return *c.EstimateFeature
+}
+
+// GetExpenseFeature returns the ExpenseFeature field if it's non-nil, zero value otherwise.
+func (c *Company) GetExpenseFeature() bool {
+	if c == nil || c.ExpenseFeature == nil {
+		return false
+	}
+
+	return *c.ExpenseFeature
+}
+
+// GetFullDomain returns the FullDomain field if it's non-nil, zero value otherwise.
+func (c *Company) GetFullDomain() string {
+	if c == nil || c.FullDomain == nil {
+		return ""
+	}
+
+	return *c.FullDomain
+}
+
+// GetInvoiceFeature returns the InvoiceFeature field if it's non-nil, zero value otherwise.
+func (c *Company) GetInvoiceFeature() bool {
+	if c == nil || c.InvoiceFeature == nil {
+		return false
+	}
+
+	return *c.InvoiceFeature
+}
+
+// GetIsActive returns the IsActive field if it's non-nil, zero value otherwise.
+func (c *Company) GetIsActive() bool {
+	if c == nil || c.IsActive == nil {
+		return false
+	}
+
+	return *c.IsActive
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *Company) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+
+	return *c.Name
+}
+
+// GetPlanType returns the PlanType field if it's non-nil, zero value otherwise.
+func (c *Company) GetPlanType() string {
+	if c == nil || c.PlanType == nil {
+		return ""
+	}
+
+	return *c.PlanType
+}
+
+// GetThousandsSeparator returns the ThousandsSeparator field if it's non-nil, zero value otherwise.
+func (c *Company) GetThousandsSeparator() string {
+	if c == nil || c.ThousandsSeparator == nil {
+		return ""
+	}
+
+	return *c.ThousandsSeparator
+}
+
+// GetTimeFormat returns the TimeFormat field if it's non-nil, zero value otherwise.
+func (c *Company) GetTimeFormat() string {
+	if c == nil || c.TimeFormat == nil {
+		return ""
+	}
+
+	return *c.TimeFormat
+}
+
+// GetWantsTimestampTimers returns the WantsTimestampTimers field if it's non-nil, zero value otherwise.
+func (c *Company) GetWantsTimestampTimers() bool {
+	if c == nil || c.WantsTimestampTimers == nil {
+		return false
+	}
+
+	return *c.WantsTimestampTimers
+}
+
+// GetWeekStartDay returns the WeekStartDay field if it's non-nil, zero value otherwise.
+func (c *Company) GetWeekStartDay() string {
+	if c == nil || c.WeekStartDay == nil {
+		return ""
+	}
+
+	return *c.WeekStartDay
+}
+
+// GetAcceptedAt returns the AcceptedAt field if it's non-nil, zero value otherwise.
+func (e *Estimate) GetAcceptedAt() time.Time {
+	if e == nil || e.AcceptedAt == nil {
+		return time.Time{}
+	}
+
+	return *e.AcceptedAt
+}
+
+// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
+func (e *Estimate) GetAmount() float64 {
+	if e == nil || e.Amount == nil {
+		return 0
+	}
+
+	return *e.Amount
+}
+
+// GetClient returns the Client field, or nil if Estimate is nil.
+func (e *Estimate) GetClient() *Client {
+	if e == nil {
+		return nil
+	}
+
+	return e.Client
+}
+
+// GetClientKey returns the ClientKey field if it's non-nil, zero value otherwise.
+func (e *Estimate) GetClientKey() string {
+	if e == nil || e.ClientKey == nil {
+		return ""
+	}
+
+	return *e.ClientKey
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (e *Estimate) GetCreatedAt() time.Time {
+	if e == nil || e.CreatedAt == nil {
+		return time.Time{}
+	}
+
+	return *e.CreatedAt
+}
+
+// GetCreator returns the Creator field, or nil if Estimate is nil.
+func (e *Estimate) GetCreator() *User {
+	if e == nil {
+		return nil
+	}
+
+	return e.Creator
+}
+
+// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
+func (e *Estimate) GetCurrency() string {
+	if e == nil || e.Currency == nil {
+		return ""
+	}
+
+	return *e.Currency
+}
+
+// GetDeclinedAt returns the DeclinedAt field if it's non-nil, zero value otherwise.
+func (e *Estimate) GetDeclinedAt() time.Time {
+	if e == nil || e.DeclinedAt == nil {
+		return time.Time{}
+	}
+
+	return *e.DeclinedAt
+}
+
+// GetDiscount returns the Discount field if it's non-nil, zero value otherwise.
+func (e *Estimate) GetDiscount() float64 {
+	if e == nil || e.Discount == nil {
+		return 0
+	}
+
+	return *e.Discount
+}
+
+// GetDiscountAmount returns the DiscountAmount field if it's non-nil, zero value otherwise.
+func (e *Estimate) GetDiscountAmount() float64 {
+	if e == nil || e.DiscountAmount == nil {
+		return 0
+	}
+
+	return *e.DiscountAmount
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (e *Estimate) GetID() int64 {
+	if e == nil || e.ID == nil {
+		return 0
+	}
+
+	return *e.ID
+}
+
+// GetIssueDate returns the IssueDate field if it's non-nil, zero value otherwise.
+func (e *Estimate) GetIssueDate() Date {
+	if e == nil || e.IssueDate == nil {
+		return Date{}
+	}
+
+	return *e.IssueDate
+}
+
+// GetLineItems returns the LineItems field if it's non-nil, zero value otherwise.
+func (e *Estimate) GetLineItems() []EstimateLineItem {
+	if e == nil || e.LineItems == nil {
+		return nil
+	}
+
+	return *e.LineItems
+}
+
+// GetNotes returns the Notes field if it's non-nil, zero value otherwise.
+func (e *Estimate) GetNotes() string {
+	if e == nil || e.Notes == nil {
+		return ""
+	}
+
+	return *e.Notes
+}
+
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (e *Estimate) GetNumber() string {
+	if e == nil || e.Number == nil {
+		return ""
+	}
+
+	return *e.Number
+}
+
+// GetPurchaseOrder returns the PurchaseOrder field if it's non-nil, zero value otherwise.
+func (e *Estimate) GetPurchaseOrder() string {
+	if e == nil || e.PurchaseOrder == nil {
+		return ""
+	}
+
+	return *e.PurchaseOrder
+}
+
+// GetSentAt returns the SentAt field if it's non-nil, zero value otherwise.
+func (e *Estimate) GetSentAt() time.Time {
+	if e == nil || e.SentAt == nil {
+		return time.Time{}
+	}
+
+	return *e.SentAt
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (e *Estimate) GetState() string {
+	if e == nil || e.State == nil {
+		return ""
+	}
+
+	return *e.State
+}
+
+// GetSubject returns the Subject field if it's non-nil, zero value otherwise.
+func (e *Estimate) GetSubject() string {
+	if e == nil || e.Subject == nil {
+		return ""
+	}
+
+	return *e.Subject
+}
+
+// GetTax returns the Tax field if it's non-nil, zero value otherwise.
+func (e *Estimate) GetTax() float64 {
+	if e == nil || e.Tax == nil {
+		return 0
+	}
+
+	return *e.Tax
+}
+
+// GetTax2 returns the Tax2 field if it's non-nil, zero value otherwise.
+func (e *Estimate) GetTax2() float64 {
+	if e == nil || e.Tax2 == nil {
+		return 0
+	}
+
+	return *e.Tax2
+}
+
+// GetTax2Amount returns the Tax2Amount field if it's non-nil, zero value otherwise.
+func (e *Estimate) GetTax2Amount() float64 {
+	if e == nil || e.Tax2Amount == nil {
+		return 0
+	}
+
+	return *e.Tax2Amount
+}
+
+// GetTaxAmount returns the TaxAmount field if it's non-nil, zero value otherwise.
+func (e *Estimate) GetTaxAmount() float64 {
+	if e == nil || e.TaxAmount == nil {
+		return 0
+	}
+
+	return *e.TaxAmount
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (e *Estimate) GetUpdatedAt() time.Time {
+	if e == nil || e.UpdatedAt == nil {
+		return time.Time{}
+	}
+
+	return *e.UpdatedAt
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (e *EstimateItemCategory) GetCreatedAt() time.Time {
+	if e == nil || e.CreatedAt == nil {
+		return time.Time{}
+	}
+
+	return *e.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (e *EstimateItemCategory) GetID() int64 {
+	if e == nil || e.ID == nil {
+		return 0
+	}
+
+	return *e.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (e *EstimateItemCategory) GetName() string {
+	if e == nil || e.Name == nil {
+		return ""
+	}
+
+	return *e.Name
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (e *EstimateItemCategory) GetUpdatedAt() time.Time {
+	if e == nil || e.UpdatedAt == nil {
+		return time.Time{}
+	}
+
+	return *e.UpdatedAt
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (e *EstimateItemCategoryRequest) GetName() string {
+	if e == nil || e.Name == nil {
+		return ""
+	}
+
+	return *e.Name
+}
+
+// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
+func (e *EstimateLineItem) GetAmount() float64 {
+	if e == nil || e.Amount == nil {
+		return 0
+	}
+
+	return *e.Amount
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (e *EstimateLineItem) GetDescription() string {
+	if e == nil || e.Description == nil {
+		return ""
+	}
+
+	return *e.Description
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (e *EstimateLineItem) GetID() int64 {
+	if e == nil || e.ID == nil {
+		return 0
+	}
+
+	return *e.ID
+}
+
+// GetKind returns the Kind field if it's non-nil, zero value otherwise.
+func (e *EstimateLineItem) GetKind() string {
+	if e == nil || e.Kind == nil {
+		return ""
+	}
+
+	return *e.Kind
+}
+
+// GetQuantity returns the Quantity field if it's non-nil, zero value otherwise.
+func (e *EstimateLineItem) GetQuantity() int64 {
+	if e == nil || e.Quantity == nil {
+		return 0
+	}
+
+	return *e.Quantity
+}
+
+// GetTaxed returns the Taxed field if it's non-nil, zero value otherwise.
+func (e *EstimateLineItem) GetTaxed() bool {
+	if e == nil || e.Taxed == nil {
+		return false
+	}
+
+	return *e.Taxed
+}
+
+// GetTaxed2 returns the Taxed2 field if it's non-nil, zero value otherwise.
+func (e *EstimateLineItem) GetTaxed2() bool {
+	if e == nil || e.Taxed2 == nil {
+		return false
+	}
+
+	return *e.Taxed2
+}
+
+// GetUnitPrice returns the UnitPrice field if it's non-nil, zero value otherwise.
+func (e *EstimateLineItem) GetUnitPrice() float64 {
+	if e == nil || e.UnitPrice == nil {
+		return 0
+	}
+
+	return *e.UnitPrice
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (e *EstimateMessage) GetBody() string {
+	if e == nil || e.Body == nil {
+		return ""
+	}
+
+	return *e.Body
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (e *EstimateMessage) GetCreatedAt() time.Time {
+	if e == nil || e.CreatedAt == nil {
+		return time.Time{}
+	}
+
+	return *e.CreatedAt
+}
+
+// GetEventType returns the EventType field if it's non-nil, zero value otherwise.
+func (e *EstimateMessage) GetEventType() string {
+	if e == nil || e.EventType == nil {
+		return ""
+	}
+
+	return *e.EventType
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (e *EstimateMessage) GetID() int64 {
+	if e == nil || e.ID == nil {
+		return 0
+	}
+
+	return *e.ID
+}
+
+// GetRecipients returns the Recipients field if it's non-nil, zero value otherwise.
+func (e *EstimateMessage) GetRecipients() []EstimateMessageRecipient {
+	if e == nil || e.Recipients == nil {
+		return nil
+	}
+
+	return *e.Recipients
+}
+
+// GetSendMeACopy returns the SendMeACopy field if it's non-nil, zero value otherwise.
+func (e *EstimateMessage) GetSendMeACopy() bool {
+	if e == nil || e.SendMeACopy == nil {
+		return false
+	}
+
+	return *e.SendMeACopy
+}
+
+// GetSentBy returns the SentBy field if it's non-nil, zero value otherwise.
+func (e *EstimateMessage) GetSentBy() string {
+	if e == nil || e.SentBy == nil {
+		return ""
+	}
+
+	return *e.SentBy
+}
+
+// GetSentByEmail returns the SentByEmail field if it's non-nil, zero value otherwise.
+func (e *EstimateMessage) GetSentByEmail() string {
+	if e == nil || e.SentByEmail == nil {
+		return ""
+	}
+
+	return *e.SentByEmail
+}
+
+// GetSentFrom returns the SentFrom field if it's non-nil, zero value otherwise.
+func (e *EstimateMessage) GetSentFrom() string {
+	if e == nil || e.SentFrom == nil {
+		return ""
+	}
+
+	return *e.SentFrom
+}
+
+// GetSentFromEmail returns the SentFromEmail field if it's non-nil, zero value otherwise.
+func (e *EstimateMessage) GetSentFromEmail() string {
+	if e == nil || e.SentFromEmail == nil {
+		return ""
+	}
+
+	return *e.SentFromEmail
+}
+
+// GetSubject returns the Subject field if it's non-nil, zero value otherwise.
+func (e *EstimateMessage) GetSubject() string {
+	if e == nil || e.Subject == nil {
+		return ""
+	}
+
+	return *e.Subject
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (e *EstimateMessage) GetUpdatedAt() time.Time {
+	if e == nil || e.UpdatedAt == nil {
+		return time.Time{}
+	}
+
+	return *e.UpdatedAt
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (e *EstimateMessageCreateRequest) GetBody() string {
+	if e == nil || e.Body == nil {
+		return ""
+	}
+
+	return *e.Body
+}
+
+// GetEventType returns the EventType field if it's non-nil, zero value otherwise.
+func (e *EstimateMessageCreateRequest) GetEventType() string {
+	if e == nil || e.EventType == nil {
+		return ""
+	}
+
+	return *e.EventType
+}
+
+// GetRecipients returns the Recipients field if it's non-nil, zero value otherwise.
+func (e *EstimateMessageCreateRequest) GetRecipients() []EstimateMessageRecipientCreateRequest {
+	if e == nil || e.Recipients == nil {
+		return nil
+	}
+
+	return *e.Recipients
+}
+
+// GetSendMeACopy returns the SendMeACopy field if it's non-nil, zero value otherwise.
+func (e *EstimateMessageCreateRequest) GetSendMeACopy() bool {
+	if e == nil || e.SendMeACopy == nil {
+		return false
+	}
+
+	return *e.SendMeACopy
+}
+
+// GetSubject returns the Subject field if it's non-nil, zero value otherwise.
+func (e *EstimateMessageCreateRequest) GetSubject() string {
+	if e == nil || e.Subject == nil {
+		return ""
+	}
+
+	return *e.Subject
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (e *EstimateMessageRecipient) GetEmail() string {
+	if e == nil || e.Email == nil {
+		return ""
+	}
+
+	return *e.Email
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (e *EstimateMessageRecipient) GetName() string {
+	if e == nil || e.Name == nil {
+		return ""
+	}
+
+	return *e.Name
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (e *EstimateMessageRecipientCreateRequest) GetEmail() string {
+	if e == nil || e.Email == nil {
+		return ""
+	}
+
+	return *e.Email
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (e *EstimateMessageRecipientCreateRequest) GetName() string {
+	if e == nil || e.Name == nil {
+		return ""
+	}
+
+	return *e.Name
+}
+
+// GetBillable returns the Billable field if it's non-nil, zero value otherwise.
+func (e *Expense) GetBillable() bool {
+	if e == nil || e.Billable == nil {
+		return false
+	}
+
+	return *e.Billable
+}
+
+// GetClient returns the Client field, or nil if Expense is nil.
+func (e *Expense) GetClient() *Client {
+	if e == nil {
+		return nil
+	}
+
+	return e.Client
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (e *Expense) GetCreatedAt() time.Time {
+	if e == nil || e.CreatedAt == nil {
+		return time.Time{}
+	}
+
+	return *e.CreatedAt
+}
+
+// GetExpenseCategory returns the ExpenseCategory field, or nil if Expense is nil.
+func (e *Expense) GetExpenseCategory() *ExpenseCategory {
+	if e == nil {
+		return nil
+	}
+
+	return e.ExpenseCategory
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (e *Expense) GetID() int64 {
+	if e == nil || e.ID == nil {
+		return 0
+	}
+
+	return *e.ID
+}
+
+// GetInvoice returns the Invoice field, or nil if Expense is nil.
+func (e *Expense) GetInvoice() *Invoice {
+	if e == nil {
+		return nil
+	}
+
+	return e.Invoice
+}
+
+// GetIsBilled returns the IsBilled field if it's non-nil, zero value otherwise.
+func (e *Expense) GetIsBilled() bool {
+	if e == nil || e.IsBilled == nil {
+		return false
+	}
+
+	return *e.IsBilled
+}
+
+// GetIsClosed returns the IsClosed field if it's non-nil, zero value otherwise.
+func (e *Expense) GetIsClosed() bool {
+	if e == nil || e.IsClosed == nil {
+		return false
+	}
+
+	return *e.IsClosed
+}
+
+// GetIsLocked returns the IsLocked field if it's non-nil, zero value otherwise.
+func (e *Expense) GetIsLocked() bool {
+	if e == nil || e.IsLocked == nil {
+		return false
+	}
+
+	return *e.IsLocked
+}
+
+// GetLockedReason returns the LockedReason field if it's non-nil, zero value otherwise.
+func (e *Expense) GetLockedReason() string {
+	if e == nil || e.LockedReason == nil {
+		return ""
+	}
+
+	return *e.LockedReason
+}
+
+// GetNotes returns the Notes field if it's non-nil, zero value otherwise.
+func (e *Expense) GetNotes() string {
+	if e == nil || e.Notes == nil {
+		return ""
+	}
+
+	return *e.Notes
+}
+
+// GetProject returns the Project field, or nil if Expense is nil.
+func (e *Expense) GetProject() *Project {
+	if e == nil {
+		return nil
+	}
+
+	return e.Project
+}
+
+// GetReceipt returns the Receipt field, or nil if Expense is nil.
+func (e *Expense) GetReceipt() *Receipt {
+	if e == nil {
+		return nil
+	}
+
+	return e.Receipt
+}
+
+// GetSpentDate returns the SpentDate field if it's non-nil, zero value otherwise.
+func (e *Expense) GetSpentDate() Date {
+	if e == nil || e.SpentDate == nil {
+		return Date{}
+	}
+
+	return *e.SpentDate
+}
+
+// GetTotalCost returns the TotalCost field if it's non-nil, zero value otherwise.
+func (e *Expense) GetTotalCost() float64 {
+	if e == nil || e.TotalCost == nil {
+		return 0
+	}
+
+	return *e.TotalCost
+}
+
+// GetUnits returns the Units field if it's non-nil, zero value otherwise.
+func (e *Expense) GetUnits() float64 {
+	if e == nil || e.Units == nil {
+		return 0
+	}
+
+	return *e.Units
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (e *Expense) GetUpdatedAt() time.Time {
+	if e == nil || e.UpdatedAt == nil {
+		return time.Time{}
+	}
+
+	return *e.UpdatedAt
+}
+
+// GetUser returns the User field, or nil if Expense is nil.
+func (e *Expense) GetUser() *User {
+	if e == nil {
+		return nil
+	}
+
+	return e.User
+}
+
+// GetUserAssignment returns the UserAssignment field, or nil if Expense is nil.
+func (e *Expense) GetUserAssignment() *ProjectUserAssignment {
+	if e == nil {
+		return nil
+	}
+
+	return e.UserAssignment
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (e *ExpenseCategory) GetCreatedAt() time.Time {
+	if e == nil || e.CreatedAt == nil {
+		return time.Time{}
+	}
+
+	return *e.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (e *ExpenseCategory) GetID() int64 {
+	if e == nil || e.ID == nil {
+		return 0
+	}
+
+	return *e.ID
+}
+
+// GetIsActive returns the IsActive field if it's non-nil, zero value otherwise.
+func (e *ExpenseCategory) GetIsActive() bool {
+	if e == nil || e.IsActive == nil {
+		return false
+	}
+
+	return *e.IsActive
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (e *ExpenseCategory) GetName() string {
+	if e == nil || e.Name == nil {
+		return ""
+	}
+
+	return *e.Name
+}
+
+// GetUnitName returns the UnitName field if it's non-nil, zero value otherwise.
+func (e *ExpenseCategory) GetUnitName() string {
+	if e == nil || e.UnitName == nil {
+		return ""
+	}
+
+	return *e.UnitName
+}
+
+// GetUnitPrice returns the UnitPrice field if it's non-nil, zero value otherwise.
+func (e *ExpenseCategory) GetUnitPrice() float64 {
+	if e == nil || e.UnitPrice == nil {
+		return 0
+	}
+
+	return *e.UnitPrice
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (e *ExpenseCategory) GetUpdatedAt() time.Time {
+	if e == nil || e.UpdatedAt == nil {
+		return time.Time{}
+	}
+
+	return *e.UpdatedAt
+}
+
+// GetIsActive returns the IsActive field if it's non-nil, zero value otherwise.
+func (e *ExpenseCategoryListOptions) GetIsActive() bool {
+	if e == nil || e.IsActive == nil {
+		return false
+	}
+
+	return *e.IsActive
+}
+
+// GetUpdatedSince returns the UpdatedSince field if it's non-nil, zero value otherwise.
+func (e *ExpenseCategoryListOptions) GetUpdatedSince() time.Time {
+	if e == nil || e.UpdatedSince == nil {
+		return time.Time{}
+	}
+
+	return *e.UpdatedSince
+}
+
+// GetIsActive returns the IsActive field if it's non-nil, zero value otherwise.
+func (e *ExpenseCategoryRequest) GetIsActive() bool {
+	if e == nil || e.IsActive == nil {
+		return false
+	}
+
+	return *e.IsActive
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (e *ExpenseCategoryRequest) GetName() string {
+	if e == nil || e.Name == nil {
+		return ""
+	}
+
+	return *e.Name
+}
+
+// GetUnitName returns the UnitName field if it's non-nil, zero value otherwise.
+func (e *ExpenseCategoryRequest) GetUnitName() string {
+	if e == nil || e.UnitName == nil {
+		return ""
+	}
+
+	return *e.UnitName
+}
+
+// GetUnitPrice returns the UnitPrice field if it's non-nil, zero value otherwise.
+func (e *ExpenseCategoryRequest) GetUnitPrice() float64 {
+	if e == nil || e.UnitPrice == nil {
+		return 0
+	}
+
+	return *e.UnitPrice
+}
+
+// GetBillable returns the Billable field if it's non-nil, zero value otherwise.
+func (e *ExpenseCreateRequest) GetBillable() bool {
+	if e == nil || e.Billable == nil {
+		return false
+	}
+
+	return *e.Billable
+}
+
+// GetExpenseCategoryID returns the ExpenseCategoryID field if it's non-nil, zero value otherwise.
+func (e *ExpenseCreateRequest) GetExpenseCategoryID() int64 {
+	if e == nil || e.ExpenseCategoryID == nil {
+		return 0
+	}
+
+	return *e.ExpenseCategoryID
+}
+
+// GetNotes returns the Notes field if it's non-nil, zero value otherwise.
+func (e *ExpenseCreateRequest) GetNotes() string {
+	if e == nil || e.Notes == nil {
+		return ""
+	}
+
+	return *e.Notes
+}
+
+// GetProjectID returns the ProjectID field if it's non-nil, zero value otherwise.
+func (e *ExpenseCreateRequest) GetProjectID() int64 {
+	if e == nil || e.ProjectID == nil {
+		return 0
+	}
+
+	return *e.ProjectID
+}
+
+// GetSpentDate returns the SpentDate field if it's non-nil, zero value otherwise.
+func (e *ExpenseCreateRequest) GetSpentDate() Date {
+	if e == nil || e.SpentDate == nil {
+		return Date{}
+	}
+
+	return *e.SpentDate
+}
+
+// GetTotalCost returns the TotalCost field if it's non-nil, zero value otherwise.
+func (e *ExpenseCreateRequest) GetTotalCost() float64 {
+	if e == nil || e.TotalCost == nil {
+		return 0
+	}
+
+	return *e.TotalCost
+}
+
+// GetUnits returns the Units field if it's non-nil, zero value otherwise.
+func (e *ExpenseCreateRequest) GetUnits() int64 {
+	if e == nil || e.Units == nil {
+		return 0
+	}
+
+	return *e.Units
+}
+
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (e *ExpenseCreateRequest) GetUserID() int64 {
+	if e == nil || e.UserID == nil {
+		return 0
+	}
+
+	return *e.UserID
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (e *ExpenseListOptions) GetClientID() int64 {
+	if e == nil || e.ClientID == nil {
+		return 0
+	}
+
+	return *e.ClientID
+}
+
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (e *ExpenseListOptions) GetFrom() Date {
+	if e == nil || e.From == nil {
+		return Date{}
+	}
+
+	return *e.From
+}
+
+// GetIsBilled returns the IsBilled field if it's non-nil, zero value otherwise.
+func (e *ExpenseListOptions) GetIsBilled() bool {
+	if e == nil || e.IsBilled == nil {
+		return false
+	}
+
+	return *e.IsBilled
+}
+
+// GetProjectID returns the ProjectID field if it's non-nil, zero value otherwise.
+func (e *ExpenseListOptions) GetProjectID() int64 {
+	if e == nil || e.ProjectID == nil {
+		return 0
+	}
+
+	return *e.ProjectID
+}
+
+// GetTo returns the To field if it's non-nil, zero value otherwise.
+func (e *ExpenseListOptions) GetTo() Date {
+	if e == nil || e.To == nil {
+		return Date{}
+	}
+
+	return *e.To
+}
+
+// GetUpdatedSince returns the UpdatedSince field if it's non-nil, zero value otherwise.
+func (e *ExpenseListOptions) GetUpdatedSince() time.Time {
+	if e == nil || e.UpdatedSince == nil {
+		return time.Time{}
+	}
+
+	return *e.UpdatedSince
+}
+
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (e *ExpenseListOptions) GetUserID() int64 {
+	if e == nil || e.UserID == nil {
+		return 0
+	}
+
+	return *e.UserID
+}
+
+// GetBillable returns the Billable field if it's non-nil, zero value otherwise.
+func (e *ExpenseUpdateRequest) GetBillable() bool {
+	if e == nil || e.Billable == nil {
+		return false
+	}
+
+	return *e.Billable
+}
+
+// GetDeleteReceipt returns the DeleteReceipt field if it's non-nil, zero value otherwise.
+func (e *ExpenseUpdateRequest) GetDeleteReceipt() bool {
+	if e == nil || e.DeleteReceipt == nil {
+		return false
+	}
+
+	return *e.DeleteReceipt
+}
+
+// GetExpenseCategoryID returns the ExpenseCategoryID field if it's non-nil, zero value otherwise.
+func (e *ExpenseUpdateRequest) GetExpenseCategoryID() int64 {
+	if e == nil || e.ExpenseCategoryID == nil {
+		return 0
+	}
+
+	return *e.ExpenseCategoryID
+}
+
+// GetNotes returns the Notes field if it's non-nil, zero value otherwise.
+func (e *ExpenseUpdateRequest) GetNotes() string {
+	if e == nil || e.Notes == nil {
+		return ""
+	}
+
+	return *e.Notes
+}
+
+// GetProjectID returns the ProjectID field if it's non-nil, zero value otherwise.
+func (e *ExpenseUpdateRequest) GetProjectID() int64 {
+	if e == nil || e.ProjectID == nil {
+		return 0
+	}
+
+	return *e.ProjectID
+}
+
+// GetSpentDate returns the SpentDate field if it's non-nil, zero value otherwise.
+func (e *ExpenseUpdateRequest) GetSpentDate() Date {
+	if e == nil || e.SpentDate == nil {
+		return Date{}
+	}
+
+	return *e.SpentDate
+}
+
+// GetTotalCost returns the TotalCost field if it's non-nil, zero value otherwise.
+func (e *ExpenseUpdateRequest) GetTotalCost() float64 {
+	if e == nil || e.TotalCost == nil {
+		return 0
+	}
+
+	return *e.TotalCost
+}
+
+// GetUnits returns the Units field if it's non-nil, zero value otherwise.
+func (e *ExpenseUpdateRequest) GetUnits() int64 {
+	if e == nil || e.Units == nil {
+		return 0
+	}
+
+	return *e.Units
+}
+
+// GetGroupID returns the GroupID field if it's non-nil, zero value otherwise.
+func (e *ExternalReference) GetGroupID() string {
+	if e == nil || e.GroupID == nil {
+		return ""
+	}
+
+	return *e.GroupID
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (e *ExternalReference) GetID() string {
+	if e == nil || e.ID == nil {
+		return ""
+	}
+
+	return *e.ID
+}
+
+// GetPermalink returns the Permalink field if it's non-nil, zero value otherwise.
+func (e *ExternalReference) GetPermalink() string {
+	if e == nil || e.Permalink == nil {
+		return ""
+	}
+
+	return *e.Permalink
+}
+
+// GetService returns the Service field if it's non-nil, zero value otherwise.
+func (e *ExternalReference) GetService() string {
+	if e == nil || e.Service == nil {
+		return ""
+	}
+
+	return *e.Service
+}
+
+// GetServiceIconURL returns the ServiceIconURL field if it's non-nil, zero value otherwise.
+func (e *ExternalReference) GetServiceIconURL() string {
+	if e == nil || e.ServiceIconURL == nil {
+		return ""
+	}
+
+	return *e.ServiceIconURL
+}
+
+// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
+func (i *Invoice) GetAmount() float64 {
+	if i == nil || i.Amount == nil {
+		return 0
+	}
+
+	return *i.Amount
+}
+
+// GetClient returns the Client field, or nil if Invoice is nil.
+func (i *Invoice) GetClient() *Client {
+	if i == nil {
+		return nil
+	}
+
+	return i.Client
+}
+
+// GetClientKey returns the ClientKey field if it's non-nil, zero value otherwise.
+func (i *Invoice) GetClientKey() string {
+	if i == nil || i.ClientKey == nil {
+		return ""
+	}
+
+	return *i.ClientKey
+}
+
+// GetClosedAt returns the ClosedAt field if it's non-nil, zero value otherwise.
+func (i *Invoice) GetClosedAt() time.Time {
+	if i == nil || i.ClosedAt == nil {
+		return time.Time{}
+	}
+
+	return *i.ClosedAt
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *Invoice) GetCreatedAt() time.Time {
+	if i == nil || i.CreatedAt == nil {
+		return time.Time{}
+	}
+
+	return *i.CreatedAt
+}
+
+// GetCreator returns the Creator field, or nil if Invoice is nil.
+func (i *Invoice) GetCreator() *User {
+	if i == nil {
+		return nil
+	}
+
+	return i.Creator
+}
+
+// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
+func (i *Invoice) GetCurrency() string {
+	if i == nil || i.Currency == nil {
+		return ""
+	}
+
+	return *i.Currency
+}
+
+// GetDiscount returns the Discount field if it's non-nil, zero value otherwise.
+func (i *Invoice) GetDiscount() float64 {
+	if i == nil || i.Discount == nil {
+		return 0
+	}
+
+	return *i.Discount
+}
+
+// GetDiscountAmount returns the DiscountAmount field if it's non-nil, zero value otherwise.
+func (i *Invoice) GetDiscountAmount() float64 {
+	if i == nil || i.DiscountAmount == nil {
+		return 0
+	}
+
+	return *i.DiscountAmount
+}
+
+// GetDueAmount returns the DueAmount field if it's non-nil, zero value otherwise.
+func (i *Invoice) GetDueAmount() float64 {
+	if i == nil || i.DueAmount == nil {
+		return 0
+	}
+
+	return *i.DueAmount
+}
+
+// GetDueDate returns the DueDate field if it's non-nil, zero value otherwise.
+func (i *Invoice) GetDueDate() Date {
+	if i == nil || i.DueDate == nil {
+		return Date{}
+	}
+
+	return *i.DueDate
+}
+
+// GetEstimate returns the Estimate field, or nil if Invoice is nil.
+func (i *Invoice) GetEstimate() *Estimate {
+	if i == nil {
+		return nil
+	}
+
+	return i.Estimate
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *Invoice) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
+	}
+
+	return *i.ID
+}
+
+// GetIssueDate returns the IssueDate field if it's non-nil, zero value otherwise.
+func (i *Invoice) GetIssueDate() Date {
+	if i == nil || i.IssueDate == nil {
+		return Date{}
+	}
+
+	return *i.IssueDate
+}
+
+// GetLineItems returns the LineItems field if it's non-nil, zero value otherwise.
+func (i *Invoice) GetLineItems() []InvoiceLineItem {
+	if i == nil || i.LineItems == nil {
+		return nil
+	}
+
+	return *i.LineItems
+}
+
+// GetNotes returns the Notes field if it's non-nil, zero value otherwise.
+func (i *Invoice) GetNotes() string {
+	if i == nil || i.Notes == nil {
+		return ""
+	}
+
+	return *i.Notes
+}
+
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (i *Invoice) GetNumber() string {
+	if i == nil || i.Number == nil {
+		return ""
+	}
+
+	return *i.Number
+}
+
+// GetPaidAt returns the PaidAt field if it's non-nil, zero value otherwise.
+func (i *Invoice) GetPaidAt() time.Time {
+	if i == nil || i.PaidAt == nil {
+		return time.Time{}
+	}
+
+	return *i.PaidAt
+}
+
+// GetPaymentOptions returns the PaymentOptions field if it's non-nil, zero value otherwise.
+func (i *Invoice) GetPaymentOptions() []string {
+	if i == nil || i.PaymentOptions == nil {
+		return nil
+	}
+
+	return *i.PaymentOptions
+}
+
+// GetPaymentTerm returns the PaymentTerm field if it's non-nil, zero value otherwise.
+func (i *Invoice) GetPaymentTerm() string {
+	if i == nil || i.PaymentTerm == nil {
+		return ""
+	}
+
+	return *i.PaymentTerm
+}
+
+// GetPeriodEnd returns the PeriodEnd field if it's non-nil, zero value otherwise.
+func (i *Invoice) GetPeriodEnd() Date {
+	if i == nil || i.PeriodEnd == nil {
+		return Date{}
+	}
+
+	return *i.PeriodEnd
+}
+
+// GetPeriodStart returns the PeriodStart field if it's non-nil, zero value otherwise.
+func (i *Invoice) GetPeriodStart() Date {
+	if i == nil || i.PeriodStart == nil {
+		return Date{}
+	}
+
+	return *i.PeriodStart
+}
+
+// GetPurchaseOrder returns the PurchaseOrder field if it's non-nil, zero value otherwise.
+func (i *Invoice) GetPurchaseOrder() string {
+	if i == nil || i.PurchaseOrder == nil {
+		return ""
+	}
+
+	return *i.PurchaseOrder
+}
+
+// GetSentAt returns the SentAt field if it's non-nil, zero value otherwise.
+func (i *Invoice) GetSentAt() time.Time {
+	if i == nil || i.SentAt == nil {
+		return time.Time{}
+	}
+
+	return *i.SentAt
+}
+
+// GetState returns the State field if it's non-nil, zero value otherwise.
+func (i *Invoice) GetState() string {
+	if i == nil || i.State == nil {
+		return ""
+	}
+
+	return *i.State
+}
+
+// GetSubject returns the Subject field if it's non-nil, zero value otherwise.
+func (i *Invoice) GetSubject() string {
+	if i == nil || i.Subject == nil {
+		return ""
+	}
+
+	return *i.Subject
+}
+
+// GetTax returns the Tax field if it's non-nil, zero value otherwise.
+func (i *Invoice) GetTax() float64 {
+	if i == nil || i.Tax == nil {
+		return 0
+	}
+
+	return *i.Tax
+}
+
+// GetTax2 returns the Tax2 field if it's non-nil, zero value otherwise.
+func (i *Invoice) GetTax2() float64 {
+	if i == nil || i.Tax2 == nil {
+		return 0
+	}
+
+	return *i.Tax2
+}
+
+// GetTax2Amount returns the Tax2Amount field if it's non-nil, zero value otherwise.
+func (i *Invoice) GetTax2Amount() float64 {
+	if i == nil || i.Tax2Amount == nil {
+		return 0
+	}
+
+	return *i.Tax2Amount
+}
+
+// GetTaxAmount returns the TaxAmount field if it's non-nil, zero value otherwise.
+func (i *Invoice) GetTaxAmount() float64 {
+	if i == nil || i.TaxAmount == nil {
+		return 0
+	}
+
+	return *i.TaxAmount
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (i *Invoice) GetUpdatedAt() time.Time {
+	if i == nil || i.UpdatedAt == nil {
+		return time.Time{}
+	}
+
+	return *i.UpdatedAt
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (i *InvoiceCreateRequest) GetClientID() int64 {
+	if i == nil || i.ClientID == nil {
+		return 0
+	}
+
+	return *i.ClientID
+}
+
+// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
+func (i *InvoiceCreateRequest) GetCurrency() string {
+	if i == nil || i.Currency == nil {
+		return ""
+	}
+
+	return *i.Currency
+}
+
+// GetDiscount returns the Discount field if it's non-nil, zero value otherwise.
+func (i *InvoiceCreateRequest) GetDiscount() float64 {
+	if i == nil || i.Discount == nil {
+		return 0
+	}
+
+	return *i.Discount
+}
+
+// GetDueDate returns the DueDate field if it's non-nil, zero value otherwise.
+func (i *InvoiceCreateRequest) GetDueDate() Date {
+	if i == nil || i.DueDate == nil {
+		return Date{}
+	}
+
+	return *i.DueDate
+}
+
+// GetEstimateID returns the EstimateID field if it's non-nil, zero value otherwise.
+func (i *InvoiceCreateRequest) GetEstimateID() int64 {
+	if i == nil || i.EstimateID == nil {
+		return 0
+	}
+
+	return *i.EstimateID
+}
+
+// GetIssueDate returns the IssueDate field if it's non-nil, zero value otherwise.
+func (i *InvoiceCreateRequest) GetIssueDate() Date {
+	if i == nil || i.IssueDate == nil {
+		return Date{}
+	}
+
+	return *i.IssueDate
+}
+
+// GetLineItems returns the LineItems field if it's non-nil, zero value otherwise.
+func (i *InvoiceCreateRequest) GetLineItems() []InvoiceLineItemRequest {
+	if i == nil || i.LineItems == nil {
+		return nil
+	}
+
+	return *i.LineItems
+}
+
+// GetLineItemsImport returns the LineItemsImport field, or nil if InvoiceCreateRequest is nil.
+func (i *InvoiceCreateRequest) GetLineItemsImport() *InvoiceLineItemImportRequest {
+	if i == nil {
+		return nil
+	}
+
+	return i.LineItemsImport
+}
+
+// GetNotes returns the Notes field if it's non-nil, zero value otherwise.
+func (i *InvoiceCreateRequest) GetNotes() string {
+	if i == nil || i.Notes == nil {
+		return ""
+	}
+
+	return *i.Notes
+}
+
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (i *InvoiceCreateRequest) GetNumber() string {
+	if i == nil || i.Number == nil {
+		return ""
+	}
+
+	return *i.Number
+}
+
+// GetPaymentOptions returns the PaymentOptions field if it's non-nil, zero value otherwise.
+func (i *InvoiceCreateRequest) GetPaymentOptions() []string {
+	if i == nil || i.PaymentOptions == nil {
+		return nil
+	}
+
+	return *i.PaymentOptions
+}
+
+// GetPaymentTerm returns the PaymentTerm field if it's non-nil, zero value otherwise.
+func (i *InvoiceCreateRequest) GetPaymentTerm() string {
+	if i == nil || i.PaymentTerm == nil {
+		return ""
+	}
+
+	return *i.PaymentTerm
+}
+
+// GetPurchaseOrder returns the PurchaseOrder field if it's non-nil, zero value otherwise.
+func (i *InvoiceCreateRequest) GetPurchaseOrder() string {
+	if i == nil || i.PurchaseOrder == nil {
+		return ""
+	}
+
+	return *i.PurchaseOrder
+}
+
+// GetRetainerID returns the RetainerID field if it's non-nil, zero value otherwise.
+func (i *InvoiceCreateRequest) GetRetainerID() int64 {
+	if i == nil || i.RetainerID == nil {
+		return 0
+	}
+
+	return *i.RetainerID
+}
+
+// GetSubject returns the Subject field if it's non-nil, zero value otherwise.
+func (i *InvoiceCreateRequest) GetSubject() string {
+	if i == nil || i.Subject == nil {
+		return ""
+	}
+
+	return *i.Subject
+}
+
+// GetTax returns the Tax field if it's non-nil, zero value otherwise.
+func (i *InvoiceCreateRequest) GetTax() float64 {
+	if i == nil || i.Tax == nil {
+		return 0
+	}
+
+	return *i.Tax
+}
+
+// GetTax2 returns the Tax2 field if it's non-nil, zero value otherwise.
+func (i *InvoiceCreateRequest) GetTax2() float64 {
+	if i == nil || i.Tax2 == nil {
+		return 0
+	}
+
+	return *i.Tax2
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *InvoiceItemCategory) GetCreatedAt() time.Time {
+	if i == nil || i.CreatedAt == nil {
+		return time.Time{}
+	}
+
+	return *i.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *InvoiceItemCategory) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
+	}
+
+	return *i.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (i *InvoiceItemCategory) GetName() string {
+	if i == nil || i.Name == nil {
+		return ""
+	}
+
+	return *i.Name
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (i *InvoiceItemCategory) GetUpdatedAt() time.Time {
+	if i == nil || i.UpdatedAt == nil {
+		return time.Time{}
+	}
+
+	return *i.UpdatedAt
+}
+
+// GetUseAsExpense returns the UseAsExpense field if it's non-nil, zero value otherwise.
+func (i *InvoiceItemCategory) GetUseAsExpense() bool {
+	if i == nil || i.UseAsExpense == nil {
+		return false
+	}
+
+	return *i.UseAsExpense
+}
+
+// GetUseAsService returns the UseAsService field if it's non-nil, zero value otherwise.
+func (i *InvoiceItemCategory) GetUseAsService() bool {
+	if i == nil || i.UseAsService == nil {
+		return false
+	}
+
+	return *i.UseAsService
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (i *InvoiceItemCategoryRequest) GetName() string {
+	if i == nil || i.Name == nil {
+		return ""
+	}
+
+	return *i.Name
+}
+
+// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
+func (i *InvoiceLineItem) GetAmount() float64 {
+	if i == nil || i.Amount == nil {
+		return 0
+	}
+
+	return *i.Amount
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (i *InvoiceLineItem) GetDescription() string {
+	if i == nil || i.Description == nil {
+		return ""
+	}
+
+	return *i.Description
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *InvoiceLineItem) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
+	}
+
+	return *i.ID
+}
+
+// GetKind returns the Kind field if it's non-nil, zero value otherwise.
+func (i *InvoiceLineItem) GetKind() string {
+	if i == nil || i.Kind == nil {
+		return ""
+	}
+
+	return *i.Kind
+}
+
+// GetProject returns the Project field, or nil if InvoiceLineItem is nil.
+func (i *InvoiceLineItem) GetProject() *Project {
+	if i == nil {
+		return nil
+	}
+
+	return i.Project
+}
+
+// GetQuantity returns the Quantity field if it's non-nil, zero value otherwise.
+func (i *InvoiceLineItem) GetQuantity() float64 {
+	if i == nil || i.Quantity == nil {
+		return 0
+	}
+
+	return *i.Quantity
+}
+
+// GetTaxed returns the Taxed field if it's non-nil, zero value otherwise.
+func (i *InvoiceLineItem) GetTaxed() bool {
+	if i == nil || i.Taxed == nil {
+		return false
+	}
+
+	return *i.Taxed
+}
+
+// GetTaxed2 returns the Taxed2 field if it's non-nil, zero value otherwise.
+func (i *InvoiceLineItem) GetTaxed2() bool {
+	if i == nil || i.Taxed2 == nil {
+		return false
+	}
+
+	return *i.Taxed2
+}
+
+// GetUnitPrice returns the UnitPrice field if it's non-nil, zero value otherwise.
+func (i *InvoiceLineItem) GetUnitPrice() float64 {
+	if i == nil || i.UnitPrice == nil {
+		return 0
+	}
+
+	return *i.UnitPrice
+}
+
+// GetAttachReceipt returns the AttachReceipt field if it's non-nil, zero value otherwise.
+func (i *InvoiceLineItemImportExpenseRequest) GetAttachReceipt() bool {
+	if i == nil || i.AttachReceipt == nil {
+		return false
+	}
+
+	return *i.AttachReceipt
+}
+
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (i *InvoiceLineItemImportExpenseRequest) GetFrom() Date {
+	if i == nil || i.From == nil {
+		return Date{}
+	}
+
+	return *i.From
+}
+
+// GetSummaryType returns the SummaryType field if it's non-nil, zero value otherwise.
+func (i *InvoiceLineItemImportExpenseRequest) GetSummaryType() string {
+	if i == nil || i.SummaryType == nil {
+		return ""
+	}
+
+	return *i.SummaryType
+}
+
+// GetTo returns the To field if it's non-nil, zero value otherwise.
+func (i *InvoiceLineItemImportExpenseRequest) GetTo() Date {
+	if i == nil || i.To == nil {
+		return Date{}
+	}
+
+	return *i.To
+}
+
+// GetExpenses returns the Expenses field, or nil if InvoiceLineItemImportRequest is nil.
+func (i *InvoiceLineItemImportRequest) GetExpenses() *InvoiceLineItemImportExpenseRequest {
+	if i == nil {
+		return nil
+	}
+
+	return i.Expenses
+}
+
+// GetProjectIDs returns the ProjectIDs field if it's non-nil, zero value otherwise.
+func (i *InvoiceLineItemImportRequest) GetProjectIDs() []int64 {
+	if i == nil || i.ProjectIDs == nil {
+		return nil
+	}
+
+	return *i.ProjectIDs
+}
+
+// GetTime returns the Time field, or nil if InvoiceLineItemImportRequest is nil.
+func (i *InvoiceLineItemImportRequest) GetTime() *InvoiceLineItemImportTimeRequest {
+	if i == nil {
+		return nil
+	}
+
+	return i.Time
+}
+
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (i *InvoiceLineItemImportTimeRequest) GetFrom() Date {
+	if i == nil || i.From == nil {
+		return Date{}
+	}
+
+	return *i.From
+}
+
+// GetSummaryType returns the SummaryType field if it's non-nil, zero value otherwise.
+func (i *InvoiceLineItemImportTimeRequest) GetSummaryType() string {
+	if i == nil || i.SummaryType == nil {
+		return ""
+	}
+
+	return *i.SummaryType
+}
+
+// GetTo returns the To field if it's non-nil, zero value otherwise.
+func (i *InvoiceLineItemImportTimeRequest) GetTo() Date {
+	if i == nil || i.To == nil {
+		return Date{}
+	}
+
+	return *i.To
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (i *InvoiceLineItemRequest) GetDescription() string {
+	if i == nil || i.Description == nil {
+		return ""
+	}
+
+	return *i.Description
+}
+
+// GetDestroy returns the Destroy field if it's non-nil, zero value otherwise.
+func (i *InvoiceLineItemRequest) GetDestroy() bool {
+	if i == nil || i.Destroy == nil {
+		return false
+	}
+
+	return *i.Destroy
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *InvoiceLineItemRequest) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
+	}
+
+	return *i.ID
+}
+
+// GetKind returns the Kind field if it's non-nil, zero value otherwise.
+func (i *InvoiceLineItemRequest) GetKind() string {
+	if i == nil || i.Kind == nil {
+		return ""
+	}
+
+	return *i.Kind
+}
+
+// GetProjectID returns the ProjectID field if it's non-nil, zero value otherwise.
+func (i *InvoiceLineItemRequest) GetProjectID() int64 {
+	if i == nil || i.ProjectID == nil {
+		return 0
+	}
+
+	return *i.ProjectID
+}
+
+// GetQuantity returns the Quantity field if it's non-nil, zero value otherwise.
+func (i *InvoiceLineItemRequest) GetQuantity() int64 {
+	if i == nil || i.Quantity == nil {
+		return 0
+	}
+
+	return *i.Quantity
+}
+
+// GetTaxed returns the Taxed field if it's non-nil, zero value otherwise.
+func (i *InvoiceLineItemRequest) GetTaxed() bool {
+	if i == nil || i.Taxed == nil {
+		return false
+	}
+
+	return *i.Taxed
+}
+
+// GetTaxed2 returns the Taxed2 field if it's non-nil, zero value otherwise.
+func (i *InvoiceLineItemRequest) GetTaxed2() bool {
+	if i == nil || i.Taxed2 == nil {
+		return false
+	}
+
+	return *i.Taxed2
+}
+
+// GetUnitPrice returns the UnitPrice field if it's non-nil, zero value otherwise.
+func (i *InvoiceLineItemRequest) GetUnitPrice() float64 {
+	if i == nil || i.UnitPrice == nil {
+		return 0
+	}
+
+	return *i.UnitPrice
+}
+
+// GetAttachPdf returns the AttachPdf field if it's non-nil, zero value otherwise.
+func (i *InvoiceMessage) GetAttachPdf() bool {
+	if i == nil || i.AttachPdf == nil {
+		return false
+	}
+
+	return *i.AttachPdf
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (i *InvoiceMessage) GetBody() string {
+	if i == nil || i.Body == nil {
+		return ""
+	}
+
+	return *i.Body
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *InvoiceMessage) GetCreatedAt() time.Time {
+	if i == nil || i.CreatedAt == nil {
+		return time.Time{}
+	}
+
+	return *i.CreatedAt
+}
+
+// GetEventType returns the EventType field if it's non-nil, zero value otherwise.
+func (i *InvoiceMessage) GetEventType() string {
+	if i == nil || i.EventType == nil {
+		return ""
+	}
+
+	return *i.EventType
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *InvoiceMessage) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
+	}
+
+	return *i.ID
+}
+
+// GetIncludeLinkToClientInvoice returns the IncludeLinkToClientInvoice field if it's non-nil, zero value otherwise.
+func (i *InvoiceMessage) GetIncludeLinkToClientInvoice() bool {
+	if i == nil || i.IncludeLinkToClientInvoice == nil {
+		return false
+	}
+
+	return *i.IncludeLinkToClientInvoice
+}
+
+// GetRecipients returns the Recipients field if it's non-nil, zero value otherwise.
+func (i *InvoiceMessage) GetRecipients() []InvoiceMessageRecipient {
+	if i == nil || i.Recipients == nil {
+		return nil
+	}
+
+	return *i.Recipients
+}
+
+// GetReminder returns the Reminder field if it's non-nil, zero value otherwise.
+func (i *InvoiceMessage) GetReminder() bool {
+	if i == nil || i.Reminder == nil {
+		return false
+	}
+
+	return *i.Reminder
+}
+
+// GetSendMeACopy returns the SendMeACopy field if it's non-nil, zero value otherwise.
+func (i *InvoiceMessage) GetSendMeACopy() bool {
+	if i == nil || i.SendMeACopy == nil {
+		return false
+	}
+
+	return *i.SendMeACopy
+}
+
+// GetSendReminderOn returns the SendReminderOn field if it's non-nil, zero value otherwise.
+func (i *InvoiceMessage) GetSendReminderOn() Date {
+	if i == nil || i.SendReminderOn == nil {
+		return Date{}
+	}
+
+	return *i.SendReminderOn
+}
+
+// GetSentBy returns the SentBy field if it's non-nil, zero value otherwise.
+func (i *InvoiceMessage) GetSentBy() string {
+	if i == nil || i.SentBy == nil {
+		return ""
+	}
+
+	return *i.SentBy
+}
+
+// GetSentByEmail returns the SentByEmail field if it's non-nil, zero value otherwise.
+func (i *InvoiceMessage) GetSentByEmail() string {
+	if i == nil || i.SentByEmail == nil {
+		return ""
+	}
+
+	return *i.SentByEmail
+}
+
+// GetSentFrom returns the SentFrom field if it's non-nil, zero value otherwise.
+func (i *InvoiceMessage) GetSentFrom() string {
+	if i == nil || i.SentFrom == nil {
+		return ""
+	}
+
+	return *i.SentFrom
+}
+
+// GetSentFromEmail returns the SentFromEmail field if it's non-nil, zero value otherwise.
+func (i *InvoiceMessage) GetSentFromEmail() string {
+	if i == nil || i.SentFromEmail == nil {
+		return ""
+	}
+
+	return *i.SentFromEmail
+}
+
+// GetSubject returns the Subject field if it's non-nil, zero value otherwise.
+func (i *InvoiceMessage) GetSubject() string {
+	if i == nil || i.Subject == nil {
+		return ""
+	}
+
+	return *i.Subject
+}
+
+// GetThankYou returns the ThankYou field if it's non-nil, zero value otherwise.
+func (i *InvoiceMessage) GetThankYou() bool {
+	if i == nil || i.ThankYou == nil {
+		return false
+	}
+
+	return *i.ThankYou
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (i *InvoiceMessage) GetUpdatedAt() time.Time {
+	if i == nil || i.UpdatedAt == nil {
+		return time.Time{}
+	}
+
+	return *i.UpdatedAt
+}
+
+// GetAttachPdf returns the AttachPdf field if it's non-nil, zero value otherwise.
+func (i *InvoiceMessageCreateRequest) GetAttachPdf() bool {
+	if i == nil || i.AttachPdf == nil {
+		return false
+	}
+
+	return *i.AttachPdf
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (i *InvoiceMessageCreateRequest) GetBody() string {
+	if i == nil || i.Body == nil {
+		return ""
+	}
+
+	return *i.Body
+}
+
+// GetEventType returns the EventType field if it's non-nil, zero value otherwise.
+func (i *InvoiceMessageCreateRequest) GetEventType() bool {
+	if i == nil || i.EventType == nil {
+		return false
+	}
+
+	return *i.EventType
+}
+
+// GetIncludeLinkToClientInvoice returns the IncludeLinkToClientInvoice field if it's non-nil, zero value otherwise.
+func (i *InvoiceMessageCreateRequest) GetIncludeLinkToClientInvoice() bool {
+	if i == nil || i.IncludeLinkToClientInvoice == nil {
+		return false
+	}
+
+	return *i.IncludeLinkToClientInvoice
+}
+
+// GetRecipients returns the Recipients field if it's non-nil, zero value otherwise.
+func (i *InvoiceMessageCreateRequest) GetRecipients() []InvoiceMessageRecipient {
+	if i == nil || i.Recipients == nil {
+		return nil
+	}
+
+	return *i.Recipients
+}
+
+// GetSendMeACopy returns the SendMeACopy field if it's non-nil, zero value otherwise.
+func (i *InvoiceMessageCreateRequest) GetSendMeACopy() bool {
+	if i == nil || i.SendMeACopy == nil {
+		return false
+	}
+
+	return *i.SendMeACopy
+}
+
+// GetSubject returns the Subject field if it's non-nil, zero value otherwise.
+func (i *InvoiceMessageCreateRequest) GetSubject() string {
+	if i == nil || i.Subject == nil {
+		return ""
+	}
+
+	return *i.Subject
+}
+
+// GetThankYou returns the ThankYou field if it's non-nil, zero value otherwise.
+func (i *InvoiceMessageCreateRequest) GetThankYou() bool {
+	if i == nil || i.ThankYou == nil {
+		return false
+	}
+
+	return *i.ThankYou
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (i *InvoiceMessageRecipient) GetEmail() string {
+	if i == nil || i.Email == nil {
+		return ""
+	}
+
+	return *i.Email
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (i *InvoiceMessageRecipient) GetName() string {
+	if i == nil || i.Name == nil {
+		return ""
+	}
+
+	return *i.Name
+}
+
+// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
+func (i *InvoicePayment) GetAmount() float64 {
+	if i == nil || i.Amount == nil {
+		return 0
+	}
+
+	return *i.Amount
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (i *InvoicePayment) GetCreatedAt() time.Time {
+	if i == nil || i.CreatedAt == nil {
+		return time.Time{}
+	}
+
+	return *i.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (i *InvoicePayment) GetID() int64 {
+	if i == nil || i.ID == nil {
+		return 0
+	}
+
+	return *i.ID
+}
+
+// GetNotes returns the Notes field if it's non-nil, zero value otherwise.
+func (i *InvoicePayment) GetNotes() string {
+	if i == nil || i.Notes == nil {
+		return ""
+	}
+
+	return *i.Notes
+}
+
+// GetPaidAt returns the PaidAt field if it's non-nil, zero value otherwise.
+func (i *InvoicePayment) GetPaidAt() time.Time {
+	if i == nil || i.PaidAt == nil {
+		return time.Time{}
+	}
+
+	return *i.PaidAt
+}
+
+// GetPaymentGateway returns the PaymentGateway field, or nil if InvoicePayment is nil.
+func (i *InvoicePayment) GetPaymentGateway() *PaymentGateway {
+	if i == nil {
+		return nil
+	}
+
+	return i.PaymentGateway
+}
+
+// GetRecordedBy returns the RecordedBy field if it's non-nil, zero value otherwise.
+func (i *InvoicePayment) GetRecordedBy() string {
+	if i == nil || i.RecordedBy == nil {
+		return ""
+	}
+
+	return *i.RecordedBy
+}
+
+// GetRecordedByEmail returns the RecordedByEmail field if it's non-nil, zero value otherwise.
+func (i *InvoicePayment) GetRecordedByEmail() string {
+	if i == nil || i.RecordedByEmail == nil {
+		return ""
+	}
+
+	return *i.RecordedByEmail
+}
+
+// GetTransactionID returns the TransactionID field if it's non-nil, zero value otherwise.
+func (i *InvoicePayment) GetTransactionID() string {
+	if i == nil || i.TransactionID == nil {
+		return ""
+	}
+
+	return *i.TransactionID
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (i *InvoicePayment) GetUpdatedAt() time.Time {
+	if i == nil || i.UpdatedAt == nil {
+		return time.Time{}
+	}
+
+	return *i.UpdatedAt
+}
+
+// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
+func (i *InvoicePaymentRequest) GetAmount() float64 {
+	if i == nil || i.Amount == nil {
+		return 0
+	}
+
+	return *i.Amount
+}
+
+// GetNotes returns the Notes field if it's non-nil, zero value otherwise.
+func (i *InvoicePaymentRequest) GetNotes() string {
+	if i == nil || i.Notes == nil {
+		return ""
+	}
+
+	return *i.Notes
+}
+
+// GetPaidAt returns the PaidAt field if it's non-nil, zero value otherwise.
+func (i *InvoicePaymentRequest) GetPaidAt() time.Time {
+	if i == nil || i.PaidAt == nil {
+		return time.Time{}
+	}
+
+	return *i.PaidAt
+}
+
+// GetPaidDate returns the PaidDate field if it's non-nil, zero value otherwise.
+func (i *InvoicePaymentRequest) GetPaidDate() Date {
+	if i == nil || i.PaidDate == nil {
+		return Date{}
+	}
+
+	return *i.PaidDate
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (i *InvoiceUpdateRequest) GetClientID() int64 {
+	if i == nil || i.ClientID == nil {
+		return 0
+	}
+
+	return *i.ClientID
+}
+
+// GetCurrency returns the Currency field if it's non-nil, zero value otherwise.
+func (i *InvoiceUpdateRequest) GetCurrency() string {
+	if i == nil || i.Currency == nil {
+		return ""
+	}
+
+	return *i.Currency
+}
+
+// GetDiscount returns the Discount field if it's non-nil, zero value otherwise.
+func (i *InvoiceUpdateRequest) GetDiscount() float64 {
+	if i == nil || i.Discount == nil {
+		return 0
+	}
+
+	return *i.Discount
+}
+
+// GetDueDate returns the DueDate field if it's non-nil, zero value otherwise.
+func (i *InvoiceUpdateRequest) GetDueDate() Date {
+	if i == nil || i.DueDate == nil {
+		return Date{}
+	}
+
+	return *i.DueDate
+}
+
+// GetEstimateID returns the EstimateID field if it's non-nil, zero value otherwise.
+func (i *InvoiceUpdateRequest) GetEstimateID() int64 {
+	if i == nil || i.EstimateID == nil {
+		return 0
+	}
+
+	return *i.EstimateID
+}
+
+// GetIssueDate returns the IssueDate field if it's non-nil, zero value otherwise.
+func (i *InvoiceUpdateRequest) GetIssueDate() Date {
+	if i == nil || i.IssueDate == nil {
+		return Date{}
+	}
+
+	return *i.IssueDate
+}
+
+// GetLineItems returns the LineItems field if it's non-nil, zero value otherwise.
+func (i *InvoiceUpdateRequest) GetLineItems() []InvoiceLineItemRequest {
+	if i == nil || i.LineItems == nil {
+		return nil
+	}
+
+	return *i.LineItems
+}
+
+// GetNotes returns the Notes field if it's non-nil, zero value otherwise.
+func (i *InvoiceUpdateRequest) GetNotes() string {
+	if i == nil || i.Notes == nil {
+		return ""
+	}
+
+	return *i.Notes
+}
+
+// GetNumber returns the Number field if it's non-nil, zero value otherwise.
+func (i *InvoiceUpdateRequest) GetNumber() string {
+	if i == nil || i.Number == nil {
+		return ""
+	}
+
+	return *i.Number
+}
+
+// GetPurchaseOrder returns the PurchaseOrder field if it's non-nil, zero value otherwise.
+func (i *InvoiceUpdateRequest) GetPurchaseOrder() string {
+	if i == nil || i.PurchaseOrder == nil {
+		return ""
+	}
+
+	return *i.PurchaseOrder
+}
+
+// GetRetainerID returns the RetainerID field if it's non-nil, zero value otherwise.
+func (i *InvoiceUpdateRequest) GetRetainerID() int64 {
+	if i == nil || i.RetainerID == nil {
+		return 0
+	}
+
+	return *i.RetainerID
+}
+
+// GetSubject returns the Subject field if it's non-nil, zero value otherwise.
+func (i *InvoiceUpdateRequest) GetSubject() string {
+	if i == nil || i.Subject == nil {
+		return ""
+	}
+
+	return *i.Subject
+}
+
+// GetTax returns the Tax field if it's non-nil, zero value otherwise.
+func (i *InvoiceUpdateRequest) GetTax() float64 {
+	if i == nil || i.Tax == nil {
+		return 0
+	}
+
+	return *i.Tax
+}
+
+// GetTax2 returns the Tax2 field if it's non-nil, zero value otherwise.
+func (i *InvoiceUpdateRequest) GetTax2() float64 {
+	if i == nil || i.Tax2 == nil {
+		return 0
+	}
+
+	return *i.Tax2
+}
+
+// GetFirst returns the First field if it's non-nil, zero value otherwise.
+func (p *PageLinks) GetFirst() string {
+	if p == nil || p.First == nil {
+		return ""
+	}
+
+	return *p.First
+}
+
+// GetLast returns the Last field if it's non-nil, zero value otherwise.
+func (p *PageLinks) GetLast() string {
+	if p == nil || p.Last == nil {
+		return ""
+	}
+
+	return *p.Last
+}
+
+// GetNext returns the Next field if it's non-nil, zero value otherwise.
+func (p *PageLinks) GetNext() string {
+	if p == nil || p.Next == nil {
+		return ""
+	}
+
+	return *p.Next
+}
+
+// GetPrevious returns the Previous field if it's non-nil, zero value otherwise.
+func (p *PageLinks) GetPrevious() string {
+	if p == nil || p.Previous == nil {
+		return ""
+	}
+
+	return *p.Previous
+}
+
+// GetLinks returns the Links field, or nil if Pagination is nil.
+func (p *Pagination) GetLinks() *PageLinks {
+	if p == nil {
+		return nil
+	}
+
+	return p.Links
+}
+
+// GetNextPage returns the NextPage field if it's non-nil, zero value otherwise.
+func (p *Pagination) GetNextPage() int {
+	if p == nil || p.NextPage == nil {
+		return 0
+	}
+
+	return *p.NextPage
+}
+
+// GetPage returns the Page field if it's non-nil, zero value otherwise.
+func (p *Pagination) GetPage() int {
+	if p == nil || p.Page == nil {
+		return 0
+	}
+
+	return *p.Page
+}
+
+// GetPerPage returns the PerPage field if it's non-nil, zero value otherwise.
+func (p *Pagination) GetPerPage() int {
+	if p == nil || p.PerPage == nil {
+		return 0
+	}
+
+	return *p.PerPage
+}
+
+// GetPreviousPage returns the PreviousPage field if it's non-nil, zero value otherwise.
+func (p *Pagination) GetPreviousPage() int {
+	if p == nil || p.PreviousPage == nil {
+		return 0
+	}
+
+	return *p.PreviousPage
+}
+
+// GetTotalEntries returns the TotalEntries field if it's non-nil, zero value otherwise.
+func (p *Pagination) GetTotalEntries() int {
+	if p == nil || p.TotalEntries == nil {
+		return 0
+	}
+
+	return *p.TotalEntries
+}
+
+// GetTotalPages returns the TotalPages field if it's non-nil, zero value otherwise.
+func (p *Pagination) GetTotalPages() int {
+	if p == nil || p.TotalPages == nil {
+		return 0
+	}
+
+	return *p.TotalPages
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *PaymentGateway) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+
+	return *p.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *PaymentGateway) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+
+	return *p.Name
+}
+
+// GetBillBy returns the BillBy field if it's non-nil, zero value otherwise.
+func (p *Project) GetBillBy() string {
+	if p == nil || p.BillBy == nil {
+		return ""
+	}
+
+	return *p.BillBy
+}
+
+// GetBudget returns the Budget field if it's non-nil, zero value otherwise.
+func (p *Project) GetBudget() float64 {
+	if p == nil || p.Budget == nil {
+		return 0
+	}
+
+	return *p.Budget
+}
+
+// GetBudgetBy returns the BudgetBy field if it's non-nil, zero value otherwise.
+func (p *Project) GetBudgetBy() string {
+	if p == nil || p.BudgetBy == nil {
+		return ""
+	}
+
+	return *p.BudgetBy
+}
+
+// GetBudgetIsMonthly returns the BudgetIsMonthly field if it's non-nil, zero value otherwise.
+func (p *Project) GetBudgetIsMonthly() bool {
+	if p == nil || p.BudgetIsMonthly == nil {
+		return false
+	}
+
+	return *p.BudgetIsMonthly
+}
+
+// GetClient returns the Client field, or nil if Project is nil.
+func (p *Project) GetClient() *Client {
+	if p == nil {
+		return nil
+	}
+
+	return p.Client
+}
+
+// GetCode returns the Code field if it's non-nil, zero value otherwise.
+func (p *Project) GetCode() string {
+	if p == nil || p.Code == nil {
+		return ""
+	}
+
+	return *p.Code
+}
+
+// GetCostBudget returns the CostBudget field if it's non-nil, zero value otherwise.
+func (p *Project) GetCostBudget() float64 {
+	if p == nil || p.CostBudget == nil {
+		return 0
+	}
+
+	return *p.CostBudget
+}
+
+// GetCostBudgetIncludeExpenses returns the CostBudgetIncludeExpenses field if it's non-nil, zero value otherwise.
+func (p *Project) GetCostBudgetIncludeExpenses() bool {
+	if p == nil || p.CostBudgetIncludeExpenses == nil {
+		return false
+	}
+
+	return *p.CostBudgetIncludeExpenses
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *Project) GetCreatedAt() time.Time {
+	if p == nil || p.CreatedAt == nil {
+		return time.Time{}
+	}
+
+	return *p.CreatedAt
+}
+
+// GetEndsOn returns the EndsOn field if it's non-nil, zero value otherwise.
+func (p *Project) GetEndsOn() Date {
+	if p == nil || p.EndsOn == nil {
+		return Date{}
+	}
+
+	return *p.EndsOn
+}
+
+// GetFee returns the Fee field if it's non-nil, zero value otherwise.
+func (p *Project) GetFee() float64 {
+	if p == nil || p.Fee == nil {
+		return 0
+	}
+
+	return *p.Fee
+}
+
+// GetHourlyRate returns the HourlyRate field if it's non-nil, zero value otherwise.
+func (p *Project) GetHourlyRate() float64 {
+	if p == nil || p.HourlyRate == nil {
+		return 0
+	}
+
+	return *p.HourlyRate
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *Project) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+
+	return *p.ID
+}
+
+// GetIsActive returns the IsActive field if it's non-nil, zero value otherwise.
+func (p *Project) GetIsActive() bool {
+	if p == nil || p.IsActive == nil {
+		return false
+	}
+
+	return *p.IsActive
+}
+
+// GetIsBillable returns the IsBillable field if it's non-nil, zero value otherwise.
+func (p *Project) GetIsBillable() bool {
+	if p == nil || p.IsBillable == nil {
+		return false
+	}
+
+	return *p.IsBillable
+}
+
+// GetIsFixedFee returns the IsFixedFee field if it's non-nil, zero value otherwise.
+func (p *Project) GetIsFixedFee() bool {
+	if p == nil || p.IsFixedFee == nil {
+		return false
+	}
+
+	return *p.IsFixedFee
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *Project) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+
+	return *p.Name
+}
+
+// GetNotes returns the Notes field if it's non-nil, zero value otherwise.
+func (p *Project) GetNotes() string {
+	if p == nil || p.Notes == nil {
+		return ""
+	}
+
+	return *p.Notes
+}
+
+// GetNotifyWhenOverBudget returns the NotifyWhenOverBudget field if it's non-nil, zero value otherwise.
+func (p *Project) GetNotifyWhenOverBudget() bool {
+	if p == nil || p.NotifyWhenOverBudget == nil {
+		return false
+	}
+
+	return *p.NotifyWhenOverBudget
+}
+
+// GetOverBudgetNotificationDate returns the OverBudgetNotificationDate field if it's non-nil, zero value otherwise.
+func (p *Project) GetOverBudgetNotificationDate() Date {
+	if p == nil || p.OverBudgetNotificationDate == nil {
+		return Date{}
+	}
+
+	return *p.OverBudgetNotificationDate
+}
+
+// GetOverBudgetNotificationPercentage returns the OverBudgetNotificationPercentage field if it's non-nil, zero value otherwise.
+func (p *Project) GetOverBudgetNotificationPercentage() float64 {
+	if p == nil || p.OverBudgetNotificationPercentage == nil {
+		return 0
+	}
+
+	return *p.OverBudgetNotificationPercentage
+}
+
+// GetShowBudgetToAll returns the ShowBudgetToAll field if it's non-nil, zero value otherwise.
+func (p *Project) GetShowBudgetToAll() bool {
+	if p == nil || p.ShowBudgetToAll == nil {
+		return false
+	}
+
+	return *p.ShowBudgetToAll
+}
+
+// GetStartsOn returns the StartsOn field if it's non-nil, zero value otherwise.
+func (p *Project) GetStartsOn() Date {
+	if p == nil || p.StartsOn == nil {
+		return Date{}
+	}
+
+	return *p.StartsOn
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *Project) GetUpdatedAt() time.Time {
+	if p == nil || p.UpdatedAt == nil {
+		return time.Time{}
+	}
+
+	return *p.UpdatedAt
+}
+
+// GetBillable returns the Billable field if it's non-nil, zero value otherwise.
+func (p *ProjectTaskAssignment) GetBillable() bool {
+	if p == nil || p.Billable == nil {
+		return false
+	}
+
+	return *p.Billable
+}
+
+// GetBudget returns the Budget field if it's non-nil, zero value otherwise.
+func (p *ProjectTaskAssignment) GetBudget() float64 {
+	if p == nil || p.Budget == nil {
+		return 0
+	}
+
+	return *p.Budget
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectTaskAssignment) GetCreatedAt() time.Time {
+	if p == nil || p.CreatedAt == nil {
+		return time.Time{}
+	}
+
+	return *p.CreatedAt
+}
+
+// GetHourlyRate returns the HourlyRate field if it's non-nil, zero value otherwise.
+func (p *ProjectTaskAssignment) GetHourlyRate() float64 {
+	if p == nil || p.HourlyRate == nil {
+		return 0
+	}
+
+	return *p.HourlyRate
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectTaskAssignment) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+
+	return *p.ID
+}
+
+// GetIsActive returns the IsActive field if it's non-nil, zero value otherwise.
+func (p *ProjectTaskAssignment) GetIsActive() bool {
+	if p == nil || p.IsActive == nil {
+		return false
+	}
+
+	return *p.IsActive
+}
+
+// GetProject returns the Project field, or nil if ProjectTaskAssignment is nil.
+func (p *ProjectTaskAssignment) GetProject() *Project {
+	if p == nil {
+		return nil
+	}
+
+	return p.Project
+}
+
+// GetTask returns the Task field, or nil if ProjectTaskAssignment is nil.
+func (p *ProjectTaskAssignment) GetTask() *Task {
+	if p == nil {
+		return nil
+	}
+
+	return p.Task
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectTaskAssignment) GetUpdatedAt() time.Time {
+	if p == nil || p.UpdatedAt == nil {
+		return time.Time{}
+	}
+
+	return *p.UpdatedAt
+}
+
+// GetBillable returns the Billable field if it's non-nil, zero value otherwise.
+func (p *ProjectTaskAssignmentCreateRequest) GetBillable() bool {
+	if p == nil || p.Billable == nil {
+		return false
+	}
+
+	return *p.Billable
+}
+
+// GetBudget returns the Budget field if it's non-nil, zero value otherwise.
+func (p *ProjectTaskAssignmentCreateRequest) GetBudget() float64 {
+	if p == nil || p.Budget == nil {
+		return 0
+	}
+
+	return *p.Budget
+}
+
+// GetHourlyRate returns the HourlyRate field if it's non-nil, zero value otherwise.
+func (p *ProjectTaskAssignmentCreateRequest) GetHourlyRate() float64 {
+	if p == nil || p.HourlyRate == nil {
+		return 0
+	}
+
+	return *p.HourlyRate
+}
+
+// GetIsActive returns the IsActive field if it's non-nil, zero value otherwise.
+func (p *ProjectTaskAssignmentCreateRequest) GetIsActive() bool {
+	if p == nil || p.IsActive == nil {
+		return false
+	}
+
+	return *p.IsActive
+}
+
+// GetTaskID returns the TaskID field if it's non-nil, zero value otherwise.
+func (p *ProjectTaskAssignmentCreateRequest) GetTaskID() int64 {
+	if p == nil || p.TaskID == nil {
+		return 0
+	}
+
+	return *p.TaskID
+}
+
+// GetBudget returns the Budget field if it's non-nil, zero value otherwise.
+func (p *ProjectUserAssignment) GetBudget() float64 {
+	if p == nil || p.Budget == nil {
+		return 0
+	}
+
+	return *p.Budget
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectUserAssignment) GetCreatedAt() time.Time {
+	if p == nil || p.CreatedAt == nil {
+		return time.Time{}
+	}
+
+	return *p.CreatedAt
+}
+
+// GetHourlyRate returns the HourlyRate field if it's non-nil, zero value otherwise.
+func (p *ProjectUserAssignment) GetHourlyRate() float64 {
+	if p == nil || p.HourlyRate == nil {
+		return 0
+	}
+
+	return *p.HourlyRate
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectUserAssignment) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+
+	return *p.ID
+}
+
+// GetIsActive returns the IsActive field if it's non-nil, zero value otherwise.
+func (p *ProjectUserAssignment) GetIsActive() bool {
+	if p == nil || p.IsActive == nil {
+		return false
+	}
+
+	return *p.IsActive
+}
+
+// GetIsProjectManager returns the IsProjectManager field if it's non-nil, zero value otherwise.
+func (p *ProjectUserAssignment) GetIsProjectManager() bool {
+	if p == nil || p.IsProjectManager == nil {
+		return false
+	}
+
+	return *p.IsProjectManager
+}
+
+// GetProject returns the Project field, or nil if ProjectUserAssignment is nil.
+func (p *ProjectUserAssignment) GetProject() *Project {
+	if p == nil {
+		return nil
+	}
+
+	return p.Project
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectUserAssignment) GetUpdatedAt() time.Time {
+	if p == nil || p.UpdatedAt == nil {
+		return time.Time{}
+	}
+
+	return *p.UpdatedAt
+}
+
+// GetUser returns the User field, or nil if ProjectUserAssignment is nil.
+func (p *ProjectUserAssignment) GetUser() *User {
+	if p == nil {
+		return nil
+	}
+
+	return p.User
+}
+
+// GetCore returns the Core field, or nil if RateLimits is nil.
+func (r *RateLimits) GetCore() *Rate {
+	if r == nil {
+		return nil
+	}
+
+	return r.Core
+}
+
+// GetContentType returns the ContentType field if it's non-nil, zero value otherwise.
+func (r *Receipt) GetContentType() string {
+	if r == nil || r.ContentType == nil {
+		return ""
+	}
+
+	return *r.ContentType
+}
+
+// GetFileName returns the FileName field if it's non-nil, zero value otherwise.
+func (r *Receipt) GetFileName() string {
+	if r == nil || r.FileName == nil {
+		return ""
+	}
+
+	return *r.FileName
+}
+
+// GetFileSize returns the FileSize field if it's non-nil, zero value otherwise.
+func (r *Receipt) GetFileSize() int64 {
+	if r == nil || r.FileSize == nil {
+		return 0
+	}
+
+	return *r.FileSize
+}
+
+// GetURL returns the URL field if it's non-nil, zero value otherwise.
+func (r *Receipt) GetURL() string {
+	if r == nil || r.URL == nil {
+		return ""
+	}
+
+	return *r.URL
+}
+
+// GetReference returns the Reference field, or nil if ReferenceHours is nil.
+func (r *ReferenceHours) GetReference() *ExternalReference {
+	if r == nil {
+		return nil
+	}
+
+	return r.Reference
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (r *Role) GetCreatedAt() time.Time {
+	if r == nil || r.CreatedAt == nil {
+		return time.Time{}
+	}
+
+	return *r.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (r *Role) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
+	}
+
+	return *r.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *Role) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
+	}
+
+	return *r.Name
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (r *Role) GetUpdatedAt() time.Time {
+	if r == nil || r.UpdatedAt == nil {
+		return time.Time{}
+	}
+
+	return *r.UpdatedAt
+}
+
+// GetUserIDs returns the UserIDs field if it's non-nil, zero value otherwise.
+func (r *Role) GetUserIDs() []int64 {
+	if r == nil || r.UserIDs == nil {
+		return nil
+	}
+
+	return *r.UserIDs
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RoleCreateRequest) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
+	}
+
+	return *r.Name
+}
+
+// GetUserIDs returns the UserIDs field if it's non-nil, zero value otherwise.
+func (r *RoleCreateRequest) GetUserIDs() []int64 {
+	if r == nil || r.UserIDs == nil {
+		return nil
+	}
+
+	return *r.UserIDs
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (r *RoleUpdateRequest) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
+	}
+
+	return *r.Name
+}
+
+// GetUserIDs returns the UserIDs field if it's non-nil, zero value otherwise.
+func (r *RoleUpdateRequest) GetUserIDs() []int64 {
+	if r == nil || r.UserIDs == nil {
+		return nil
+	}
+
+	return *r.UserIDs
+}
+
+// GetBillableByDefault returns the BillableByDefault field if it's non-nil, zero value otherwise.
+func (t *Task) GetBillableByDefault() bool {
+	if t == nil || t.BillableByDefault == nil {
+		return false
+	}
+
+	return *t.BillableByDefault
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (t *Task) GetCreatedAt() time.Time {
+	if t == nil || t.CreatedAt == nil {
+		return time.Time{}
+	}
+
+	return *t.CreatedAt
+}
+
+// GetDefaultHourlyRate returns the DefaultHourlyRate field if it's non-nil, zero value otherwise.
+func (t *Task) GetDefaultHourlyRate() float64 {
+	if t == nil || t.DefaultHourlyRate == nil {
+		return 0
+	}
+
+	return *t.DefaultHourlyRate
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (t *Task) GetID() int64 {
+	if t == nil || t.ID == nil {
+		return 0
+	}
+
+	return *t.ID
+}
+
+// GetIsActive returns the IsActive field if it's non-nil, zero value otherwise.
+func (t *Task) GetIsActive() bool {
+	if t == nil || t.IsActive == nil {
+		return false
+	}
+
+	return *t.IsActive
+}
+
+// GetIsDefault returns the IsDefault field if it's non-nil, zero value otherwise.
+func (t *Task) GetIsDefault() bool {
+	if t == nil || t.IsDefault == nil {
+		return false
+	}
+
+	return *t.IsDefault
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (t *Task) GetName() string {
+	if t == nil || t.Name == nil {
+		return ""
+	}
+
+	return *t.Name
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (t *Task) GetUpdatedAt() time.Time {
+	if t == nil || t.UpdatedAt == nil {
+		return time.Time{}
+	}
+
+	return *t.UpdatedAt
+}
+
+// GetBillableByDefault returns the BillableByDefault field if it's non-nil, zero value otherwise.
+func (t *TaskCreateRequest) GetBillableByDefault() bool {
+	if t == nil || t.BillableByDefault == nil {
+		return false
+	}
+
+	return *t.BillableByDefault
+}
+
+// GetDefaultHourlyRate returns the DefaultHourlyRate field if it's non-nil, zero value otherwise.
+func (t *TaskCreateRequest) GetDefaultHourlyRate() float64 {
+	if t == nil || t.DefaultHourlyRate == nil {
+		return 0
+	}
+
+	return *t.DefaultHourlyRate
+}
+
+// GetIsActive returns the IsActive field if it's non-nil, zero value otherwise.
+func (t *TaskCreateRequest) GetIsActive() bool {
+	if t == nil || t.IsActive == nil {
+		return false
+	}
+
+	return *t.IsActive
+}
+
+// GetIsDefault returns the IsDefault field if it's non-nil, zero value otherwise.
+func (t *TaskCreateRequest) GetIsDefault() bool {
+	if t == nil || t.IsDefault == nil {
+		return false
+	}
+
+	return *t.IsDefault
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (t *TaskCreateRequest) GetName() string {
+	if t == nil || t.Name == nil {
+		return ""
+	}
+
+	return *t.Name
+}
+
+// GetBillableByDefault returns the BillableByDefault field if it's non-nil, zero value otherwise.
+func (t *TaskUpdateRequest) GetBillableByDefault() bool {
+	if t == nil || t.BillableByDefault == nil {
+		return false
+	}
+
+	return *t.BillableByDefault
+}
+
+// GetDefaultHourlyRate returns the DefaultHourlyRate field if it's non-nil, zero value otherwise.
+func (t *TaskUpdateRequest) GetDefaultHourlyRate() float64 {
+	if t == nil || t.DefaultHourlyRate == nil {
+		return 0
+	}
+
+	return *t.DefaultHourlyRate
+}
+
+// GetIsActive returns the IsActive field if it's non-nil, zero value otherwise.
+func (t *TaskUpdateRequest) GetIsActive() bool {
+	if t == nil || t.IsActive == nil {
+		return false
+	}
+
+	return *t.IsActive
+}
+
+// GetIsDefault returns the IsDefault field if it's non-nil, zero value otherwise.
+func (t *TaskUpdateRequest) GetIsDefault() bool {
+	if t == nil || t.IsDefault == nil {
+		return false
+	}
+
+	return *t.IsDefault
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (t *TaskUpdateRequest) GetName() string {
+	if t == nil || t.Name == nil {
+		return ""
+	}
+
+	return *t.Name
+}
+
+// GetBillable returns the Billable field if it's non-nil, zero value otherwise.
+func (t *TimeEntry) GetBillable() bool {
+	if t == nil || t.Billable == nil {
+		return false
+	}
+
+	return *t.Billable
+}
+
+// GetBillableRate returns the BillableRate field if it's non-nil, zero value otherwise.
+func (t *TimeEntry) GetBillableRate() float64 {
+	if t == nil || t.BillableRate == nil {
+		return 0
+	}
+
+	return *t.BillableRate
+}
+
+// GetBudgeted returns the Budgeted field if it's non-nil, zero value otherwise.
+func (t *TimeEntry) GetBudgeted() bool {
+	if t == nil || t.Budgeted == nil {
+		return false
+	}
+
+	return *t.Budgeted
+}
+
+// GetClient returns the Client field, or nil if TimeEntry is nil.
+func (t *TimeEntry) GetClient() *Client {
+	if t == nil {
+		return nil
+	}
+
+	return t.Client
+}
+
+// GetCostRate returns the CostRate field if it's non-nil, zero value otherwise.
+func (t *TimeEntry) GetCostRate() float64 {
+	if t == nil || t.CostRate == nil {
+		return 0
+	}
+
+	return *t.CostRate
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (t *TimeEntry) GetCreatedAt() time.Time {
+	if t == nil || t.CreatedAt == nil {
+		return time.Time{}
+	}
+
+	return *t.CreatedAt
+}
+
+// GetEndedTime returns the EndedTime field if it's non-nil, zero value otherwise.
+func (t *TimeEntry) GetEndedTime() Time {
+	if t == nil || t.EndedTime == nil {
+		return Time{}
+	}
+
+	return *t.EndedTime
+}
+
+// GetExternalReference returns the ExternalReference field, or nil if TimeEntry is nil.
+func (t *TimeEntry) GetExternalReference() *ExternalReference {
+	if t == nil {
+		return nil
+	}
+
+	return t.ExternalReference
+}
+
+// GetHours returns the Hours field if it's non-nil, zero value otherwise.
+func (t *TimeEntry) GetHours() Hours {
+	if t == nil || t.Hours == nil {
+		return 0
+	}
+
+	return *t.Hours
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (t *TimeEntry) GetID() int64 {
+	if t == nil || t.ID == nil {
+		return 0
+	}
+
+	return *t.ID
+}
+
+// GetInvoice returns the Invoice field, or nil if TimeEntry is nil.
+func (t *TimeEntry) GetInvoice() *Invoice {
+	if t == nil {
+		return nil
+	}
+
+	return t.Invoice
+}
+
+// GetIsBilled returns the IsBilled field if it's non-nil, zero value otherwise.
+func (t *TimeEntry) GetIsBilled() bool {
+	if t == nil || t.IsBilled == nil {
+		return false
+	}
+
+	return *t.IsBilled
+}
+
+// GetIsClosed returns the IsClosed field if it's non-nil, zero value otherwise.
+func (t *TimeEntry) GetIsClosed() bool {
+	if t == nil || t.IsClosed == nil {
+		return false
+	}
+
+	return *t.IsClosed
+}
+
+// GetIsLocked returns the IsLocked field if it's non-nil, zero value otherwise.
+func (t *TimeEntry) GetIsLocked() bool {
+	if t == nil || t.IsLocked == nil {
+		return false
+	}
+
+	return *t.IsLocked
+}
+
+// GetIsRunning returns the IsRunning field if it's non-nil, zero value otherwise.
+func (t *TimeEntry) GetIsRunning() bool {
+	if t == nil || t.IsRunning == nil {
+		return false
+	}
+
+	return *t.IsRunning
+}
+
+// GetLockedReason returns the LockedReason field if it's non-nil, zero value otherwise.
+func (t *TimeEntry) GetLockedReason() string {
+	if t == nil || t.LockedReason == nil {
+		return ""
+	}
+
+	return *t.LockedReason
+}
+
+// GetNotes returns the Notes field if it's non-nil, zero value otherwise.
+func (t *TimeEntry) GetNotes() string {
+	if t == nil || t.Notes == nil {
+		return ""
+	}
+
+	return *t.Notes
+}
+
+// GetProject returns the Project field, or nil if TimeEntry is nil.
+func (t *TimeEntry) GetProject() *Project {
+	if t == nil {
+		return nil
+	}
+
+	return t.Project
+}
+
+// GetRoundedHours returns the RoundedHours field if it's non-nil, zero value otherwise.
+func (t *TimeEntry) GetRoundedHours() Hours {
+	if t == nil || t.RoundedHours == nil {
+		return 0
+	}
+
+	return *t.RoundedHours
+}
+
+// GetSpentDate returns the SpentDate field if it's non-nil, zero value otherwise.
+func (t *TimeEntry) GetSpentDate() Date {
+	if t == nil || t.SpentDate == nil {
+		return Date{}
+	}
+
+	return *t.SpentDate
+}
+
+// GetStartedTime returns the StartedTime field if it's non-nil, zero value otherwise.
+func (t *TimeEntry) GetStartedTime() Time {
+	if t == nil || t.StartedTime == nil {
+		return Time{}
+	}
+
+	return *t.StartedTime
+}
+
+// GetTask returns the Task field, or nil if TimeEntry is nil.
+func (t *TimeEntry) GetTask() *Task {
+	if t == nil {
+		return nil
+	}
+
+	return t.Task
+}
+
+// GetTaskAssignment returns the TaskAssignment field, or nil if TimeEntry is nil.
+func (t *TimeEntry) GetTaskAssignment() *ProjectTaskAssignment {
+	if t == nil {
+		return nil
+	}
+
+	return t.TaskAssignment
+}
+
+// GetTimerStartedAt returns the TimerStartedAt field if it's non-nil, zero value otherwise.
+func (t *TimeEntry) GetTimerStartedAt() time.Time {
+	if t == nil || t.TimerStartedAt == nil {
+		return time.Time{}
+	}
+
+	return *t.TimerStartedAt
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (t *TimeEntry) GetUpdatedAt() time.Time {
+	if t == nil || t.UpdatedAt == nil {
+		return time.Time{}
+	}
+
+	return *t.UpdatedAt
+}
+
+// GetUser returns the User field, or nil if TimeEntry is nil.
+func (t *TimeEntry) GetUser() *User {
+	if t == nil {
+		return nil
+	}
+
+	return t.User
+}
+
+// GetUserAssignment returns the UserAssignment field, or nil if TimeEntry is nil.
+func (t *TimeEntry) GetUserAssignment() *ProjectUserAssignment {
+	if t == nil {
+		return nil
+	}
+
+	return t.UserAssignment
+}
+
+// GetExternalReference returns the ExternalReference field, or nil if TimeEntryCreateViaDuration is nil.
+func (t *TimeEntryCreateViaDuration) GetExternalReference() *ExternalReference {
+	if t == nil {
+		return nil
+	}
+
+	return t.ExternalReference
+}
+
+// GetHours returns the Hours field if it's non-nil, zero value otherwise.
+func (t *TimeEntryCreateViaDuration) GetHours() Hours {
+	if t == nil || t.Hours == nil {
+		return 0
+	}
+
+	return *t.Hours
+}
+
+// GetNotes returns the Notes field if it's non-nil, zero value otherwise.
+func (t *TimeEntryCreateViaDuration) GetNotes() string {
+	if t == nil || t.Notes == nil {
+		return ""
+	}
+
+	return *t.Notes
+}
+
+// GetProjectID returns the ProjectID field if it's non-nil, zero value otherwise.
+func (t *TimeEntryCreateViaDuration) GetProjectID() int64 {
+	if t == nil || t.ProjectID == nil {
+		return 0
+	}
+
+	return *t.ProjectID
+}
+
+// GetSpentDate returns the SpentDate field if it's non-nil, zero value otherwise.
+func (t *TimeEntryCreateViaDuration) GetSpentDate() Date {
+	if t == nil || t.SpentDate == nil {
+		return Date{}
+	}
+
+	return *t.SpentDate
+}
+
+// GetTaskID returns the TaskID field if it's non-nil, zero value otherwise.
+func (t *TimeEntryCreateViaDuration) GetTaskID() int64 {
+	if t == nil || t.TaskID == nil {
+		return 0
+	}
+
+	return *t.TaskID
+}
+
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (t *TimeEntryCreateViaDuration) GetUserID() int64 {
+	if t == nil || t.UserID == nil {
+		return 0
+	}
+
+	return *t.UserID
+}
+
+// GetEndedTime returns the EndedTime field if it's non-nil, zero value otherwise.
+func (t *TimeEntryCreateViaStartEndTime) GetEndedTime() Time {
+	if t == nil || t.EndedTime == nil {
+		return Time{}
+	}
+
+	return *t.EndedTime
+}
+
+// GetExternalReference returns the ExternalReference field, or nil if TimeEntryCreateViaStartEndTime is nil.
+func (t *TimeEntryCreateViaStartEndTime) GetExternalReference() *ExternalReference {
+	if t == nil {
+		return nil
+	}
+
+	return t.ExternalReference
+}
+
+// GetNotes returns the Notes field if it's non-nil, zero value otherwise.
+func (t *TimeEntryCreateViaStartEndTime) GetNotes() string {
+	if t == nil || t.Notes == nil {
+		return ""
+	}
+
+	return *t.Notes
+}
+
+// GetProjectID returns the ProjectID field if it's non-nil, zero value otherwise.
+func (t *TimeEntryCreateViaStartEndTime) GetProjectID() int64 {
+	if t == nil || t.ProjectID == nil {
+		return 0
+	}
+
+	return *t.ProjectID
+}
+
+// GetSpentDate returns the SpentDate field if it's non-nil, zero value otherwise.
+func (t *TimeEntryCreateViaStartEndTime) GetSpentDate() Date {
+	if t == nil || t.SpentDate == nil {
+		return Date{}
+	}
+
+	return *t.SpentDate
+}
+
+// GetStartedTime returns the StartedTime field if it's non-nil, zero value otherwise.
+func (t *TimeEntryCreateViaStartEndTime) GetStartedTime() Time {
+	if t == nil || t.StartedTime == nil {
+		return Time{}
+	}
+
+	return *t.StartedTime
+}
+
+// GetTaskID returns the TaskID field if it's non-nil, zero value otherwise.
+func (t *TimeEntryCreateViaStartEndTime) GetTaskID() int64 {
+	if t == nil || t.TaskID == nil {
+		return 0
+	}
+
+	return *t.TaskID
+}
+
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (t *TimeEntryCreateViaStartEndTime) GetUserID() int64 {
+	if t == nil || t.UserID == nil {
+		return 0
+	}
+
+	return *t.UserID
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (t *TimeEntryListOptions) GetClientID() int64 {
+	if t == nil || t.ClientID == nil {
+		return 0
+	}
+
+	return *t.ClientID
+}
+
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (t *TimeEntryListOptions) GetFrom() Date {
+	if t == nil || t.From == nil {
+		return Date{}
+	}
+
+	return *t.From
+}
+
+// GetIsBilled returns the IsBilled field if it's non-nil, zero value otherwise.
+func (t *TimeEntryListOptions) GetIsBilled() bool {
+	if t == nil || t.IsBilled == nil {
+		return false
+	}
+
+	return *t.IsBilled
+}
+
+// GetIsRunning returns the IsRunning field if it's non-nil, zero value otherwise.
+func (t *TimeEntryListOptions) GetIsRunning() bool {
+	if t == nil || t.IsRunning == nil {
+		return false
+	}
+
+	return *t.IsRunning
+}
+
+// GetProjectID returns the ProjectID field if it's non-nil, zero value otherwise.
+func (t *TimeEntryListOptions) GetProjectID() int64 {
+	if t == nil || t.ProjectID == nil {
+		return 0
+	}
+
+	return *t.ProjectID
+}
+
+// GetTaskID returns the TaskID field if it's non-nil, zero value otherwise.
+func (t *TimeEntryListOptions) GetTaskID() int64 {
+	if t == nil || t.TaskID == nil {
+		return 0
+	}
+
+	return *t.TaskID
+}
+
+// GetTo returns the To field if it's non-nil, zero value otherwise.
+func (t *TimeEntryListOptions) GetTo() Date {
+	if t == nil || t.To == nil {
+		return Date{}
+	}
+
+	return *t.To
+}
+
+// GetUpdatedSince returns the UpdatedSince field if it's non-nil, zero value otherwise.
+func (t *TimeEntryListOptions) GetUpdatedSince() time.Time {
+	if t == nil || t.UpdatedSince == nil {
+		return time.Time{}
+	}
+
+	return *t.UpdatedSince
+}
+
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (t *TimeEntryListOptions) GetUserID() int64 {
+	if t == nil || t.UserID == nil {
+		return 0
+	}
+
+	return *t.UserID
+}
+
+// GetEndedTime returns the EndedTime field if it's non-nil, zero value otherwise.
+func (t *TimeEntryUpdate) GetEndedTime() Time {
+	if t == nil || t.EndedTime == nil {
+		return Time{}
+	}
+
+	return *t.EndedTime
+}
+
+// GetExternalReference returns the ExternalReference field, or nil if TimeEntryUpdate is nil.
+func (t *TimeEntryUpdate) GetExternalReference() *ExternalReference {
+	if t == nil {
+		return nil
+	}
+
+	return t.ExternalReference
+}
+
+// GetHours returns the Hours field if it's non-nil, zero value otherwise.
+func (t *TimeEntryUpdate) GetHours() Hours {
+	if t == nil || t.Hours == nil {
+		return 0
+	}
+
+	return *t.Hours
+}
+
+// GetNotes returns the Notes field if it's non-nil, zero value otherwise.
+func (t *TimeEntryUpdate) GetNotes() string {
+	if t == nil || t.Notes == nil {
+		return ""
+	}
+
+	return *t.Notes
+}
+
+// GetProjectID returns the ProjectID field if it's non-nil, zero value otherwise.
+func (t *TimeEntryUpdate) GetProjectID() int64 {
+	if t == nil || t.ProjectID == nil {
+		return 0
+	}
+
+	return *t.ProjectID
+}
+
+// GetSpentDate returns the SpentDate field if it's non-nil, zero value otherwise.
+func (t *TimeEntryUpdate) GetSpentDate() Date {
+	if t == nil || t.SpentDate == nil {
+		return Date{}
+	}
+
+	return *t.SpentDate
+}
+
+// GetStartedTime returns the StartedTime field if it's non-nil, zero value otherwise.
+func (t *TimeEntryUpdate) GetStartedTime() Time {
+	if t == nil || t.StartedTime == nil {
+		return Time{}
+	}
+
+	return *t.StartedTime
+}
+
+// GetTaskID returns the TaskID field if it's non-nil, zero value otherwise.
+func (t *TimeEntryUpdate) GetTaskID() int64 {
+	if t == nil || t.TaskID == nil {
+		return 0
+	}
+
+	return *t.TaskID
+}
+
+// GetAvatarURL returns the AvatarURL field if it's non-nil, zero value otherwise.
+func (u *User) GetAvatarURL() string {
+	if u == nil || u.AvatarURL == nil {
+		return ""
+	}
+
+	return *u.AvatarURL
+}
+
+// GetCanCreateInvoices returns the CanCreateInvoices field if it's non-nil, zero value otherwise.
+func (u *User) GetCanCreateInvoices() bool {
+	if u == nil || u.CanCreateInvoices == nil {
+		return false
+	}
+
+	return *u.CanCreateInvoices
+}
+
+// GetCanCreateProjects returns the CanCreateProjects field if it's non-nil, zero value otherwise.
+func (u *User) GetCanCreateProjects() bool {
+	if u == nil || u.CanCreateProjects == nil {
+		return false
+	}
+
+	return *u.CanCreateProjects
+}
+
+// GetCanSeeRates returns the CanSeeRates field if it's non-nil, zero value otherwise.
+func (u *User) GetCanSeeRates() bool {
+	if u == nil || u.CanSeeRates == nil {
+		return false
+	}
+
+	return *u.CanSeeRates
+}
+
+// GetCostRate returns the CostRate field if it's non-nil, zero value otherwise.
+func (u *User) GetCostRate() float64 {
+	if u == nil || u.CostRate == nil {
+		return 0
+	}
+
+	return *u.CostRate
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (u *User) GetCreatedAt() time.Time {
+	if u == nil || u.CreatedAt == nil {
+		return time.Time{}
+	}
+
+	return *u.CreatedAt
+}
+
+// GetDefaultHourlyRate returns the DefaultHourlyRate field if it's non-nil, zero value otherwise.
+func (u *User) GetDefaultHourlyRate() float64 {
+	if u == nil || u.DefaultHourlyRate == nil {
+		return 0
+	}
+
+	return *u.DefaultHourlyRate
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (u *User) GetEmail() string {
+	if u == nil || u.Email == nil {
+		return ""
+	}
+
+	return *u.Email
+}
+
+// GetFirstName returns the FirstName field if it's non-nil, zero value otherwise.
+func (u *User) GetFirstName() string {
+	if u == nil || u.FirstName == nil {
+		return ""
+	}
+
+	return *u.FirstName
+}
+
+// GetHasAccessToAllFutureProjects returns the HasAccessToAllFutureProjects field if it's non-nil, zero value otherwise.
+func (u *User) GetHasAccessToAllFutureProjects() bool {
+	if u == nil || u.HasAccessToAllFutureProjects == nil {
+		return false
+	}
+
+	return *u.HasAccessToAllFutureProjects
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (u *User) GetID() int64 {
+	if u == nil || u.ID == nil {
+		return 0
+	}
+
+	return *u.ID
+}
+
+// GetIsActive returns the IsActive field if it's non-nil, zero value otherwise.
+func (u *User) GetIsActive() bool {
+	if u == nil || u.IsActive == nil {
+		return false
+	}
+
+	return *u.IsActive
+}
+
+// GetIsAdmin returns the IsAdmin field if it's non-nil, zero value otherwise.
+func (u *User) GetIsAdmin() bool {
+	if u == nil || u.IsAdmin == nil {
+		return false
+	}
+
+	return *u.IsAdmin
+}
+
+// GetIsContractor returns the IsContractor field if it's non-nil, zero value otherwise.
+func (u *User) GetIsContractor() bool {
+	if u == nil || u.IsContractor == nil {
+		return false
+	}
+
+	return *u.IsContractor
+}
+
+// GetIsProjectManager returns the IsProjectManager field if it's non-nil, zero value otherwise.
+func (u *User) GetIsProjectManager() bool {
+	if u == nil || u.IsProjectManager == nil {
+		return false
+	}
+
+	return *u.IsProjectManager
+}
+
+// GetLastName returns the LastName field if it's non-nil, zero value otherwise.
+func (u *User) GetLastName() string {
+	if u == nil || u.LastName == nil {
+		return ""
+	}
+
+	return *u.LastName
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (u *User) GetName() string {
+	if u == nil || u.Name == nil {
+		return ""
+	}
+
+	return *u.Name
+}
+
+// GetRoles returns the Roles field if it's non-nil, zero value otherwise.
+func (u *User) GetRoles() []string {
+	if u == nil || u.Roles == nil {
+		return nil
+	}
+
+	return *u.Roles
+}
+
+// GetTelephone returns the Telephone field if it's non-nil, zero value otherwise.
+func (u *User) GetTelephone() string {
+	if u == nil || u.Telephone == nil {
+		return ""
+	}
+
+	return *u.Telephone
+}
+
+// GetTimezone returns the Timezone field if it's non-nil, zero value otherwise.
+func (u *User) GetTimezone() string {
+	if u == nil || u.Timezone == nil {
+		return ""
+	}
+
+	return *u.Timezone
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (u *User) GetUpdatedAt() time.Time {
+	if u == nil || u.UpdatedAt == nil {
+		return time.Time{}
+	}
+
+	return *u.UpdatedAt
+}
+
+// GetWeeklyCapacity returns the WeeklyCapacity field if it's non-nil, zero value otherwise.
+func (u *User) GetWeeklyCapacity() int {
+	if u == nil || u.WeeklyCapacity == nil {
+		return 0
+	}
+
+	return *u.WeeklyCapacity
+}
+
+// GetCanCreateInvoices returns the CanCreateInvoices field if it's non-nil, zero value otherwise.
+func (u *UserCreateRequest) GetCanCreateInvoices() bool {
+	if u == nil || u.CanCreateInvoices == nil {
+		return false
+	}
+
+	return *u.CanCreateInvoices
+}
+
+// GetCanCreateProjects returns the CanCreateProjects field if it's non-nil, zero value otherwise.
+func (u *UserCreateRequest) GetCanCreateProjects() bool {
+	if u == nil || u.CanCreateProjects == nil {
+		return false
+	}
+
+	return *u.CanCreateProjects
+}
+
+// GetCanSeeRates returns the CanSeeRates field if it's non-nil, zero value otherwise.
+func (u *UserCreateRequest) GetCanSeeRates() bool {
+	if u == nil || u.CanSeeRates == nil {
+		return false
+	}
+
+	return *u.CanSeeRates
+}
+
+// GetCostRate returns the CostRate field if it's non-nil, zero value otherwise.
+func (u *UserCreateRequest) GetCostRate() float64 {
+	if u == nil || u.CostRate == nil {
+		return 0
+	}
+
+	return *u.CostRate
+}
+
+// GetDefaultHourlyRate returns the DefaultHourlyRate field if it's non-nil, zero value otherwise.
+func (u *UserCreateRequest) GetDefaultHourlyRate() float64 {
+	if u == nil || u.DefaultHourlyRate == nil {
+		return 0
+	}
+
+	return *u.DefaultHourlyRate
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (u *UserCreateRequest) GetEmail() string {
+	if u == nil || u.Email == nil {
+		return ""
+	}
+
+	return *u.Email
+}
+
+// GetFirstName returns the FirstName field if it's non-nil, zero value otherwise.
+func (u *UserCreateRequest) GetFirstName() string {
+	if u == nil || u.FirstName == nil {
+		return ""
+	}
+
+	return *u.FirstName
+}
+
+// GetHasAccessToAllFutureProjects returns the HasAccessToAllFutureProjects field if it's non-nil, zero value otherwise.
+func (u *UserCreateRequest) GetHasAccessToAllFutureProjects() bool {
+	if u == nil || u.HasAccessToAllFutureProjects == nil {
+		return false
+	}
+
+	return *u.HasAccessToAllFutureProjects
+}
+
+// GetIsActive returns the IsActive field if it's non-nil, zero value otherwise.
+func (u *UserCreateRequest) GetIsActive() bool {
+	if u == nil || u.IsActive == nil {
+		return false
+	}
+
+	return *u.IsActive
+}
+
+// GetIsAdmin returns the IsAdmin field if it's non-nil, zero value otherwise.
+func (u *UserCreateRequest) GetIsAdmin() bool {
+	if u == nil || u.IsAdmin == nil {
+		return false
+	}
+
+	return *u.IsAdmin
+}
+
+// GetIsContractor returns the IsContractor field if it's non-nil, zero value otherwise.
+func (u *UserCreateRequest) GetIsContractor() bool {
+	if u == nil || u.IsContractor == nil {
+		return false
+	}
+
+	return *u.IsContractor
+}
+
+// GetIsProjectManager returns the IsProjectManager field if it's non-nil, zero value otherwise.
+func (u *UserCreateRequest) GetIsProjectManager() bool {
+	if u == nil || u.IsProjectManager == nil {
+		return false
+	}
+
+	return *u.IsProjectManager
+}
+
+// GetLastName returns the LastName field if it's non-nil, zero value otherwise.
+func (u *UserCreateRequest) GetLastName() string {
+	if u == nil || u.LastName == nil {
+		return ""
+	}
+
+	return *u.LastName
+}
+
+// GetTelephone returns the Telephone field if it's non-nil, zero value otherwise.
+func (u *UserCreateRequest) GetTelephone() string {
+	if u == nil || u.Telephone == nil {
+		return ""
+	}
+
+	return *u.Telephone
+}
+
+// GetTimezone returns the Timezone field if it's non-nil, zero value otherwise.
+func (u *UserCreateRequest) GetTimezone() string {
+	if u == nil || u.Timezone == nil {
+		return ""
+	}
+
+	return *u.Timezone
+}
+
+// GetWeeklyCapacity returns the WeeklyCapacity field if it's non-nil, zero value otherwise.
+func (u *UserCreateRequest) GetWeeklyCapacity() int {
+	if u == nil || u.WeeklyCapacity == nil {
+		return 0
+	}
+
+	return *u.WeeklyCapacity
+}
+
+// GetBudget returns the Budget field if it's non-nil, zero value otherwise.
+func (u *UserProjectAssignment) GetBudget() float64 {
+	if u == nil || u.Budget == nil {
+		return 0
+	}
+
+	return *u.Budget
+}
+
+// GetClient returns the Client field, or nil if UserProjectAssignment is nil.
+func (u *UserProjectAssignment) GetClient() *Client {
+	if u == nil {
+		return nil
+	}
+
+	return u.Client
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (u *UserProjectAssignment) GetCreatedAt() time.Time {
+	if u == nil || u.CreatedAt == nil {
+		return time.Time{}
+	}
+
+	return *u.CreatedAt
+}
+
+// GetHourlyRate returns the HourlyRate field if it's non-nil, zero value otherwise.
+func (u *UserProjectAssignment) GetHourlyRate() float64 {
+	if u == nil || u.HourlyRate == nil {
+		return 0
+	}
+
+	return *u.HourlyRate
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (u *UserProjectAssignment) GetID() int64 {
+	if u == nil || u.ID == nil {
+		return 0
+	}
+
+	return *u.ID
+}
+
+// GetIsActive returns the IsActive field if it's non-nil, zero value otherwise.
+func (u *UserProjectAssignment) GetIsActive() bool {
+	if u == nil || u.IsActive == nil {
+		return false
+	}
+
+	return *u.IsActive
+}
+
+// GetIsProjectManager returns the IsProjectManager field if it's non-nil, zero value otherwise.
+func (u *UserProjectAssignment) GetIsProjectManager() bool {
+	if u == nil || u.IsProjectManager == nil {
+		return false
+	}
+
+	return *u.IsProjectManager
+}
+
+// GetProject returns the Project field, or nil if UserProjectAssignment is nil.
+func (u *UserProjectAssignment) GetProject() *Project {
+	if u == nil {
+		return nil
+	}
+
+	return u.Project
+}
+
+// GetTaskAssignments returns the TaskAssignments field if it's non-nil, zero value otherwise.
+func (u *UserProjectAssignment) GetTaskAssignments() []ProjectTaskAssignment {
+	if u == nil || u.TaskAssignments == nil {
+		return nil
+	}
+
+	return *u.TaskAssignments
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (u *UserProjectAssignment) GetUpdatedAt() time.Time {
+	if u == nil || u.UpdatedAt == nil {
+		return time.Time{}
+	}
+
+	return *u.UpdatedAt
+}
+
+// GetUseDefaultRates returns the UseDefaultRates field if it's non-nil, zero value otherwise.
+func (u *UserProjectAssignment) GetUseDefaultRates() bool {
+	if u == nil || u.UseDefaultRates == nil {
+		return false
+	}
+
+	return *u.UseDefaultRates
+}
+
+// GetCanCreateInvoices returns the CanCreateInvoices field if it's non-nil, zero value otherwise.
+func (u *UserUpdateRequest) GetCanCreateInvoices() bool {
+	if u == nil || u.CanCreateInvoices == nil {
+		return false
+	}
+
+	return *u.CanCreateInvoices
+}
+
+// GetCanCreateProjects returns the CanCreateProjects field if it's non-nil, zero value otherwise.
+func (u *UserUpdateRequest) GetCanCreateProjects() bool {
+	if u == nil || u.CanCreateProjects == nil {
+		return false
+	}
+
+	return *u.CanCreateProjects
+}
+
+// GetCanSeeRates returns the CanSeeRates field if it's non-nil, zero value otherwise.
+func (u *UserUpdateRequest) GetCanSeeRates() bool {
+	if u == nil || u.CanSeeRates == nil {
+		return false
+	}
+
+	return *u.CanSeeRates
+}
+
+// GetCostRate returns the CostRate field if it's non-nil, zero value otherwise.
+func (u *UserUpdateRequest) GetCostRate() float64 {
+	if u == nil || u.CostRate == nil {
+		return 0
+	}
+
+	return *u.CostRate
+}
+
+// GetDefaultHourlyRate returns the DefaultHourlyRate field if it's non-nil, zero value otherwise.
+func (u *UserUpdateRequest) GetDefaultHourlyRate() float64 {
+	if u == nil || u.DefaultHourlyRate == nil {
+		return 0
+	}
+
+	return *u.DefaultHourlyRate
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (u *UserUpdateRequest) GetEmail() string {
+	if u == nil || u.Email == nil {
+		return ""
+	}
+
+	return *u.Email
+}
+
+// GetFirstName returns the FirstName field if it's non-nil, zero value otherwise.
+func (u *UserUpdateRequest) GetFirstName() string {
+	if u == nil || u.FirstName == nil {
+		return ""
+	}
+
+	return *u.FirstName
+}
+
+// GetHasAccessToAllFutureProjects returns the HasAccessToAllFutureProjects field if it's non-nil, zero value otherwise.
+func (u *UserUpdateRequest) GetHasAccessToAllFutureProjects() bool {
+	if u == nil || u.HasAccessToAllFutureProjects == nil {
+		return false
+	}
+
+	return *u.HasAccessToAllFutureProjects
+}
+
+// GetIsActive returns the IsActive field if it's non-nil, zero value otherwise.
+func (u *UserUpdateRequest) GetIsActive() bool {
+	if u == nil || u.IsActive == nil {
+		return false
+	}
+
+	return *u.IsActive
+}
+
+// GetIsAdmin returns the IsAdmin field if it's non-nil, zero value otherwise.
+func (u *UserUpdateRequest) GetIsAdmin() bool {
+	if u == nil || u.IsAdmin == nil {
+		return false
+	}
+
+	return *u.IsAdmin
+}
+
+// GetIsContractor returns the IsContractor field if it's non-nil, zero value otherwise.
+func (u *UserUpdateRequest) GetIsContractor() bool {
+	if u == nil || u.IsContractor == nil {
+		return false
+	}
+
+	return *u.IsContractor
+}
+
+// GetIsProjectManager returns the IsProjectManager field if it's non-nil, zero value otherwise.
+func (u *UserUpdateRequest) GetIsProjectManager() bool {
+	if u == nil || u.IsProjectManager == nil {
+		return false
+	}
+
+	return *u.IsProjectManager
+}
+
+// GetLastName returns the LastName field if it's non-nil, zero value otherwise.
+func (u *UserUpdateRequest) GetLastName() string {
+	if u == nil || u.LastName == nil {
+		return ""
+	}
+
+	return *u.LastName
+}
+
+// GetTelephone returns the Telephone field if it's non-nil, zero value otherwise.
+func (u *UserUpdateRequest) GetTelephone() string {
+	if u == nil || u.Telephone == nil {
+		return ""
+	}
+
+	return *u.Telephone
+}
+
+// GetTimezone returns the Timezone field if it's non-nil, zero value otherwise.
+func (u *UserUpdateRequest) GetTimezone() string {
+	if u == nil || u.Timezone == nil {
+		return ""
+	}
+
+	return *u.Timezone
+}
+
+// GetWeeklyCapacity returns the WeeklyCapacity field if it's non-nil, zero value otherwise.
+func (u *UserUpdateRequest) GetWeeklyCapacity() int {
+	if u == nil || u.WeeklyCapacity == nil {
+		return 0
+	}
+
+	return *u.WeeklyCapacity
+}
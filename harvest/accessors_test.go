@@ -0,0 +1,65 @@
+package harvest_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/becoded/go-harvest/harvest"
+	"github.com/becoded/go-harvest/internal/accessors"
+)
+
+func TestAccessors_UpToDate(t *testing.T) {
+	t.Parallel()
+
+	want, err := accessors.Generate(".")
+	assert.NoError(t, err)
+
+	got, err := os.ReadFile(accessors.OutputFile)
+	assert.NoError(t, err)
+
+	assert.Equal(t, string(want), string(got), "accessors.go is out of date, run `mage generate`")
+}
+
+func TestAccessors_NilReceiver(t *testing.T) {
+	t.Parallel()
+
+	var entry *harvest.TimeEntry
+
+	assert.Equal(t, int64(0), entry.GetID())
+	assert.Equal(t, "", entry.GetNotes())
+	assert.Equal(t, harvest.Hours(0), entry.GetHours())
+	assert.False(t, entry.GetIsRunning())
+	assert.Equal(t, harvest.Date{}, entry.GetSpentDate())
+	assert.Equal(t, harvest.Time{}, entry.GetStartedTime())
+	assert.Equal(t, time.Time{}, entry.GetCreatedAt())
+	assert.Nil(t, entry.GetProject())
+	assert.Equal(t, "", entry.GetProject().GetClient().GetName())
+}
+
+func TestAccessors_PartialResponse(t *testing.T) {
+	t.Parallel()
+
+	entry := &harvest.TimeEntry{
+		ID:        harvest.Int64(636709355),
+		Hours:     harvest.HoursP(2.11),
+		SpentDate: harvest.DateP(harvest.Date{Time: time.Date(2017, 3, 21, 0, 0, 0, 0, time.UTC)}),
+		Project: &harvest.Project{
+			ID:   harvest.Int64(14307913),
+			Name: harvest.String("Marketing Website"),
+		},
+	}
+
+	assert.Equal(t, int64(636709355), entry.GetID())
+	assert.Equal(t, harvest.Hours(2.11), entry.GetHours())
+	assert.Equal(t, "2017-03-21", entry.GetSpentDate().Format("2006-01-02"))
+	assert.Equal(t, "Marketing Website", entry.GetProject().GetName())
+	assert.Equal(t, "", entry.GetProject().GetClient().GetName())
+	assert.Equal(t, int64(0), entry.GetUser().GetID())
+
+	invoice := &harvest.Invoice{}
+	assert.Nil(t, invoice.GetLineItems())
+	assert.Nil(t, invoice.GetClient())
+}
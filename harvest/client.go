@@ -55,7 +55,7 @@ type ClientListOptions struct {
 
 type ClientCreateRequest struct {
 	// required	A textual description of the client.
-	Name *string `json:"name"`
+	Name *string `json:"name" validate:"required"`
 	// optional	Whether the client is active, or archived. Defaults to true.
 	IsActive *bool `json:"is_active,omitempty"`
 	// optional	A textual representation of the client’s physical address. May include new line characters.
@@ -65,6 +65,11 @@ type ClientCreateRequest struct {
 	Currency *string `json:"currency,omitempty"`
 }
 
+// Validate reports required fields that are missing.
+func (r *ClientCreateRequest) Validate() error {
+	return validate(r)
+}
+
 type ClientUpdateRequest struct {
 	// A textual description of the client.
 	Name *string `json:"name,omitempty"`
@@ -77,6 +82,11 @@ type ClientUpdateRequest struct {
 	Currency *string `json:"currency,omitempty"`
 }
 
+// Validate checks the request before it is sent.
+func (r *ClientUpdateRequest) Validate() error {
+	return validate(r)
+}
+
 // List returns a list of your clients.
 // The clients are returned sorted by creation date, with the most recently created clients appearing first.
 func (s *ClientService) List(ctx context.Context, opt *ClientListOptions) (*ClientList, *http.Response, error) {
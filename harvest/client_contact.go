@@ -59,11 +59,11 @@ type ClientContactListOptions struct {
 
 type ClientContactCreateRequest struct {
 	// required	The ID of the client associated with this contact.
-	ClientID *int64 `json:"client_id"`
+	ClientID *int64 `json:"client_id" validate:"required"`
 	// optional	The title of the contact.
 	Title *string `json:"title,omitempty"`
 	// required	The first name of the contact.
-	FirstName *string `json:"first_name"`
+	FirstName *string `json:"first_name" validate:"required"`
 	// optional	The last name of the contact.
 	LastName *string `json:"last_name,omitempty"`
 	// optional	The contact’s email address.
@@ -76,12 +76,17 @@ type ClientContactCreateRequest struct {
 	Fax *string `json:"fax,omitempty"`
 }
 
+// Validate reports required fields that are missing.
+func (r *ClientContactCreateRequest) Validate() error {
+	return validate(r)
+}
+
 type ClientContactUpdateRequest struct {
-	// required	The ID of the client associated with this contact.
+	// optional	The ID of the client associated with this contact.
 	ClientID *int64 `json:"client_id,omitempty"`
 	// optional	The title of the contact.
 	Title *string `json:"title,omitempty"`
-	// required	The first name of the contact.
+	// optional	The first name of the contact.
 	FirstName *string `json:"first_name,omitempty"`
 	// optional	The last name of the contact.
 	LastName *string `json:"last_name,omitempty"`
@@ -95,6 +100,11 @@ type ClientContactUpdateRequest struct {
 	Fax *string `json:"fax,omitempty"`
 }
 
+// Validate checks the request before it is sent.
+func (r *ClientContactUpdateRequest) Validate() error {
+	return validate(r)
+}
+
 func (s *ClientService) ListContacts(
 	ctx context.Context,
 	opt *ClientContactListOptions,
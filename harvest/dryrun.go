@@ -0,0 +1,110 @@
+package harvest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// A Plan records the calls a client in dry-run mode did not send. Set
+// APIClient.DryRun to a Plan to enable dry-run mode.
+type Plan struct {
+	mu    sync.Mutex
+	calls []PlannedCall
+}
+
+// PlannedCall is a mutating call that was recorded instead of sent.
+type PlannedCall struct {
+	Method string
+	URL    string
+	// Body is the value passed to NewRequest, such as a
+	// *TimeEntryUpdate, or nil.
+	Body interface{}
+}
+
+func (c PlannedCall) String() string {
+	return Stringify(c)
+}
+
+// NewPlan returns an empty Plan.
+func NewPlan() *Plan {
+	return &Plan{}
+}
+
+// Calls returns the recorded calls in the order they were made.
+func (p *Plan) Calls() []PlannedCall {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]PlannedCall(nil), p.calls...)
+}
+
+// Reset discards the recorded calls.
+func (p *Plan) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.calls = nil
+}
+
+func (p *Plan) add(call PlannedCall) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.calls = append(p.calls, call)
+}
+
+// dryRun is the innermost middleware when APIClient.DryRun is set. GET and
+// HEAD requests go through; other requests, which NewRequest has already
+// validated, are logged and added to the plan, and answered with a synthetic
+// response whose body echoes the request body.
+func (c *APIClient) dryRun(next Doer) Doer {
+	return DoerFunc(func(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
+		if req.Method == http.MethodGet || req.Method == http.MethodHead {
+			return next.Do(ctx, req, v)
+		}
+
+		u := *req.URL
+		call := PlannedCall{Method: req.Method, URL: sanitizeURL(&u).String(), Body: RequestBody(req)}
+
+		c.logger().InfoContext(ctx, "harvest: dry run", "method", call.Method, "url", call.URL)
+		c.DryRun.add(call)
+
+		var data []byte
+
+		if req.GetBody != nil {
+			if rc, err := req.GetBody(); err == nil {
+				data, _ = io.ReadAll(rc)
+				_ = rc.Close()
+			}
+		}
+
+		status := http.StatusOK
+		if req.Method == http.MethodPost {
+			status = http.StatusCreated
+		}
+
+		resp := &http.Response{
+			Status:        http.StatusText(status),
+			StatusCode:    status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header{"Content-Type": {DefaultMediaType}},
+			Body:          io.NopCloser(bytes.NewReader(data)),
+			ContentLength: int64(len(data)),
+			Request:       req,
+		}
+
+		if v != nil && len(data) > 0 {
+			// The echo is best effort: request and response types share
+			// most of their fields but not all of them.
+			_ = json.Unmarshal(data, v)
+		}
+
+		return resp, nil
+	})
+}
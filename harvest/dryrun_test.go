@@ -0,0 +1,121 @@
+package harvest_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/becoded/go-harvest/harvest"
+)
+
+var errNameRequired = errors.New("name is required")
+
+type validatedBody struct {
+	Name string `json:"name"`
+}
+
+func (b *validatedBody) Validate() error {
+	if b.Name == "" {
+		return errNameRequired
+	}
+
+	return nil
+}
+
+func TestDryRun(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup(t)
+	t.Cleanup(teardown)
+
+	mux.HandleFunc("/clients/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("%s %s was sent in dry-run mode", r.Method, r.URL)
+		}
+
+		fmt.Fprint(w, `{"id":1,"name":"Acme"}`)
+	})
+	mux.HandleFunc("/clients", func(_ http.ResponseWriter, r *http.Request) {
+		t.Errorf("%s %s was sent in dry-run mode", r.Method, r.URL)
+	})
+
+	var logs bytes.Buffer
+
+	client.Logger = slog.New(slog.NewTextHandler(&logs, nil))
+	client.DryRun = harvest.NewPlan()
+
+	ctx := context.Background()
+
+	got, _, err := client.Client.Get(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "Acme", got.GetName(), "GET requests go through")
+
+	create := &harvest.ClientCreateRequest{Name: harvest.String("Globex"), Currency: harvest.String("EUR")}
+
+	created, resp, err := client.Client.Create(ctx, create)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, "Globex", created.GetName(), "the synthetic response echoes the request")
+	assert.Equal(t, "EUR", created.GetCurrency())
+
+	update := &harvest.ClientUpdateRequest{IsActive: harvest.Bool(false)}
+
+	_, resp, err = client.Client.Update(ctx, 1, update)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	_, err = client.Client.Delete(ctx, 1)
+	assert.NoError(t, err)
+
+	base := client.BaseURL.String()
+	assert.Equal(t, []harvest.PlannedCall{
+		{Method: "POST", URL: base + "clients", Body: create},
+		{Method: "PATCH", URL: base + "clients/1", Body: update},
+		{Method: "DELETE", URL: base + "clients/1"},
+	}, client.DryRun.Calls())
+	assert.Contains(t, logs.String(), "harvest: dry run")
+
+	client.DryRun.Reset()
+	assert.Empty(t, client.DryRun.Calls())
+}
+
+func TestDryRun_validation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		body    *validatedBody
+		wantErr error
+		planned int
+	}{
+		{name: "valid", body: &validatedBody{Name: "Acme"}, planned: 1},
+		{name: "invalid", body: &validatedBody{}, wantErr: errNameRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, _, teardown := setup(t)
+			t.Cleanup(teardown)
+
+			client.DryRun = harvest.NewPlan()
+
+			ctx := context.Background()
+
+			req, err := client.NewRequest(ctx, "POST", "clients", tt.body)
+			if err == nil {
+				_, err = client.Do(ctx, req, nil)
+			}
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Len(t, client.DryRun.Calls(), tt.planned)
+		})
+	}
+}
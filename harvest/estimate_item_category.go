@@ -30,6 +30,12 @@ type EstimateItemCategoryRequest struct {
 	Name *string `json:"name,omitempty"` // required	The name of the estimate item category.
 }
 
+// Validate checks the request before it is sent. The name is only required
+// when creating, so it is left to the API.
+func (r *EstimateItemCategoryRequest) Validate() error {
+	return validate(r)
+}
+
 func (p EstimateItemCategory) String() string {
 	return Stringify(p)
 }
@@ -45,7 +45,7 @@ type EstimateMessageRecipient struct {
 
 type EstimateMessageCreateRequest struct {
 	// required	Array of recipient parameters. See below for details.
-	Recipients *[]EstimateMessageRecipientCreateRequest `json:"recipients,omitempty"`
+	Recipients *[]EstimateMessageRecipientCreateRequest `json:"recipients,omitempty" validate:"required"`
 	// optional	The message subject.
 	Subject *string `json:"subject,omitempty"`
 	// optional	The message body.
@@ -53,14 +53,24 @@ type EstimateMessageCreateRequest struct {
 	// optional	If set to true, a copy of the message email will be sent to the current user. Defaults to false.
 	SendMeACopy *bool `json:"send_me_a_copy,omitempty"`
 	// optional	If provided, runs an event against the estimate. Options: “accept”, “decline”, “re-open”, or “send”.
-	EventType *string `json:"event_type,omitempty"`
+	EventType *string `json:"event_type,omitempty" validate:"oneof=accept decline re-open send"`
+}
+
+// Validate reports required fields that are missing and invalid options.
+func (r *EstimateMessageCreateRequest) Validate() error {
+	return validate(r)
 }
 
 type EstimateMessageRecipientCreateRequest struct {
 	// optional	Name of the message recipient.
 	Name *string `json:"name,omitempty"`
 	// required	Email of the message recipient.
-	Email *string `json:"email"`
+	Email *string `json:"email" validate:"required"`
+}
+
+// Validate reports required fields that are missing.
+func (r *EstimateMessageRecipientCreateRequest) Validate() error {
+	return validate(r)
 }
 
 type EstimateMessageList struct {
@@ -84,7 +94,12 @@ type EstimateMessageListOptions struct {
 }
 
 type EstimateEventTypeRequest struct {
-	EventType string `json:"event_type"`
+	EventType string `json:"event_type" validate:"required,oneof=accept decline re-open send"`
+}
+
+// Validate reports required fields that are missing and invalid options.
+func (r *EstimateEventTypeRequest) Validate() error {
+	return validate(r)
 }
 
 // ListEstimateMessages returns a list of messages associated with a given estimate.
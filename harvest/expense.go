@@ -71,11 +71,11 @@ type ExpenseCreateRequest struct {
 	// optional	The ID of the user associated with this expense. Defaults to the ID of the currently authenticated user.
 	UserID *int64 `json:"user_id,omitempty"`
 	// required	The ID of the project associated with this expense.
-	ProjectID *int64 `json:"project_id"`
+	ProjectID *int64 `json:"project_id" validate:"required"`
 	// required	The ID of the expense category this expense is being tracked against.
-	ExpenseCategoryID *int64 `json:"expense_category_id"`
+	ExpenseCategoryID *int64 `json:"expense_category_id" validate:"required"`
 	// required	Date the expense occurred.
-	SpentDate *Date `json:"spent_date"`
+	SpentDate *Date `json:"spent_date" validate:"required"`
 	// *optional	The quantity of units to use in calculating the total_cost of the expense.
 	Units *int64 `json:"units,omitempty"`
 	// *optional	The total amount of the expense.
@@ -90,6 +90,11 @@ type ExpenseCreateRequest struct {
 	// Receipt *file `json:"receipt,omitempty"`
 }
 
+// Validate reports required fields that are missing.
+func (r *ExpenseCreateRequest) Validate() error {
+	return validate(r)
+}
+
 type ExpenseUpdateRequest struct {
 	// The ID of the project associated with this expense.
 	ProjectID *int64 `json:"project_id,omitempty"`
@@ -112,6 +117,11 @@ type ExpenseUpdateRequest struct {
 	DeleteReceipt *bool `json:"delete_receipt,omitempty"`
 }
 
+// Validate checks the request before it is sent.
+func (r *ExpenseUpdateRequest) Validate() error {
+	return validate(r)
+}
+
 func (p Expense) String() string {
 	return Stringify(p)
 }
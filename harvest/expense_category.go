@@ -43,6 +43,12 @@ type ExpenseCategoryRequest struct {
 	IsActive *bool `json:"is_active,omitempty"`
 }
 
+// Validate checks the request before it is sent. The name is only required
+// when creating, so it is left to the API.
+func (r *ExpenseCategoryRequest) Validate() error {
+	return validate(r)
+}
+
 func (p ExpenseCategory) String() string {
 	return Stringify(p)
 }
@@ -1,5 +1,7 @@
 package harvest
 
+//go:generate go run ../internal/cmd/gen-accessors
+
 import (
 	"bytes"
 	"context"
@@ -7,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"reflect"
@@ -15,7 +18,6 @@ import (
 	"time"
 
 	"github.com/google/go-querystring/query"
-	"github.com/sirupsen/logrus"
 )
 
 const (
@@ -27,6 +29,14 @@ const (
 	bitSize64        = 64
 )
 
+// Harvest allows 100 requests per 15 seconds. Packages that pace their own
+// requests, such as bulk and watch, stay within this quota by default.
+// Harvest API docs: https://help.getharvest.com/api-v2/introduction/overview/general/#rate-limiting
+const (
+	DefaultRateLimit  = 100
+	DefaultRateWindow = 15 * time.Second
+)
+
 // A APIClient manages communication with the Harvest API.
 type APIClient struct {
 	httpClient *http.Client // HTTP client used to communicate with the API.
@@ -40,19 +50,35 @@ type APIClient struct {
 	// User agent used when communicating with the Harvest API.
 	UserAgent string
 
+	// Logger receives the log output of the client. It is nil, and the
+	// client silent, by default. At debug level requests and responses are
+	// logged, with credentials redacted.
+	Logger *slog.Logger
+
+	// Middleware wraps every call made through Do, the first entry being the
+	// outermost. See Use.
+	Middleware []Middleware
+
+	// DryRun enables dry-run mode when set: calls that would change data
+	// are validated, logged and recorded in the plan instead of being sent,
+	// and answered with a synthetic response. GET requests still go
+	// through.
+	DryRun *Plan
+
 	common service // Reuse a single struct instead of allocating one for each service on the heap.
 
 	// Services used for talking to different parts of the Harvest API.
-	Client    *ClientService
-	Company   *CompanyService
-	Estimate  *EstimateService
-	Expense   *ExpenseService
-	Invoice   *InvoiceService
-	Project   *ProjectService
-	Role      *RoleService
-	Task      *TaskService
-	Timesheet *TimesheetService
-	User      *UserService
+	// They are exposed as interfaces so they can be replaced by mocks.
+	Client    ClientAPI
+	Company   CompanyAPI
+	Estimate  EstimateAPI
+	Expense   ExpenseAPI
+	Invoice   InvoiceAPI
+	Project   ProjectAPI
+	Role      RoleAPI
+	Task      TaskAPI
+	Timesheet TimesheetAPI
+	User      UserAPI
 }
 
 type service struct {
@@ -119,10 +145,7 @@ func NewAPIClient(httpClient *http.Client) *APIClient {
 		httpClient = &http.Client{}
 	}
 
-	baseURL, err := url.Parse(DefaultBaseURL)
-	if err != nil {
-		logrus.Error(err)
-	}
+	baseURL, _ := url.Parse(DefaultBaseURL) // DefaultBaseURL is a valid URL.
 
 	c := &APIClient{httpClient: httpClient, BaseURL: baseURL, UserAgent: UserAgent}
 	c.common.client = c
@@ -146,7 +169,9 @@ var ErrBaseURLMissingSlash = errors.New("BaseURL must have a trailing slash")
 // in which case it is resolved relative to the BaseURL of the Client.
 // Relative URLs should always be specified without a preceding slash. If
 // specified, the value pointed to by body is JSON encoded and included as the
-// request body.
+// request body. Bodies with a Validate method are validated first, and a
+// *ValidationError is returned if they are invalid. Requests made by a
+// service method carry its name, see Operation.
 func (c *APIClient) NewRequest(
 	ctx context.Context,
 	method,
@@ -162,6 +187,12 @@ func (c *APIClient) NewRequest(
 		return nil, err
 	}
 
+	if v, ok := body.(validator); ok {
+		if err := v.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	var buf io.ReadWriter
 
 	if body != nil {
@@ -173,6 +204,12 @@ func (c *APIClient) NewRequest(
 		if err != nil {
 			return nil, err
 		}
+
+		ctx = context.WithValue(ctx, requestBodyKey{}, body)
+	}
+
+	if op := callerOperation(); op != "" {
+		ctx = context.WithValue(ctx, operationKey{}, op)
 	}
 
 	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
@@ -204,9 +241,20 @@ func (c *APIClient) NewRequest(
 //
 // The provided ctx must be non-nil. If it is canceled or times out,
 // ctx.Err() will be returned.
+//
+// The request passes through the client's Middleware before it is sent.
 func (c *APIClient) Do(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
+	return c.chain().Do(ctx, req, v)
+}
+
+// send is the innermost Doer: it sends req and decodes the response.
+func (c *APIClient) send(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
+	c.logRequest(ctx, req)
+
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
+		c.logger().DebugContext(ctx, "harvest: request failed", "method", req.Method, "error", err)
+
 		// If we got an error, and the context has been canceled,
 		// the context's error is probably more useful.
 		select {
@@ -232,14 +280,16 @@ func (c *APIClient) Do(ctx context.Context, req *http.Request, v interface{}) (*
 		// Drain up to 512 bytes and close the body to let the Transport reuse the connection
 		drainBytes := 512
 		if _, err := io.CopyN(io.Discard, resp.Body, int64(drainBytes)); err != nil && !errors.Is(err, io.EOF) {
-			logrus.Error(err)
+			c.logger().WarnContext(ctx, "harvest: draining response body", "error", err)
 		}
 
 		if err := resp.Body.Close(); err != nil {
-			logrus.Error(err)
+			c.logger().WarnContext(ctx, "harvest: closing response body", "error", err)
 		}
 	}()
 
+	c.logResponse(ctx, resp)
+
 	if err := CheckResponse(resp); err != nil {
 		// even though there was an error, we still return the response
 		// in case the caller wants to inspect it further
@@ -370,9 +420,7 @@ func CheckResponse(r *http.Response) error {
 
 	data, err := io.ReadAll(r.Body)
 	if err == nil && data != nil {
-		if err := json.Unmarshal(data, errorResponse); err != nil {
-			logrus.Error(err)
-		}
+		_ = json.Unmarshal(data, errorResponse) // Non-JSON bodies are ignored, see above.
 	}
 
 	switch r.StatusCode {
@@ -441,6 +489,10 @@ func Ints64(v []int64) *[]int64 { return &v }
 // to store v and returns a pointer to it.
 func Float64(v float64) *float64 { return &v }
 
+// HoursP is a helper routine that allocates a new Hours value
+// to store v and returns a pointer to it.
+func HoursP(v Hours) *Hours { return &v }
+
 // String is a helper routine that allocates a new string value
 // to store v and returns a pointer to it.
 func String(v string) *string { return &v }
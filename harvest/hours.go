@@ -0,0 +1,232 @@
+package harvest
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrHoursParse = errors.New(`ErrHoursParse: should be decimal hours "1.5", hours and minutes "1:30" or a duration "90m"`)
+
+const (
+	// TimeFormatDecimal is the Company.TimeFormat value for decimal hours, e.g. "1.50".
+	TimeFormatDecimal = "decimal"
+	// TimeFormatHoursMinutes is the Company.TimeFormat value for hours and minutes, e.g. "1:30".
+	TimeFormatHoursMinutes = "hours_minutes"
+
+	defaultDecimalSymbol      = "."
+	defaultThousandsSeparator = ","
+	minutesPerHour            = 60
+	secondsPerHour            = 3600
+	digitGroupSize            = 3
+)
+
+// Hours is an amount of tracked time expressed in decimal hours, the unit the
+// Harvest API uses for hours and rounded_hours. It is encoded as a JSON number.
+type Hours float64
+
+// ParseHours parses decimal hours ("1.5"), hours and minutes ("1:30") or a Go
+// duration string ("90m", "1h30m"). NaN and infinities are rejected.
+func ParseHours(s string) (Hours, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, ErrHoursParse
+	}
+
+	if h, m, ok := strings.Cut(s, ":"); ok {
+		return parseHoursMinutes(h, m)
+	}
+
+	if f, err := strconv.ParseFloat(s, bitSize64); err == nil {
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return 0, ErrHoursParse
+		}
+
+		return Hours(f), nil
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, ErrHoursParse
+	}
+
+	return HoursFromDuration(d), nil
+}
+
+func parseHoursMinutes(h, m string) (Hours, error) {
+	negative := strings.HasPrefix(h, "-")
+	h = strings.TrimPrefix(h, "-")
+
+	hours, err := strconv.ParseUint(h, baseDecimal, bitSize64)
+	if err != nil && h != "" {
+		return 0, ErrHoursParse
+	}
+
+	minutes, err := strconv.ParseUint(m, baseDecimal, bitSize64)
+	if err != nil || len(m) != 2 || minutes >= minutesPerHour {
+		return 0, ErrHoursParse
+	}
+
+	v := Hours(float64(hours) + float64(minutes)/minutesPerHour)
+	if negative {
+		v = -v
+	}
+
+	return v, nil
+}
+
+// HoursFromDuration converts d to decimal hours.
+func HoursFromDuration(d time.Duration) Hours {
+	return Hours(d.Hours())
+}
+
+// Duration converts h to a time.Duration, rounded to the nearest second.
+func (h Hours) Duration() time.Duration {
+	return time.Duration(math.Round(float64(h)*secondsPerHour)) * time.Second
+}
+
+// Float64 returns h as a plain float64.
+func (h Hours) Float64() float64 {
+	return float64(h)
+}
+
+// HoursMinutes returns h split into whole hours and minutes, rounded to the
+// nearest minute. For negative values both parts are negative.
+func (h Hours) HoursMinutes() (int, int) {
+	total := int(math.Round(float64(h) * minutesPerHour))
+
+	return total / minutesPerHour, total % minutesPerHour
+}
+
+func (h *Hours) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var f float64
+	if err := json.Unmarshal(data, &f); err == nil {
+		*h = Hours(f)
+
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return ErrHoursParse
+	}
+
+	v, err := ParseHours(s)
+	if err != nil {
+		return err
+	}
+
+	*h = v
+
+	return nil
+}
+
+// FormatHours formats h the way Harvest displays it for the company: either as
+// hours and minutes ("1:30") or as decimal hours ("1.50") using the company's
+// decimal symbol and thousands separator. A nil company formats as decimal
+// hours with "." and ",".
+func (c *Company) FormatHours(h Hours) string {
+	timeFormat, decimalSymbol, thousandsSeparator := c.displayFormat()
+
+	if timeFormat != TimeFormatHoursMinutes {
+		return formatDecimal(float64(h), decimalSymbol, thousandsSeparator)
+	}
+
+	// The sign is taken after rounding, so that "-0:00" isn't shown.
+	sign := ""
+
+	hours, minutes := h.HoursMinutes()
+	if hours < 0 || minutes < 0 {
+		sign = "-"
+		hours, minutes = -hours, -minutes
+	}
+
+	return sign + groupThousands(strconv.Itoa(hours), thousandsSeparator) + ":" + twoDigits(minutes)
+}
+
+// FormatNumber formats v with two decimals using the company's decimal symbol
+// and thousands separator, the way Harvest displays rates and amounts. A nil
+// company formats with "." and ",".
+func (c *Company) FormatNumber(v float64) string {
+	_, decimalSymbol, thousandsSeparator := c.displayFormat()
+
+	return formatDecimal(v, decimalSymbol, thousandsSeparator)
+}
+
+// displayFormat returns the company's time format, decimal symbol and
+// thousands separator, falling back to the defaults for unset ones.
+func (c *Company) displayFormat() (string, string, string) {
+	timeFormat := TimeFormatDecimal
+	decimalSymbol := defaultDecimalSymbol
+	thousandsSeparator := defaultThousandsSeparator
+
+	if c != nil {
+		if c.TimeFormat != nil {
+			timeFormat = *c.TimeFormat
+		}
+
+		if c.DecimalSymbol != nil {
+			decimalSymbol = *c.DecimalSymbol
+		}
+
+		if c.ThousandsSeparator != nil {
+			thousandsSeparator = *c.ThousandsSeparator
+		}
+	}
+
+	return timeFormat, decimalSymbol, thousandsSeparator
+}
+
+func formatDecimal(v float64, decimalSymbol, thousandsSeparator string) string {
+	s := strconv.FormatFloat(v, 'f', 2, bitSize64)
+
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		s = strings.TrimPrefix(s, "-")
+		if strings.Trim(s, "0.") != "" {
+			sign = "-"
+		}
+	}
+
+	whole, fraction, _ := strings.Cut(s, ".")
+
+	return sign + groupThousands(whole, thousandsSeparator) + decimalSymbol + fraction
+}
+
+func groupThousands(digits, separator string) string {
+	if separator == "" || len(digits) <= digitGroupSize {
+		return digits
+	}
+
+	var b strings.Builder
+
+	head := len(digits) % digitGroupSize
+	if head > 0 {
+		b.WriteString(digits[:head])
+	}
+
+	for i := head; i < len(digits); i += digitGroupSize {
+		if b.Len() > 0 {
+			b.WriteString(separator)
+		}
+
+		b.WriteString(digits[i : i+digitGroupSize])
+	}
+
+	return b.String()
+}
+
+func twoDigits(n int) string {
+	if n < baseDecimal {
+		return "0" + strconv.Itoa(n)
+	}
+
+	return strconv.Itoa(n)
+}
@@ -0,0 +1,261 @@
+package harvest_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/becoded/go-harvest/harvest"
+)
+
+func TestParseHours(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  harvest.Hours
+		err   error
+	}{
+		{name: "decimal", input: "1.5", want: 1.5},
+		{name: "whole", input: "8", want: 8},
+		{name: "hours and minutes", input: "1:30", want: 1.5},
+		{name: "minutes only", input: ":45", want: 0.75},
+		{name: "negative hours and minutes", input: "-2:15", want: -2.25},
+		{name: "duration minutes", input: "90m", want: 1.5},
+		{name: "duration hours and minutes", input: "2h15m", want: 2.25},
+		{name: "surrounding whitespace", input: " 0.25 ", want: 0.25},
+		{name: "empty", input: "", err: harvest.ErrHoursParse},
+		{name: "minutes out of range", input: "1:75", err: harvest.ErrHoursParse},
+		{name: "single digit minutes", input: "1:5", err: harvest.ErrHoursParse},
+		{name: "gibberish", input: "one hour", err: harvest.ErrHoursParse},
+		{name: "not a number", input: "NaN", err: harvest.ErrHoursParse},
+		{name: "infinity", input: "-Inf", err: harvest.ErrHoursParse},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := harvest.ParseHours(tt.input)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.InDelta(t, float64(tt.want), float64(got), 1e-9)
+		})
+	}
+}
+
+func TestHours_Duration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input harvest.Hours
+		want  time.Duration
+	}{
+		{name: "zero", input: 0, want: 0},
+		{name: "one and a half", input: 1.5, want: 90 * time.Minute},
+		{name: "rounded to the second", input: 0.02, want: 72 * time.Second},
+		{name: "negative", input: -0.25, want: -15 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.input.Duration())
+			assert.InDelta(t, float64(tt.input), float64(harvest.HoursFromDuration(tt.want)), 1e-9)
+		})
+	}
+}
+
+func TestHours_HoursMinutes(t *testing.T) {
+	t.Parallel()
+
+	hours, minutes := harvest.Hours(2.11).HoursMinutes()
+	assert.Equal(t, 2, hours)
+	assert.Equal(t, 7, minutes)
+
+	hours, minutes = harvest.Hours(1.999).HoursMinutes()
+	assert.Equal(t, 2, hours)
+	assert.Equal(t, 0, minutes)
+}
+
+func TestCompany_FormatHours(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		company *harvest.Company
+		input   harvest.Hours
+		want    string
+	}{
+		{
+			name:    "nil company",
+			company: nil,
+			input:   1.5,
+			want:    "1.50",
+		},
+		{
+			name:    "decimal",
+			company: &harvest.Company{TimeFormat: harvest.String(harvest.TimeFormatDecimal)},
+			input:   2.11,
+			want:    "2.11",
+		},
+		{
+			name: "decimal with european separators",
+			company: &harvest.Company{
+				TimeFormat:         harvest.String(harvest.TimeFormatDecimal),
+				DecimalSymbol:      harvest.String(","),
+				ThousandsSeparator: harvest.String("."),
+			},
+			input: 1234.5,
+			want:  "1.234,50",
+		},
+		{
+			name: "decimal without thousands separator",
+			company: &harvest.Company{
+				DecimalSymbol:      harvest.String("."),
+				ThousandsSeparator: harvest.String(""),
+			},
+			input: 123456,
+			want:  "123456.00",
+		},
+		{
+			name:    "hours and minutes",
+			company: &harvest.Company{TimeFormat: harvest.String(harvest.TimeFormatHoursMinutes)},
+			input:   2.11,
+			want:    "2:07",
+		},
+		{
+			name:    "hours and minutes with thousands",
+			company: &harvest.Company{TimeFormat: harvest.String(harvest.TimeFormatHoursMinutes)},
+			input:   1500.25,
+			want:    "1,500:15",
+		},
+		{
+			name:    "negative hours and minutes",
+			company: &harvest.Company{TimeFormat: harvest.String(harvest.TimeFormatHoursMinutes)},
+			input:   -0.5,
+			want:    "-0:30",
+		},
+		{
+			name:    "negative hours and minutes rounding to zero",
+			company: &harvest.Company{TimeFormat: harvest.String(harvest.TimeFormatHoursMinutes)},
+			input:   -0.001,
+			want:    "0:00",
+		},
+		{
+			name:    "negative decimal rounding to zero",
+			company: nil,
+			input:   -0.001,
+			want:    "0.00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.company.FormatHours(tt.input))
+		})
+	}
+}
+
+func TestCompany_FormatNumber(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		company *harvest.Company
+		input   float64
+		want    string
+	}{
+		{
+			name:    "nil company",
+			company: nil,
+			input:   1234.5,
+			want:    "1,234.50",
+		},
+		{
+			name:    "ignores the time format",
+			company: &harvest.Company{TimeFormat: harvest.String(harvest.TimeFormatHoursMinutes)},
+			input:   2.11,
+			want:    "2.11",
+		},
+		{
+			name: "european separators",
+			company: &harvest.Company{
+				DecimalSymbol:      harvest.String(","),
+				ThousandsSeparator: harvest.String("."),
+			},
+			input: -1234567.891,
+			want:  "-1.234.567,89",
+		},
+		{
+			name:    "rounds to zero",
+			company: nil,
+			input:   -0.001,
+			want:    "0.00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.company.FormatNumber(tt.input))
+		})
+	}
+}
+
+func TestHours_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  *harvest.Hours
+		err   error
+	}{
+		{name: "number", input: `{"hours":2.11}`, want: harvest.HoursP(2.11)},
+		{name: "string", input: `{"hours":"1:30"}`, want: harvest.HoursP(1.5)},
+		{name: "null", input: `{"hours":null}`, want: nil},
+		{name: "invalid", input: `{"hours":"soon"}`, err: harvest.ErrHoursParse},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got struct {
+				Hours *harvest.Hours `json:"hours"`
+			}
+
+			err := json.Unmarshal([]byte(tt.input), &got)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got.Hours)
+		})
+	}
+}
+
+func TestHours_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	b, err := json.Marshal(harvest.TimeEntryCreateViaDuration{Hours: harvest.HoursP(1.25)})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"project_id":null,"task_id":null,"spent_date":null,"hours":1.25}`, string(b))
+}
@@ -128,7 +128,7 @@ type InvoiceListOptions struct {
 
 type InvoiceCreateRequest struct {
 	// required	The ID of the client this invoice belongs to.
-	ClientID *int64 `json:"client_id"`
+	ClientID *int64 `json:"client_id" validate:"required"`
 	// optional	The ID of the retainer associated with this invoice.
 	RetainerID *int64 `json:"retainer_id,omitempty"`
 	// optional	The ID of the estimate associated with this invoice.
@@ -170,19 +170,24 @@ type InvoiceCreateRequest struct {
 	LineItemsImport *InvoiceLineItemImportRequest `json:"line_items_import,omitempty"`
 }
 
+// Validate reports required fields that are missing.
+func (r *InvoiceCreateRequest) Validate() error {
+	return validate(r)
+}
+
 type InvoiceLineItemRequest struct {
 	// Unique ID for the line item.
 	ID *int64 `json:"id,omitempty"`
 	// optional	The ID of the project associated with this line item.
 	ProjectID *int64 `json:"project_id,omitempty"`
 	// required	The name of an invoice item category.
-	Kind *string `json:"kind"`
+	Kind *string `json:"kind" validate:"required"`
 	// optional	Text description of the line item.
 	Description *string `json:"description,omitempty"`
 	// optional	The unit quantity of the item. Defaults to 1.
 	Quantity *int64 `json:"quantity,omitempty"`
 	// required	The individual price per unit.
-	UnitPrice *float64 `json:"unit_price"`
+	UnitPrice *float64 `json:"unit_price" validate:"required"`
 	// optional	Whether the invoice’s tax percentage applies to this line item. Defaults to false.
 	Taxed *bool `json:"taxed,omitempty"`
 	// optional	Whether the invoice’s tax2 percentage applies to this line item. Defaults to false.
@@ -191,18 +196,28 @@ type InvoiceLineItemRequest struct {
 	Destroy *bool `json:"_destroy,omitempty"`
 }
 
+// Validate reports required fields that are missing.
+func (r *InvoiceLineItemRequest) Validate() error {
+	return validate(r)
+}
+
 type InvoiceLineItemImportRequest struct {
 	// required	An array of the client’s project IDs you’d like to include time/expenses from.
-	ProjectIDs *[]int64 `json:"project_ids"`
+	ProjectIDs *[]int64 `json:"project_ids" validate:"required"`
 	// optional	A time import object.
 	Time *InvoiceLineItemImportTimeRequest `json:"time,omitempty"`
 	// optional	An expense import object.
 	Expenses *InvoiceLineItemImportExpenseRequest `json:"expenses,omitempty"`
 }
 
+// Validate reports required fields that are missing.
+func (r *InvoiceLineItemImportRequest) Validate() error {
+	return validate(r)
+}
+
 type InvoiceLineItemImportTimeRequest struct {
 	// required	How to summarize the time entries per line item. Options: project, task, people, or detailed.
-	SummaryType *string `json:"summary_type"`
+	SummaryType *string `json:"summary_type" validate:"required,oneof=project task people detailed"`
 	// optional	Start date for included time entries.
 	// Must be provided if to is present. If neither from or to are provided,
 	// all unbilled time entries will be included.
@@ -213,9 +228,14 @@ type InvoiceLineItemImportTimeRequest struct {
 	To *Date `json:"to,omitempty"`
 }
 
+// Validate reports required fields that are missing and invalid options.
+func (r *InvoiceLineItemImportTimeRequest) Validate() error {
+	return validate(r)
+}
+
 type InvoiceLineItemImportExpenseRequest struct {
 	// required	How to summarize the expenses per line item. Options: project, category, people, or detailed.
-	SummaryType *string `json:"summary_type"`
+	SummaryType *string `json:"summary_type" validate:"required,oneof=project category people detailed"`
 	// optional	Start date for included expenses.
 	// Must be provided if to is present. If neither from or to are provided, all unbilled expenses will be included.
 	From *Date `json:"from,omitempty"`
@@ -227,6 +247,11 @@ type InvoiceLineItemImportExpenseRequest struct {
 	AttachReceipt *bool `json:"attach_receipt,omitempty"`
 }
 
+// Validate reports required fields that are missing and invalid options.
+func (r *InvoiceLineItemImportExpenseRequest) Validate() error {
+	return validate(r)
+}
+
 type InvoiceUpdateRequest struct {
 	// The ID of the client this invoice belongs to.
 	ClientID *int64 `json:"client_id,omitempty"`
@@ -259,6 +284,11 @@ type InvoiceUpdateRequest struct {
 	LineItems *[]InvoiceLineItemRequest `json:"line_items,omitempty"`
 }
 
+// Validate checks the request before it is sent.
+func (r *InvoiceUpdateRequest) Validate() error {
+	return validate(r)
+}
+
 // List returns a list of your invoices.
 func (s *InvoiceService) List(ctx context.Context, opt *InvoiceListOptions) (*InvoiceList, *http.Response, error) {
 	u := "invoices"
@@ -35,6 +35,12 @@ type InvoiceItemCategoryRequest struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// Validate checks the request before it is sent. The name is only required
+// when creating, so it is left to the API.
+func (r *InvoiceItemCategoryRequest) Validate() error {
+	return validate(r)
+}
+
 func (p InvoiceItemCategory) String() string {
 	return Stringify(p)
 }
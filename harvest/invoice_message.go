@@ -50,7 +50,12 @@ type InvoiceMessageRecipient struct {
 	// Name of the message recipient.
 	Name *string `json:"name,omitempty"`
 	// Email of the message recipient.
-	Email *string `json:"email"`
+	Email *string `json:"email" validate:"required"`
+}
+
+// Validate reports required fields that are missing.
+func (r *InvoiceMessageRecipient) Validate() error {
+	return validate(r)
 }
 
 type InvoiceMessageList struct {
@@ -74,12 +79,17 @@ type InvoiceMessageListOptions struct {
 }
 
 type EventTypeRequest struct {
-	EventType string `json:"event_type"`
+	EventType string `json:"event_type" validate:"required,oneof=close draft re-open send"`
+}
+
+// Validate reports required fields that are missing and invalid options.
+func (r *EventTypeRequest) Validate() error {
+	return validate(r)
 }
 
 type InvoiceMessageCreateRequest struct {
 	// required	Array of recipient parameters. See below for details.
-	Recipients *[]InvoiceMessageRecipient `json:"recipients"`
+	Recipients *[]InvoiceMessageRecipient `json:"recipients" validate:"required"`
 	// optional	The message subject.
 	Subject *string `json:"subject,omitempty"`
 	// optional	The message body.
@@ -97,6 +107,11 @@ type InvoiceMessageCreateRequest struct {
 	EventType *bool `json:"event_type,omitempty"`
 }
 
+// Validate reports required fields that are missing.
+func (r *InvoiceMessageCreateRequest) Validate() error {
+	return validate(r)
+}
+
 // ListInvoiceMessages returns a list of messages associated with a given invoice.
 func (s *InvoiceService) ListInvoiceMessages(
 	ctx context.Context,
@@ -45,7 +45,7 @@ type InvoicePaymentList struct {
 
 type InvoicePaymentRequest struct {
 	// required The amount of the payment.
-	Amount *float64 `json:"amount"`
+	Amount *float64 `json:"amount" validate:"required"`
 	// optional Date and time the payment was made. Pass either paid_at or paid_date, but not both.
 	PaidAt *time.Time `json:"paid_at,omitempty"`
 	// optional	Date the payment was made. Pass either paid_at or paid_date, but not both.
@@ -54,6 +54,11 @@ type InvoicePaymentRequest struct {
 	Notes *string `json:"notes,omitempty"`
 }
 
+// Validate reports required fields that are missing.
+func (r *InvoicePaymentRequest) Validate() error {
+	return validate(r)
+}
+
 func (p InvoicePayment) String() string {
 	return Stringify(p)
 }
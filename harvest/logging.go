@@ -0,0 +1,155 @@
+package harvest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+// maxLoggedBody is the number of body bytes written to debug logs.
+const maxLoggedBody = 4096
+
+const redacted = "REDACTED"
+
+// redactedHeaders are the headers whose values never appear in logs.
+var redactedHeaders = []string{ //nolint: gochecknoglobals
+	"Authorization",
+	"Harvest-Account-ID",
+	"Cookie",
+	"Set-Cookie",
+}
+
+// redactedFields are the JSON body fields whose values never appear in logs.
+var redactedFields = map[string]bool{ //nolint: gochecknoglobals
+	"access_token":  true,
+	"refresh_token": true,
+	"client_secret": true,
+	"password":      true,
+}
+
+// logger returns the configured logger, or one that discards everything.
+func (c *APIClient) logger() *slog.Logger {
+	if c.Logger == nil {
+		return slog.New(slog.DiscardHandler)
+	}
+
+	return c.Logger
+}
+
+// logRequest logs req at debug level. The body is read through GetBody so
+// the request itself is left untouched.
+func (c *APIClient) logRequest(ctx context.Context, req *http.Request) {
+	l := c.logger()
+	if !l.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+
+	var body []byte
+
+	if req.GetBody != nil {
+		if rc, err := req.GetBody(); err == nil {
+			body, _ = io.ReadAll(rc)
+			_ = rc.Close()
+		}
+	}
+
+	u := *req.URL
+
+	l.DebugContext(ctx, "harvest: request",
+		slog.String("method", req.Method),
+		slog.String("url", sanitizeURL(&u).String()),
+		slog.Any("header", redactHeader(req.Header)),
+		slog.String("body", redactBody(body)),
+	)
+}
+
+// logResponse logs resp at debug level. The body is buffered and replaced so
+// the caller can still decode it.
+func (c *APIClient) logResponse(ctx context.Context, resp *http.Response) {
+	l := c.logger()
+	if !l.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if closeErr := resp.Body.Close(); err == nil {
+		err = closeErr
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	attrs := []any{
+		slog.Int("status", resp.StatusCode),
+		slog.Any("header", redactHeader(resp.Header)),
+		slog.String("body", redactBody(body)),
+	}
+
+	if resp.Request != nil {
+		u := *resp.Request.URL
+		attrs = append(attrs, slog.String("method", resp.Request.Method), slog.String("url", sanitizeURL(&u).String()))
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.Any("error", err))
+	}
+
+	l.DebugContext(ctx, "harvest: response", attrs...)
+}
+
+func redactHeader(h http.Header) http.Header {
+	h = h.Clone()
+
+	for _, name := range redactedHeaders {
+		if _, ok := h[http.CanonicalHeaderKey(name)]; ok {
+			h.Set(name, redacted)
+		}
+	}
+
+	return h
+}
+
+// redactBody returns body for logging. Sensitive fields of JSON bodies are
+// redacted and long bodies are truncated.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err == nil {
+		if data, err := json.Marshal(redactValue(v)); err == nil {
+			body = data
+		}
+	}
+
+	if len(body) > maxLoggedBody {
+		return string(body[:maxLoggedBody]) + "...(truncated)"
+	}
+
+	return string(body)
+}
+
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if redactedFields[strings.ToLower(key)] {
+				v[key] = redacted
+
+				continue
+			}
+
+			v[key] = redactValue(val)
+		}
+	case []interface{}:
+		for i, val := range v {
+			v[i] = redactValue(val)
+		}
+	}
+
+	return v
+}
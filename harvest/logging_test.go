@@ -0,0 +1,112 @@
+package harvest_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDo_logging(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		level      slog.Level
+		wantLogged []string
+		notLogged  []string
+	}{
+		{
+			name:  "debug logs redacted requests and responses",
+			level: slog.LevelDebug,
+			wantLogged: []string{
+				"harvest: request",
+				"harvest: response",
+				`"method":"POST"`,
+				`"status":201`,
+				`\"name\":\"Acme\"`,
+				`\"access_token\":\"REDACTED\"`,
+				`"Authorization":["REDACTED"]`,
+				`"Harvest-Account-Id":["REDACTED"]`,
+			},
+			notLogged: []string{"secret-token", "test-account-id", "response-secret"},
+		},
+		{
+			name:      "info level stays silent",
+			level:     slog.LevelInfo,
+			notLogged: []string{"harvest:"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux, teardown := setup(t)
+			t.Cleanup(teardown)
+
+			var buf bytes.Buffer
+
+			client.Logger = slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: tt.level}))
+
+			mux.HandleFunc("/clients", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "POST")
+				w.WriteHeader(http.StatusCreated)
+				fmt.Fprint(w, `{"id":1,"name":"Acme","access_token":"response-secret"}`)
+			})
+
+			ctx := context.Background()
+
+			req, err := client.NewRequest(ctx, "POST", "clients", map[string]string{
+				"name":         "Acme",
+				"access_token": "secret-token",
+			})
+			assert.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer secret-token")
+
+			body := new(struct {
+				ID   int64  `json:"id"`
+				Name string `json:"name"`
+			})
+
+			_, err = client.Do(ctx, req, body)
+			assert.NoError(t, err)
+			assert.Equal(t, "Acme", body.Name, "the response body is still decoded")
+
+			logged := buf.String()
+			for _, want := range tt.wantLogged {
+				assert.Contains(t, logged, want)
+			}
+
+			for _, secret := range tt.notLogged {
+				assert.NotContains(t, logged, secret)
+			}
+		})
+	}
+}
+
+func TestDo_silentByDefault(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup(t)
+	t.Cleanup(teardown)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, strings.Repeat("x", 1024))
+	})
+
+	ctx := context.Background()
+	req, _ := client.NewRequest(ctx, "GET", ".", nil)
+
+	var buf bytes.Buffer
+
+	_, err := client.Do(ctx, req, &buf)
+	assert.NoError(t, err)
+	assert.Nil(t, client.Logger)
+	assert.Equal(t, 1024, buf.Len())
+}
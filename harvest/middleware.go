@@ -0,0 +1,212 @@
+package harvest
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// RequestIDHeader is the header RequestIDMiddleware sets.
+const RequestIDHeader = "X-Request-Id"
+
+// A Doer sends an API request and decodes the response into v, following the
+// contract of APIClient.Do.
+type Doer interface {
+	Do(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error)
+}
+
+// DoerFunc adapts a function to the Doer interface.
+type DoerFunc func(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error)
+
+// Do calls f(ctx, req, v).
+func (f DoerFunc) Do(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
+	return f(ctx, req, v)
+}
+
+// Middleware wraps a Doer with behaviour that runs around every API call. It
+// sees the typed request body through RequestBody and, once next returns, the
+// decoded response in v.
+type Middleware func(next Doer) Doer
+
+// Use appends middleware to the client. The first middleware added is the
+// outermost one.
+func (c *APIClient) Use(mw ...Middleware) {
+	c.Middleware = append(c.Middleware, mw...)
+}
+
+// chain returns the configured middleware wrapped around send, with the
+// dry-run step innermost so the other middleware sees planned calls too.
+func (c *APIClient) chain() Doer {
+	var d Doer = DoerFunc(c.send)
+
+	if c.DryRun != nil {
+		d = c.dryRun(d)
+	}
+
+	for i := len(c.Middleware) - 1; i >= 0; i-- {
+		d = c.Middleware[i](d)
+	}
+
+	return d
+}
+
+type requestBodyKey struct{}
+
+// RequestBody returns the value that was passed as body to NewRequest, such
+// as a *TimeEntryCreateViaDuration, or nil if the request has no body.
+func RequestBody(req *http.Request) interface{} {
+	return req.Context().Value(requestBodyKey{})
+}
+
+type operationKey struct{}
+
+// Operation returns the service method that created req, such as
+// "Timesheet.StopTimeEntry", or "" for requests built outside the services.
+func Operation(req *http.Request) string {
+	op, _ := req.Context().Value(operationKey{}).(string)
+
+	return op
+}
+
+// callerOperation names the service method that called NewRequest, from its
+// function name "github.com/becoded/go-harvest/harvest.(*TimesheetService).StopTimeEntry".
+func callerOperation() string {
+	pcs := make([]uintptr, 1)
+	if runtime.Callers(3, pcs) == 0 { //nolint: mnd // runtime.Callers, callerOperation, NewRequest.
+		return ""
+	}
+
+	frame, _ := runtime.CallersFrames(pcs).Next()
+
+	name := frame.Function[strings.LastIndex(frame.Function, "/")+1:]
+
+	name, ok := strings.CutPrefix(name, "harvest.(*")
+	if !ok {
+		return ""
+	}
+
+	service, method, ok := strings.Cut(name, ").")
+	if !ok || !strings.HasSuffix(service, "Service") {
+		return ""
+	}
+
+	method, _, _ = strings.Cut(method, ".")
+
+	return strings.TrimSuffix(service, "Service") + "." + method
+}
+
+type attemptKey struct{}
+
+// WithAttempt returns a copy of ctx that marks the calls made with it as
+// attempt n of the same operation. Callers that retry a call, such as
+// bulk.Executor, set it so middleware can count retries.
+func WithAttempt(ctx context.Context, n int) context.Context {
+	return context.WithValue(ctx, attemptKey{}, n)
+}
+
+// Attempt returns the attempt set with WithAttempt, or 1 if there is none.
+func Attempt(ctx context.Context) int {
+	if n, ok := ctx.Value(attemptKey{}).(int); ok {
+		return n
+	}
+
+	return 1
+}
+
+// RequestIDMiddleware sets the X-Request-Id header on requests that don't
+// have one yet. IDs are generated by generate, or are random 128-bit hex
+// strings if generate is nil.
+func RequestIDMiddleware(generate func() string) Middleware {
+	if generate == nil {
+		generate = randomID
+	}
+
+	return func(next Doer) Doer {
+		return DoerFunc(func(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
+			if req.Header.Get(RequestIDHeader) == "" {
+				req.Header.Set(RequestIDHeader, generate())
+			}
+
+			return next.Do(ctx, req, v)
+		})
+	}
+}
+
+func randomID() string {
+	b := make([]byte, 16) //nolint: mnd
+	_, _ = rand.Read(b)   // crypto/rand.Read never returns an error.
+
+	return hex.EncodeToString(b)
+}
+
+// HeaderMiddleware sets the headers in h on every request, replacing any
+// values already present.
+func HeaderMiddleware(h http.Header) Middleware {
+	h = h.Clone()
+
+	return func(next Doer) Doer {
+		return DoerFunc(func(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
+			for name, values := range h {
+				req.Header[http.CanonicalHeaderKey(name)] = values
+			}
+
+			return next.Do(ctx, req, v)
+		})
+	}
+}
+
+// AuditMiddleware logs every call that changes data (any method but GET and
+// HEAD) to logger at info level: the method, URL, request ID, type of the
+// request body, status, duration and, when the decoded response has one, the
+// ID of the affected record. Failed calls are logged at error level.
+func AuditMiddleware(logger *slog.Logger) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
+			if req.Method == http.MethodGet || req.Method == http.MethodHead {
+				return next.Do(ctx, req, v)
+			}
+
+			start := time.Now()
+			resp, err := next.Do(ctx, req, v)
+
+			u := *req.URL
+			attrs := []any{
+				slog.String("method", req.Method),
+				slog.String("url", sanitizeURL(&u).String()),
+				slog.Duration("duration", time.Since(start)),
+			}
+
+			if id := req.Header.Get(RequestIDHeader); id != "" {
+				attrs = append(attrs, slog.String("request_id", id))
+			}
+
+			if body := RequestBody(req); body != nil {
+				attrs = append(attrs, slog.String("request", fmt.Sprintf("%T", body)))
+			}
+
+			if resp != nil {
+				attrs = append(attrs, slog.Int("status", resp.StatusCode))
+			}
+
+			if err != nil {
+				logger.ErrorContext(ctx, "harvest: audit", append(attrs, slog.Any("error", err))...)
+
+				return resp, err
+			}
+
+			if r, ok := v.(interface{ GetID() int64 }); ok && r.GetID() != 0 {
+				attrs = append(attrs, slog.Int64("id", r.GetID()))
+			}
+
+			logger.InfoContext(ctx, "harvest: audit", attrs...)
+
+			return resp, err
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package harvest_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/becoded/go-harvest/harvest"
+)
+
+func TestDo_middlewareOrder(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup(t)
+	t.Cleanup(teardown)
+
+	mux.HandleFunc("/clients", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":1,"name":"Acme"}`)
+	})
+
+	var calls []string
+
+	trace := func(name string) harvest.Middleware {
+		return func(next harvest.Doer) harvest.Doer {
+			return harvest.DoerFunc(func(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
+				calls = append(calls, name+" before")
+				resp, err := next.Do(ctx, req, v)
+				calls = append(calls, name+" after")
+
+				return resp, err
+			})
+		}
+	}
+
+	var (
+		requested interface{}
+		operation string
+		decoded   *harvest.Client
+	)
+
+	inspect := func(next harvest.Doer) harvest.Doer {
+		return harvest.DoerFunc(func(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
+			requested = harvest.RequestBody(req)
+			operation = harvest.Operation(req)
+			resp, err := next.Do(ctx, req, v)
+			decoded, _ = v.(*harvest.Client)
+
+			return resp, err
+		})
+	}
+
+	client.Use(trace("outer"), trace("inner"), inspect)
+
+	data := &harvest.ClientCreateRequest{Name: harvest.String("Acme")}
+
+	_, _, err := client.Client.Create(context.Background(), data)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"outer before", "inner before", "inner after", "outer after"}, calls)
+	assert.Same(t, data, requested)
+	assert.Equal(t, "Client.Create", operation)
+	assert.Equal(t, "Acme", decoded.GetName())
+
+	req, err := client.NewRequest(context.Background(), "GET", "clients", nil)
+	assert.NoError(t, err)
+	assert.Empty(t, harvest.Operation(req), "requests built outside the services have no operation")
+}
+
+func TestAttempt(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	assert.Equal(t, 1, harvest.Attempt(ctx))
+	assert.Equal(t, 3, harvest.Attempt(harvest.WithAttempt(ctx, 3)))
+}
+
+func TestRequestIDMiddleware(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		generate func() string
+		preset   string
+		want     string
+	}{
+		{
+			name:     "generated",
+			generate: func() string { return "generated-id" },
+			want:     "generated-id",
+		},
+		{
+			name:     "kept when already set",
+			generate: func() string { return "generated-id" },
+			preset:   "caller-id",
+			want:     "caller-id",
+		},
+		{
+			name: "random by default",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux, teardown := setup(t)
+			t.Cleanup(teardown)
+
+			var got string
+
+			mux.HandleFunc("/company", func(w http.ResponseWriter, r *http.Request) {
+				got = r.Header.Get(harvest.RequestIDHeader)
+
+				fmt.Fprint(w, `{}`)
+			})
+
+			client.Use(harvest.RequestIDMiddleware(tt.generate))
+
+			ctx := context.Background()
+			req, _ := client.NewRequest(ctx, "GET", "company", nil)
+
+			if tt.preset != "" {
+				req.Header.Set(harvest.RequestIDHeader, tt.preset)
+			}
+
+			_, err := client.Do(ctx, req, nil)
+			assert.NoError(t, err)
+
+			if tt.want == "" {
+				assert.Len(t, got, 32)
+			} else {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestHeaderMiddleware(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup(t)
+	t.Cleanup(teardown)
+
+	mux.HandleFunc("/company", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "reporting", r.Header.Get("X-Team"))
+		assert.Equal(t, "custom-agent", r.Header.Get("User-Agent"))
+
+		fmt.Fprint(w, `{}`)
+	})
+
+	client.Use(harvest.HeaderMiddleware(http.Header{
+		"x-team":     {"reporting"},
+		"User-Agent": {"custom-agent"},
+	}))
+
+	_, _, err := client.Company.Get(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestAuditMiddleware(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup(t)
+	t.Cleanup(teardown)
+
+	mux.HandleFunc("/clients", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":7,"name":"Acme"}`)
+	})
+	mux.HandleFunc("/clients/7", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `{"id":7,"name":"Acme"}`)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Name can't be blank"}`)
+	})
+
+	var buf bytes.Buffer
+
+	client.Use(
+		harvest.RequestIDMiddleware(func() string { return "req-1" }),
+		harvest.AuditMiddleware(slog.New(slog.NewJSONHandler(&buf, nil))),
+	)
+
+	ctx := context.Background()
+
+	_, _, err := client.Client.Create(ctx, &harvest.ClientCreateRequest{Name: harvest.String("Acme")})
+	assert.NoError(t, err)
+
+	_, _, err = client.Client.Get(ctx, 7)
+	assert.NoError(t, err)
+
+	_, _, err = client.Client.Update(ctx, 7, &harvest.ClientUpdateRequest{Name: harvest.String("")})
+	assert.Error(t, err)
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	assert.Len(t, lines, 2, "GET requests are not audited")
+
+	created := string(lines[0])
+	assert.Contains(t, created, `"level":"INFO"`)
+	assert.Contains(t, created, `"method":"POST"`)
+	assert.Contains(t, created, `"request_id":"req-1"`)
+	assert.Contains(t, created, `"request":"*harvest.ClientCreateRequest"`)
+	assert.Contains(t, created, `"status":201`)
+	assert.Contains(t, created, `"id":7`)
+
+	failed := string(lines[1])
+	assert.Contains(t, failed, `"level":"ERROR"`)
+	assert.Contains(t, failed, `"method":"PATCH"`)
+	assert.Contains(t, failed, `"status":422`)
+	assert.Contains(t, failed, "Name can't be blank")
+}
@@ -31,7 +31,7 @@ type ProjectTaskAssignment struct {
 
 type ProjectTaskAssignmentCreateRequest struct {
 	// required The ID of the task to associate with the project.
-	TaskID *int64 `json:"task_id"`
+	TaskID *int64 `json:"task_id" validate:"required"`
 	// optional Whether the task assignment is active or archived. Defaults to true
 	IsActive *bool `json:"is_active,omitempty"`
 	// optional Whether the task assignment is billable or not. Defaults to false.
@@ -43,6 +43,11 @@ type ProjectTaskAssignmentCreateRequest struct {
 	Budget *float64 `json:"budget,omitempty"`
 }
 
+// Validate reports required fields that are missing.
+func (r *ProjectTaskAssignmentCreateRequest) Validate() error {
+	return validate(r)
+}
+
 type ProjectTaskAssignmentList struct {
 	TaskAssignments []*ProjectTaskAssignment `json:"task_assignments"`
 
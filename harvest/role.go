@@ -40,8 +40,13 @@ type RoleListOptions struct {
 }
 
 type RoleCreateRequest struct {
-	Name    *string  `json:"name"`               // required	The name of the role.
-	UserIDs *[]int64 `json:"user_ids,omitempty"` // The IDs of the users assigned to this role.
+	Name    *string  `json:"name" validate:"required"` // required	The name of the role.
+	UserIDs *[]int64 `json:"user_ids,omitempty"`       // The IDs of the users assigned to this role.
+}
+
+// Validate reports required fields that are missing.
+func (r *RoleCreateRequest) Validate() error {
+	return validate(r)
 }
 
 type RoleUpdateRequest struct {
@@ -49,6 +54,11 @@ type RoleUpdateRequest struct {
 	UserIDs *[]int64 `json:"user_ids,omitempty"` // The IDs of the users assigned to this role.
 }
 
+// Validate checks the request before it is sent.
+func (r *RoleUpdateRequest) Validate() error {
+	return validate(r)
+}
+
 // List returns a list of roles in the account.
 func (s *RoleService) List(ctx context.Context, opt *RoleListOptions) (*RoleList, *http.Response, error) {
 	u := "roles"
@@ -0,0 +1,273 @@
+package harvest
+
+//go:generate go tool mockgen -source=services.go -destination=../harvestmock/services.go -package=harvestmock
+
+import (
+	"context"
+	"net/http"
+)
+
+// The interfaces below describe the method sets of the services exposed on
+// APIClient. Depend on them instead of the concrete services to substitute
+// the mocks from the harvestmock package in tests.
+
+// ClientAPI is the interface implemented by ClientService.
+type ClientAPI interface {
+	List(ctx context.Context, opt *ClientListOptions) (*ClientList, *http.Response, error)
+	Get(ctx context.Context, clientID int64) (*Client, *http.Response, error)
+	Create(ctx context.Context, data *ClientCreateRequest) (*Client, *http.Response, error)
+	Update(ctx context.Context, clientID int64, data *ClientUpdateRequest) (*Client, *http.Response, error)
+	Delete(ctx context.Context, clientID int64) (*http.Response, error)
+	ListContacts(ctx context.Context, opt *ClientContactListOptions) (*ClientContactList, *http.Response, error)
+	GetContact(ctx context.Context, clientContactID int64) (*ClientContact, *http.Response, error)
+	CreateClientContact(
+		ctx context.Context,
+		data *ClientContactCreateRequest,
+	) (*ClientContact, *http.Response, error)
+	UpdateClientContact(
+		ctx context.Context,
+		contactID int64,
+		data *ClientContactUpdateRequest,
+	) (*ClientContact, *http.Response, error)
+	DeleteClientContact(ctx context.Context, contactID int64) (*http.Response, error)
+}
+
+// CompanyAPI is the interface implemented by CompanyService.
+type CompanyAPI interface {
+	Get(ctx context.Context) (*Company, *http.Response, error)
+}
+
+// EstimateAPI is the interface implemented by EstimateService.
+type EstimateAPI interface {
+	List(ctx context.Context, opt *EstimateListOptions) (*EstimateList, *http.Response, error)
+	Get(ctx context.Context, estimateID int64) (*Estimate, *http.Response, error)
+	ListItemCategories(
+		ctx context.Context,
+		opt *EstimateItemCategoryListOptions,
+	) (*EstimateItemCategoryList, *http.Response, error)
+	GetItemCategory(
+		ctx context.Context,
+		estimateItemCategoryID int64,
+	) (*EstimateItemCategory, *http.Response, error)
+	CreateItemCategory(
+		ctx context.Context,
+		data *EstimateItemCategoryRequest,
+	) (*EstimateItemCategory, *http.Response, error)
+	UpdateItemCategory(
+		ctx context.Context,
+		estimateItemCategoryID int64,
+		data *EstimateItemCategoryRequest,
+	) (*EstimateItemCategory, *http.Response, error)
+	DeleteItemCategory(ctx context.Context, estimateItemCategoryID int64) (*http.Response, error)
+	ListEstimateMessages(
+		ctx context.Context,
+		estimateID int64,
+		opt *EstimateMessageListOptions,
+	) (*EstimateMessageList, *http.Response, error)
+	CreateEstimateMessage(
+		ctx context.Context,
+		estimateID int64,
+		data *EstimateMessageCreateRequest,
+	) (*EstimateMessage, *http.Response, error)
+	DeleteEstimateMessage(ctx context.Context, estimateID, estimateMessageID int64) (*http.Response, error)
+	MarkAsSent(ctx context.Context, estimateID int64) (*EstimateMessage, *http.Response, error)
+	MarkAsAccepted(ctx context.Context, estimateID int64) (*EstimateMessage, *http.Response, error)
+	MarkAsDeclined(ctx context.Context, estimateID int64) (*EstimateMessage, *http.Response, error)
+	MarkAsReopen(ctx context.Context, estimateID int64) (*EstimateMessage, *http.Response, error)
+	SendEvent(
+		ctx context.Context,
+		estimateID int64,
+		data *EstimateEventTypeRequest,
+	) (*EstimateMessage, *http.Response, error)
+}
+
+// ExpenseAPI is the interface implemented by ExpenseService.
+type ExpenseAPI interface {
+	List(ctx context.Context, opt *ExpenseListOptions) (*ExpenseList, *http.Response, error)
+	Get(ctx context.Context, expenseID int64) (*Expense, *http.Response, error)
+	Create(ctx context.Context, data *ExpenseCreateRequest) (*Expense, *http.Response, error)
+	Update(ctx context.Context, expenseID int64, data *ExpenseUpdateRequest) (*Expense, *http.Response, error)
+	Delete(ctx context.Context, expenseID int64) (*http.Response, error)
+	ListExpenseCategories(
+		ctx context.Context,
+		opt *ExpenseCategoryListOptions,
+	) (*ExpenseCategoryList, *http.Response, error)
+	GetExpenseCategory(ctx context.Context, expenseCategoryID int64) (*ExpenseCategory, *http.Response, error)
+	CreateExpenseCategory(
+		ctx context.Context,
+		data *ExpenseCategoryRequest,
+	) (*ExpenseCategory, *http.Response, error)
+	UpdateExpenseCategory(
+		ctx context.Context,
+		expenseCategoryID int64,
+		data *ExpenseCategoryRequest,
+	) (*ExpenseCategory, *http.Response, error)
+	DeleteExpenseCategory(ctx context.Context, expenseCategoryID int64) (*http.Response, error)
+}
+
+// InvoiceAPI is the interface implemented by InvoiceService.
+type InvoiceAPI interface {
+	List(ctx context.Context, opt *InvoiceListOptions) (*InvoiceList, *http.Response, error)
+	Get(ctx context.Context, invoiceID int64) (*Invoice, *http.Response, error)
+	Create(ctx context.Context, data *InvoiceCreateRequest) (*Invoice, *http.Response, error)
+	Update(ctx context.Context, invoiceID int64, data *InvoiceUpdateRequest) (*Invoice, *http.Response, error)
+	Delete(ctx context.Context, invoiceID int64) (*http.Response, error)
+	ListItemCategories(
+		ctx context.Context,
+		opt *InvoiceItemCategoryListOptions,
+	) (*InvoiceItemCategoryList, *http.Response, error)
+	GetItemCategory(
+		ctx context.Context,
+		invoiceItemCategoryID int64,
+	) (*InvoiceItemCategory, *http.Response, error)
+	CreateItemCategory(
+		ctx context.Context,
+		data *InvoiceItemCategoryRequest,
+	) (*InvoiceItemCategory, *http.Response, error)
+	UpdateItemCategory(
+		ctx context.Context,
+		invoiceItemCategoryID int64,
+		data *InvoiceItemCategoryRequest,
+	) (*InvoiceItemCategory, *http.Response, error)
+	DeleteItemCategory(ctx context.Context, invoiceItemCategoryID int64) (*http.Response, error)
+	ListInvoiceMessages(
+		ctx context.Context,
+		invoiceID int64,
+		opt *InvoiceMessageListOptions,
+	) (*InvoiceMessageList, *http.Response, error)
+	CreateInvoiceMessage(
+		ctx context.Context,
+		invoiceID int64,
+		data *InvoiceMessageCreateRequest,
+	) (*InvoiceMessage, *http.Response, error)
+	DeleteInvoiceMessage(ctx context.Context, invoiceID, invoiceMessageID int64) (*http.Response, error)
+	MarkAsSent(ctx context.Context, invoiceID int64) (*InvoiceMessage, *http.Response, error)
+	MarkAsDraft(ctx context.Context, invoiceID int64) (*InvoiceMessage, *http.Response, error)
+	MarkAsClosed(ctx context.Context, invoiceID int64) (*InvoiceMessage, *http.Response, error)
+	MarkAsReopen(ctx context.Context, invoiceID int64) (*InvoiceMessage, *http.Response, error)
+	SendEvent(ctx context.Context, invoiceID int64, data *EventTypeRequest) (*InvoiceMessage, *http.Response, error)
+	ListPayments(
+		ctx context.Context,
+		invoiceID int64,
+		opt *InvoicePaymentListOptions,
+	) (*InvoicePaymentList, *http.Response, error)
+	CreatePayment(
+		ctx context.Context,
+		invoiceID int64,
+		data *InvoicePaymentRequest,
+	) (*InvoicePayment, *http.Response, error)
+	DeletePayment(ctx context.Context, invoiceID, invoicePaymentID int64) (*http.Response, error)
+}
+
+// ProjectAPI is the interface implemented by ProjectService.
+type ProjectAPI interface {
+	List(ctx context.Context, opt *ProjectListOptions) (*ProjectList, *http.Response, error)
+	Get(ctx context.Context, projectID int64) (*Project, *http.Response, error)
+	ListTaskAssignments(
+		ctx context.Context,
+		projectID int64,
+		opt *ProjectTaskAssignmentListOptions,
+	) (*ProjectTaskAssignmentList, *http.Response, error)
+	GetTaskAssignment(
+		ctx context.Context,
+		projectID int64,
+		taskAssignmentID int64,
+	) (*ProjectTaskAssignment, *http.Response, error)
+	CreateTaskAssignment(
+		ctx context.Context,
+		projectID int64,
+		data *ProjectTaskAssignmentCreateRequest,
+	) (*ProjectTaskAssignment, *http.Response, error)
+	ListUserAssignments(
+		ctx context.Context,
+		projectID int64,
+		opt *ProjectUserAssignmentListOptions,
+	) (*ProjectUserAssignmentList, *http.Response, error)
+	GetUserAssignment(
+		ctx context.Context,
+		projectID int64,
+		userAssignmentID int64,
+	) (*ProjectUserAssignment, *http.Response, error)
+}
+
+// RoleAPI is the interface implemented by RoleService.
+type RoleAPI interface {
+	List(ctx context.Context, opt *RoleListOptions) (*RoleList, *http.Response, error)
+	Get(ctx context.Context, roleID int64) (*Role, *http.Response, error)
+	Create(ctx context.Context, data *RoleCreateRequest) (*Role, *http.Response, error)
+	Update(ctx context.Context, roleID int64, data *RoleUpdateRequest) (*Role, *http.Response, error)
+	Delete(ctx context.Context, roleID int64) (*http.Response, error)
+}
+
+// TaskAPI is the interface implemented by TaskService.
+type TaskAPI interface {
+	List(ctx context.Context, opt *TaskListOptions) (*TaskList, *http.Response, error)
+	Get(ctx context.Context, taskID int64) (*Task, *http.Response, error)
+	Create(ctx context.Context, data *TaskCreateRequest) (*Task, *http.Response, error)
+	Update(ctx context.Context, taskID int64, data *TaskUpdateRequest) (*Task, *http.Response, error)
+	Delete(ctx context.Context, taskID int64) (*http.Response, error)
+}
+
+// TimesheetAPI is the interface implemented by TimesheetService.
+type TimesheetAPI interface {
+	List(ctx context.Context, opt *TimeEntryListOptions) (*TimeEntryList, *http.Response, error)
+	Get(ctx context.Context, timeEntryID int64) (*TimeEntry, *http.Response, error)
+	CreateTimeEntryViaDuration(
+		ctx context.Context,
+		data *TimeEntryCreateViaDuration,
+	) (*TimeEntry, *http.Response, error)
+	CreateTimeEntryViaStartEndTime(
+		ctx context.Context,
+		data *TimeEntryCreateViaStartEndTime,
+	) (*TimeEntry, *http.Response, error)
+	UpdateTimeEntry(
+		ctx context.Context,
+		timeEntryID int64,
+		data *TimeEntryUpdate,
+	) (*TimeEntry, *http.Response, error)
+	DeleteTimeEntry(ctx context.Context, timeEntryID int64) (*http.Response, error)
+	RestartTimeEntry(ctx context.Context, timeEntryID int64) (*TimeEntry, *http.Response, error)
+	StopTimeEntry(ctx context.Context, timeEntryID int64) (*TimeEntry, *http.Response, error)
+	CurrentTimer(ctx context.Context, userID int64) (*TimeEntry, *http.Response, error)
+	StartTimer(ctx context.Context, projectID int64, taskID int64, notes string) (*TimeEntry, *http.Response, error)
+	SwitchTimer(ctx context.Context, timeEntryID int64) (*TimeEntry, *http.Response, error)
+	ListByExternalReference(
+		ctx context.Context,
+		opt *TimeEntryListOptions,
+		referenceID string,
+	) ([]*TimeEntry, *http.Response, error)
+	DeleteExternalReference(ctx context.Context, timeEntryID int64) (*http.Response, error)
+}
+
+// UserAPI is the interface implemented by UserService.
+type UserAPI interface {
+	List(ctx context.Context, opt *UserListOptions) (*UserList, *http.Response, error)
+	Get(ctx context.Context, userID int64) (*User, *http.Response, error)
+	Current(ctx context.Context) (*User, *http.Response, error)
+	Create(ctx context.Context, data *UserCreateRequest) (*User, *http.Response, error)
+	Update(ctx context.Context, userID int64, data *UserUpdateRequest) (*User, *http.Response, error)
+	Delete(ctx context.Context, userID int64) (*http.Response, error)
+	ListProjectAssignments(
+		ctx context.Context,
+		userID int64,
+		opt *UserProjectAssignmentListOptions,
+	) (*UserProjectAssignmentList, *http.Response, error)
+	GetMyProjectAssignments(
+		ctx context.Context,
+		opt *MyProjectAssignmentListOptions,
+	) (*UserProjectAssignmentList, *http.Response, error)
+}
+
+// Compile-time checks that the services implement their interfaces.
+var (
+	_ ClientAPI    = (*ClientService)(nil)
+	_ CompanyAPI   = (*CompanyService)(nil)
+	_ EstimateAPI  = (*EstimateService)(nil)
+	_ ExpenseAPI   = (*ExpenseService)(nil)
+	_ InvoiceAPI   = (*InvoiceService)(nil)
+	_ ProjectAPI   = (*ProjectService)(nil)
+	_ RoleAPI      = (*RoleService)(nil)
+	_ TaskAPI      = (*TaskService)(nil)
+	_ TimesheetAPI = (*TimesheetService)(nil)
+	_ UserAPI      = (*UserService)(nil)
+)
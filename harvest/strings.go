@@ -3,11 +3,8 @@ package harvest
 import (
 	"bytes"
 	"fmt"
-	"io"
 	"reflect"
 	"time"
-
-	"github.com/sirupsen/logrus"
 )
 
 var dateType = reflect.TypeOf(Date{}) //nolint: gochecknoglobals
@@ -25,13 +22,11 @@ func Stringify(message interface{}) string {
 	return buf.String()
 }
 
-// stringifyValue was heavily inspired by the goprotobuf library.
-
-func stringifyValue(w io.Writer, val reflect.Value) { //nolint:funlen,gocognit
+// stringifyValue was heavily inspired by the goprotobuf library. Writes to a
+// bytes.Buffer never fail, so their errors are not checked.
+func stringifyValue(w *bytes.Buffer, val reflect.Value) { //nolint:funlen,gocognit
 	if val.Kind() == reflect.Ptr && val.IsNil() {
-		if _, err := w.Write([]byte("<nil>")); err != nil {
-			logrus.Error(err)
-		}
+		w.WriteString("<nil>")
 
 		return
 	}
@@ -42,30 +37,22 @@ func stringifyValue(w io.Writer, val reflect.Value) { //nolint:funlen,gocognit
 	case reflect.String:
 		fmt.Fprintf(w, `"%s"`, v)
 	case reflect.Slice:
-		if _, err := w.Write([]byte{'['}); err != nil {
-			logrus.Error(err)
-		}
+		w.WriteByte('[')
 
 		for i := 0; i < v.Len(); i++ {
 			if i > 0 {
-				if _, err := w.Write([]byte{' '}); err != nil {
-					logrus.Error(err)
-				}
+				w.WriteByte(' ')
 			}
 
 			stringifyValue(w, v.Index(i))
 		}
 
-		if _, err := w.Write([]byte{']'}); err != nil {
-			logrus.Error(err)
-		}
+		w.WriteByte(']')
 
 		return
 	case reflect.Struct:
 		if v.Type().Name() != "" {
-			if _, err := w.Write([]byte(v.Type().String())); err != nil {
-				logrus.Error(err)
-			}
+			w.WriteString(v.Type().String())
 		}
 
 		// special handling of date values
@@ -80,9 +67,7 @@ func stringifyValue(w io.Writer, val reflect.Value) { //nolint:funlen,gocognit
 			return
 		}
 
-		if _, err := w.Write([]byte{'{'}); err != nil {
-			logrus.Error(err)
-		}
+		w.WriteByte('{')
 
 		var sep bool
 
@@ -97,27 +82,19 @@ func stringifyValue(w io.Writer, val reflect.Value) { //nolint:funlen,gocognit
 			}
 
 			if sep {
-				if _, err := w.Write([]byte(", ")); err != nil {
-					logrus.Error(err)
-				}
+				w.WriteString(", ")
 			} else {
 				sep = true
 			}
 
-			if _, err := w.Write([]byte(v.Type().Field(i).Name)); err != nil {
-				logrus.Error(err)
-			}
+			w.WriteString(v.Type().Field(i).Name)
 
-			if _, err := w.Write([]byte{':'}); err != nil {
-				logrus.Error(err)
-			}
+			w.WriteByte(':')
 
 			stringifyValue(w, fv)
 		}
 
-		if _, err := w.Write([]byte{'}'}); err != nil {
-			logrus.Error(err)
-		}
+		w.WriteByte('}')
 	default:
 		if v.CanInterface() {
 			fmt.Fprint(w, v.Interface())
@@ -57,7 +57,7 @@ type TaskListOptions struct {
 
 type TaskCreateRequest struct {
 	// required	The name of the task.
-	Name *string `json:"name"`
+	Name *string `json:"name" validate:"required"`
 	// optional	Used in determining whether default tasks should be marked billable when creating a new project.
 	// Defaults to true.
 	BillableByDefault *bool `json:"billable_by_default,omitempty"`
@@ -69,6 +69,11 @@ type TaskCreateRequest struct {
 	IsActive *bool `json:"is_active,omitempty"`
 }
 
+// Validate reports required fields that are missing.
+func (r *TaskCreateRequest) Validate() error {
+	return validate(r)
+}
+
 type TaskUpdateRequest struct {
 	// The name of the task.
 	Name *string `json:"name,omitempty"`
@@ -82,6 +87,11 @@ type TaskUpdateRequest struct {
 	IsActive *bool `json:"is_active,omitempty"`
 }
 
+// Validate checks the request before it is sent.
+func (r *TaskUpdateRequest) Validate() error {
+	return validate(r)
+}
+
 // List returns a list of your tasks.
 func (s *TaskService) List(ctx context.Context, opt *TaskListOptions) (*TaskList, *http.Response, error) {
 	u := "tasks"
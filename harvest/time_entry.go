@@ -38,10 +38,10 @@ type TimeEntry struct {
 	// Once the time entry has been invoiced, this field will include the associated invoice’s id and number.
 	Invoice *Invoice `json:"invoice,omitempty"`
 	// Number of (decimal time) hours tracked in this time entry.
-	Hours *float64 `json:"hours,omitempty"`
+	Hours *Hours `json:"hours,omitempty"`
 	// Number of (decimal time) hours tracked in this time entry used in summary reports and invoices.
 	// This value is rounded according to the Time Rounding setting in your Preferences.
-	RoundedHours *float64 `json:"rounded_hours,omitempty"`
+	RoundedHours *Hours `json:"rounded_hours,omitempty"`
 	// Notes attached to the time entry.
 	Notes *string `json:"notes,omitempty"`
 	// Whether or not the time entry has been locked.
@@ -124,30 +124,35 @@ type TimeEntryCreateViaDuration struct {
 	// optional	The ID of the user to associate with the time entry. Defaults to the currently authenticated user’s ID.
 	UserID *int64 `json:"user_id,omitempty"`
 	// required	The ID of the project to associate with the time entry.
-	ProjectID *int64 `json:"project_id"`
+	ProjectID *int64 `json:"project_id" validate:"required"`
 	// required	The ID of the task to associate with the time entry.
-	TaskID *int64 `json:"task_id"`
+	TaskID *int64 `json:"task_id" validate:"required"`
 	// required	The ISO 8601 formatted date the time entry was spent.
-	SpentDate *Date `json:"spent_date"`
+	SpentDate *Date `json:"spent_date" validate:"required"`
 	// optional	The current amount of time tracked.
 	// If provided, the time entry will be created with the specified hours and is_running will be set to false.
 	// If not provided, hours will be set to 0.0 and is_running will be set to true.
-	Hours *float64 `json:"hours,omitempty"`
+	Hours *Hours `json:"hours,omitempty"`
 	// optional	Any notes to be associated with the time entry.
 	Notes *string `json:"notes,omitempty"`
 	// optional	An object containing the id, group_id, and permalink of the external reference.
 	ExternalReference *ExternalReference `json:"external_reference,omitempty"`
 }
 
+// Validate reports required fields that are missing.
+func (r *TimeEntryCreateViaDuration) Validate() error {
+	return validate(r)
+}
+
 type TimeEntryCreateViaStartEndTime struct {
 	// optional	The ID of the user to associate with the time entry. Defaults to the currently authenticated user’s ID.
 	UserID *int64 `json:"user_id,omitempty"`
 	// required	The ID of the project to associate with the time entry.
-	ProjectID *int64 `json:"project_id"`
+	ProjectID *int64 `json:"project_id" validate:"required"`
 	// required	The ID of the task to associate with the time entry.
-	TaskID *int64 `json:"task_id"`
+	TaskID *int64 `json:"task_id" validate:"required"`
 	// required	The ISO 8601 formatted date the time entry was spent.
-	SpentDate *Date `json:"spent_date"`
+	SpentDate *Date `json:"spent_date" validate:"required"`
 	// optional	The time the entry started. Defaults to the current time. Example: “8:00am”.
 	StartedTime *Time `json:"started_time,omitempty"`
 	// optional	The time the entry ended. If provided, is_running will be set to false.
@@ -159,13 +164,18 @@ type TimeEntryCreateViaStartEndTime struct {
 	ExternalReference *ExternalReference `json:"external_reference,omitempty"`
 }
 
+// Validate reports required fields that are missing.
+func (r *TimeEntryCreateViaStartEndTime) Validate() error {
+	return validate(r)
+}
+
 type TimeEntryUpdate struct {
-	// required	The ID of the project to associate with the time entry.
-	ProjectID *int64 `json:"project_id"`
-	// required	The ID of the task to associate with the time entry.
-	TaskID *int64 `json:"task_id"`
-	// required	The ISO 8601 formatted date the time entry was spent.
-	SpentDate *Date `json:"spent_date"`
+	// optional	The ID of the project to associate with the time entry.
+	ProjectID *int64 `json:"project_id,omitempty"`
+	// optional	The ID of the task to associate with the time entry.
+	TaskID *int64 `json:"task_id,omitempty"`
+	// optional	The ISO 8601 formatted date the time entry was spent.
+	SpentDate *Date `json:"spent_date,omitempty"`
 	// optional	The time the entry started. Defaults to the current time. Example: “8:00am”.
 	StartedTime *Time `json:"started_time,omitempty"`
 	// optional	The time the entry ended. If provided, is_running will be set to false.
@@ -174,13 +184,18 @@ type TimeEntryUpdate struct {
 	// optional	The current amount of time tracked.
 	// If provided, the time entry will be created with the specified hours and is_running will be set to false.
 	// If not provided, hours will be set to 0.0 and is_running will be set to true.
-	Hours *float64 `json:"hours,omitempty"`
+	Hours *Hours `json:"hours,omitempty"`
 	// optional	Any notes to be associated with the time entry.
 	Notes *string `json:"notes,omitempty"`
 	// optional	An object containing the id, group_id, and permalink of the external reference.
 	ExternalReference *ExternalReference `json:"external_reference,omitempty"`
 }
 
+// Validate checks the request before it is sent.
+func (r *TimeEntryUpdate) Validate() error {
+	return validate(r)
+}
+
 // List returns a list of time entries.
 func (s *TimesheetService) List(
 	ctx context.Context,
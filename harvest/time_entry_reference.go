@@ -0,0 +1,178 @@
+package harvest
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ErrIssueURL is returned by IssueReference for a URL that isn't a Jira,
+// GitHub, GitLab or Linear issue.
+var ErrIssueURL = errors.New("not an issue URL")
+
+// issuePaths match the paths of issue URLs, by tracker. GitHub and GitLab
+// pull and merge requests count as issues.
+var issuePaths = []struct { //nolint: gochecknoglobals
+	service string
+	path    *regexp.Regexp
+}{
+	{"jira", regexp.MustCompile(`^/browse/(([A-Z][A-Z0-9_]*)-\d+)/?$`)},
+	{"github", regexp.MustCompile(`^/([^/]+/[^/]+)/(?:issues|pull)/(\d+)/?$`)},
+	{"gitlab", regexp.MustCompile(`^/((?:[^/]+/)+?[^/]+)/-/(?:issues|merge_requests)/(\d+)/?$`)},
+	{"linear", regexp.MustCompile(`^/[^/]+/issue/(([A-Z][A-Z0-9]*)-\d+)(?:/[^/]*)?/?$`)},
+}
+
+// IssueReference returns the external reference of the issue at rawURL, such
+// as https://acme.atlassian.net/browse/WEB-12,
+// https://github.com/becoded/go-harvest/issues/42,
+// https://gitlab.com/acme/web/-/issues/7 or
+// https://linear.app/acme/issue/ENG-3/fix-login.
+//
+// ID identifies the issue across projects, like "WEB-12" or
+// "becoded/go-harvest#42", GroupID is its project or repository, and Service
+// is the host. Trackers are recognised by the path alone, so self-hosted
+// instances work as well.
+func IssueReference(rawURL string) (*ExternalReference, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("%w: %q", ErrIssueURL, rawURL)
+	}
+
+	for _, p := range issuePaths {
+		m := p.path.FindStringSubmatch(u.Path)
+		if m == nil {
+			continue
+		}
+
+		ref := &ExternalReference{
+			Permalink: String(u.Scheme + "://" + u.Host + strings.TrimSuffix(u.Path, "/")),
+			Service:   String(u.Host),
+		}
+
+		switch p.service {
+		case "github", "gitlab":
+			ref.ID = String(m[1] + "#" + m[2])
+			ref.GroupID = String(m[1])
+		default:
+			ref.ID = String(m[1])
+			ref.GroupID = String(m[2])
+		}
+
+		return ref, nil
+	}
+
+	return nil, fmt.Errorf("%w: %q", ErrIssueURL, rawURL)
+}
+
+// ListByExternalReference returns the time entries matching opt whose
+// external reference has the given ID, reading every page. The API can't
+// filter by external reference, so narrow opt down, for instance by date or
+// project, to keep the pages few. opt may be nil.
+func (s *TimesheetService) ListByExternalReference(
+	ctx context.Context,
+	opt *TimeEntryListOptions,
+	referenceID string,
+) ([]*TimeEntry, *http.Response, error) {
+	o := TimeEntryListOptions{}
+	if opt != nil {
+		o = *opt
+	}
+
+	if o.Page == 0 {
+		o.Page = 1
+	}
+
+	var entries []*TimeEntry
+
+	for {
+		list, resp, err := s.List(ctx, &o)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		entries = append(entries, FilterByExternalReference(list.TimeEntries, referenceID)...)
+
+		if list.NextPage == nil {
+			return entries, resp, nil
+		}
+
+		o.Page = *list.NextPage
+	}
+}
+
+// FilterByExternalReference returns the entries whose external reference has
+// the given ID.
+func FilterByExternalReference(entries []*TimeEntry, referenceID string) []*TimeEntry {
+	var filtered []*TimeEntry
+
+	for _, e := range entries {
+		if e.GetExternalReference().GetID() == referenceID {
+			filtered = append(filtered, e)
+		}
+	}
+
+	return filtered
+}
+
+// ReferenceHours are the hours tracked against an external reference.
+type ReferenceHours struct {
+	// Reference is the reference of the first entry found for it.
+	Reference *ExternalReference
+	Hours     Hours
+	Entries   int
+}
+
+// HoursByExternalReference sums the hours of the entries per external
+// reference, told apart by service, group and ID, most hours first. Entries
+// without a reference are left out.
+func HoursByExternalReference(entries []*TimeEntry) []ReferenceHours {
+	type key struct{ service, group, id string }
+
+	index := map[key]int{}
+
+	var totals []ReferenceHours
+
+	for _, e := range entries {
+		ref := e.GetExternalReference()
+		if ref.GetID() == "" {
+			continue
+		}
+
+		k := key{ref.GetService(), ref.GetGroupID(), ref.GetID()}
+
+		i, ok := index[k]
+		if !ok {
+			i = len(totals)
+			index[k] = i
+			totals = append(totals, ReferenceHours{Reference: ref})
+		}
+
+		totals[i].Hours += e.GetHours()
+		totals[i].Entries++
+	}
+
+	slices.SortStableFunc(totals, func(a, b ReferenceHours) int {
+		return cmp.Compare(b.Hours, a.Hours)
+	})
+
+	return totals
+}
+
+// DeleteExternalReference removes the external reference of a time entry,
+// unlinking it from its issue.
+func (s *TimesheetService) DeleteExternalReference(ctx context.Context, timeEntryID int64) (*http.Response, error) {
+	u := fmt.Sprintf("%s/%d/external_reference", basePathTimeEntries, timeEntryID)
+
+	req, err := s.client.NewRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,162 @@
+package harvest_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/becoded/go-harvest/harvest"
+)
+
+func TestIssueReference(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		url     string
+		want    *harvest.ExternalReference
+		wantErr error
+	}{
+		{
+			name: "jira",
+			url:  "https://acme.atlassian.net/browse/WEB-12",
+			want: &harvest.ExternalReference{
+				ID:        harvest.String("WEB-12"),
+				GroupID:   harvest.String("WEB"),
+				Permalink: harvest.String("https://acme.atlassian.net/browse/WEB-12"),
+				Service:   harvest.String("acme.atlassian.net"),
+			},
+		},
+		{
+			name: "github pull request",
+			url:  "https://github.com/becoded/go-harvest/pull/42/",
+			want: &harvest.ExternalReference{
+				ID:        harvest.String("becoded/go-harvest#42"),
+				GroupID:   harvest.String("becoded/go-harvest"),
+				Permalink: harvest.String("https://github.com/becoded/go-harvest/pull/42"),
+				Service:   harvest.String("github.com"),
+			},
+		},
+		{
+			name: "gitlab subgroup",
+			url:  "https://gitlab.example.com/acme/web/shop/-/issues/7",
+			want: &harvest.ExternalReference{
+				ID:        harvest.String("acme/web/shop#7"),
+				GroupID:   harvest.String("acme/web/shop"),
+				Permalink: harvest.String("https://gitlab.example.com/acme/web/shop/-/issues/7"),
+				Service:   harvest.String("gitlab.example.com"),
+			},
+		},
+		{
+			name: "linear",
+			url:  "https://linear.app/acme/issue/ENG-3/fix-login",
+			want: &harvest.ExternalReference{
+				ID:        harvest.String("ENG-3"),
+				GroupID:   harvest.String("ENG"),
+				Permalink: harvest.String("https://linear.app/acme/issue/ENG-3/fix-login"),
+				Service:   harvest.String("linear.app"),
+			},
+		},
+		{
+			name:    "not an issue",
+			url:     "https://github.com/becoded/go-harvest",
+			wantErr: harvest.ErrIssueURL,
+		},
+		{
+			name:    "no host",
+			url:     "WEB-12",
+			wantErr: harvest.ErrIssueURL,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := harvest.IssueReference(tt.url)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func referenced(id int64, hours harvest.Hours, refID string) *harvest.TimeEntry {
+	e := &harvest.TimeEntry{ID: harvest.Int64(id), Hours: harvest.HoursP(hours)}
+	if refID != "" {
+		e.ExternalReference = &harvest.ExternalReference{ID: harvest.String(refID)}
+	}
+
+	return e
+}
+
+func TestHoursByExternalReference(t *testing.T) {
+	t.Parallel()
+
+	entries := []*harvest.TimeEntry{
+		referenced(1, 1, "WEB-12"),
+		referenced(2, 3, "WEB-13"),
+		referenced(3, 0.5, "WEB-12"),
+		referenced(4, 8, ""),
+		referenced(5, 1, "WEB-12"),
+	}
+
+	assert.Equal(t, []harvest.ReferenceHours{
+		{Reference: entries[1].ExternalReference, Hours: 3, Entries: 1},
+		{Reference: entries[0].ExternalReference, Hours: 2.5, Entries: 3},
+	}, harvest.HoursByExternalReference(entries))
+	assert.Equal(t, []*harvest.TimeEntry{entries[0], entries[2], entries[4]},
+		harvest.FilterByExternalReference(entries, "WEB-12"))
+}
+
+func TestTimesheetService_ListByExternalReference(t *testing.T) {
+	t.Parallel()
+
+	service, mux, teardown := setup(t)
+	t.Cleanup(teardown)
+
+	mux.HandleFunc("/time_entries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		assert.Equal(t, "42", r.URL.Query().Get("project_id"))
+
+		if r.URL.Query().Get("page") == "1" {
+			fmt.Fprint(w, `{"time_entries":[{"id":1,"external_reference":{"id":"WEB-12"}},{"id":2}],"next_page":2}`)
+
+			return
+		}
+
+		fmt.Fprint(w, `{"time_entries":[{"id":3,"external_reference":{"id":"WEB-13"}},`+
+			`{"id":4,"external_reference":{"id":"WEB-12"}}],"next_page":null}`)
+	})
+
+	got, _, err := service.Timesheet.ListByExternalReference(context.Background(),
+		&harvest.TimeEntryListOptions{ProjectID: harvest.Int64(42)}, "WEB-12")
+	assert.NoError(t, err)
+
+	var ids []int64
+	for _, e := range got {
+		ids = append(ids, e.GetID())
+	}
+
+	assert.Equal(t, []int64{1, 4}, ids)
+}
+
+func TestTimesheetService_DeleteExternalReference(t *testing.T) {
+	t.Parallel()
+
+	service, mux, teardown := setup(t)
+	t.Cleanup(teardown)
+
+	mux.HandleFunc("/time_entries/636709355/external_reference", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	_, err := service.Timesheet.DeleteExternalReference(context.Background(), 636709355)
+	assert.NoError(t, err)
+
+	_, err = service.Timesheet.DeleteExternalReference(context.Background(), 999)
+	assert.Error(t, err)
+}
@@ -28,7 +28,7 @@ func TestTimesheetService_CreateTimeEntryViaDuration(t *testing.T) {
 				ProjectID: harvest.Int64(2),
 				TaskID:    harvest.Int64(3),
 				SpentDate: harvest.DateP(harvest.Date{Time: time.Date(2018, 3, 30, 0, 0, 0, 0, time.UTC)}),
-				Hours:     harvest.Float64(1.2),
+				Hours:     harvest.HoursP(1.2),
 				Notes:     harvest.String("Writing tests"),
 			},
 			setupMock: func(mux *http.ServeMux) {
@@ -262,8 +262,8 @@ func TestTimesheetService_CreateTimeEntryViaStartEndTime(t *testing.T) {
 			want: &harvest.TimeEntry{
 				ID:           harvest.Int64(636708723),
 				SpentDate:    &spentDate,
-				Hours:        harvest.Float64(2.0),
-				RoundedHours: harvest.Float64(2.0),
+				Hours:        harvest.HoursP(2.0),
+				RoundedHours: harvest.HoursP(2.0),
 				Notes:        harvest.String("Importing products"),
 				IsLocked:     harvest.Bool(false),
 				LockedReason: nil,
@@ -358,7 +358,7 @@ func TestTimesheetService_UpdateTimeEntry(t *testing.T) {
 				ProjectID: harvest.Int64(14307913),
 				TaskID:    harvest.Int64(8083365),
 				SpentDate: &spentDate,
-				Hours:     harvest.Float64(1.0),
+				Hours:     harvest.HoursP(1.0),
 				Notes:     harvest.String("Updated notes"),
 			},
 			setupMock: func(mux *http.ServeMux) {
@@ -370,8 +370,8 @@ func TestTimesheetService_UpdateTimeEntry(t *testing.T) {
 			want: &harvest.TimeEntry{
 				ID:           harvest.Int64(636718192),
 				SpentDate:    &spentDate,
-				Hours:        harvest.Float64(1.0),
-				RoundedHours: harvest.Float64(1.0),
+				Hours:        harvest.HoursP(1.0),
+				RoundedHours: harvest.HoursP(1.0),
 				Notes:        harvest.String("Updated notes"),
 				IsLocked:     harvest.Bool(false),
 				LockedReason: nil,
@@ -403,6 +403,23 @@ func TestTimesheetService_UpdateTimeEntry(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:        "Notes Only Update",
+			timeEntryID: 636718192,
+			input:       &harvest.TimeEntryUpdate{Notes: harvest.String("Updated notes")},
+			setupMock: func(mux *http.ServeMux) {
+				mux.HandleFunc("/time_entries/636718192", func(w http.ResponseWriter, r *http.Request) {
+					testMethod(t, r, "PATCH")
+					testBody(t, r, "time_entry/update/body_3.json")
+					testWriteResponse(t, w, "time_entry/update/response_1.json")
+				})
+			},
+			want: &harvest.TimeEntry{
+				ID:    harvest.Int64(636718192),
+				Notes: harvest.String("Updated notes"),
+			},
+			wantErr: false,
+		},
 		{
 			name:        "Error Updating Time Entry",
 			timeEntryID: 999,
@@ -541,8 +558,8 @@ func TestTimesheetService_RestartTimeEntry(t *testing.T) {
 			want: &harvest.TimeEntry{
 				ID:             harvest.Int64(662202797),
 				SpentDate:      &spentDate,
-				Hours:          harvest.Float64(0.0),
-				RoundedHours:   harvest.Float64(0.0),
+				Hours:          harvest.HoursP(0.0),
+				RoundedHours:   harvest.HoursP(0.0),
 				Notes:          nil,
 				IsLocked:       harvest.Bool(false),
 				LockedReason:   nil,
@@ -651,8 +668,8 @@ func TestTimesheetService_StopTimeEntry(t *testing.T) {
 			want: &harvest.TimeEntry{
 				ID:             harvest.Int64(662202797),
 				SpentDate:      &spentDate,
-				Hours:          harvest.Float64(0.02),
-				RoundedHours:   harvest.Float64(0.25),
+				Hours:          harvest.HoursP(0.02),
+				RoundedHours:   harvest.HoursP(0.25),
 				Notes:          nil,
 				IsLocked:       harvest.Bool(false),
 				LockedReason:   nil,
@@ -739,8 +756,8 @@ func TestTimeEntry_String(t *testing.T) {
 			in: harvest.TimeEntry{
 				ID:           harvest.Int64(636709355),
 				SpentDate:    &spentDate,
-				Hours:        harvest.Float64(2.11),
-				RoundedHours: harvest.Float64(2.25),
+				Hours:        harvest.HoursP(2.11),
+				RoundedHours: harvest.HoursP(2.25),
 				Notes:        harvest.String("Adding CSS styling"),
 				IsLocked:     harvest.Bool(false),
 				IsClosed:     harvest.Bool(false),
@@ -776,7 +793,7 @@ func TestTimeEntry_String(t *testing.T) {
 			in: harvest.TimeEntry{
 				ID:        harvest.Int64(636709355),
 				SpentDate: &spentDate,
-				Hours:     harvest.Float64(2.11),
+				Hours:     harvest.HoursP(2.11),
 			},
 			want: `harvest.TimeEntry{ID:636709355, SpentDate:harvest.Date{{2017-03-21 00:00:00 +0000 UTC}}, Hours:2.11}`,
 		},
@@ -801,7 +818,7 @@ func TestTimeEntry_String(t *testing.T) {
 			in: harvest.TimeEntry{
 				ID:             harvest.Int64(636709355),
 				SpentDate:      &spentDate,
-				Hours:          harvest.Float64(0.0),
+				Hours:          harvest.HoursP(0.0),
 				IsRunning:      harvest.Bool(true),
 				TimerStartedAt: &createdAt,
 			},
@@ -843,8 +860,8 @@ func TestTimeEntryList_String(t *testing.T) {
 					{
 						ID:           harvest.Int64(636709355),
 						SpentDate:    &spentDate,
-						Hours:        harvest.Float64(2.11),
-						RoundedHours: harvest.Float64(2.25),
+						Hours:        harvest.HoursP(2.11),
+						RoundedHours: harvest.HoursP(2.25),
 						Notes:        harvest.String("Adding CSS styling"),
 						IsLocked:     harvest.Bool(false),
 						IsClosed:     harvest.Bool(false),
@@ -890,7 +907,7 @@ func TestTimeEntryList_String(t *testing.T) {
 					{
 						ID:        harvest.Int64(636709355),
 						SpentDate: &spentDate,
-						Hours:     harvest.Float64(2.11),
+						Hours:     harvest.HoursP(2.11),
 					},
 				},
 				Pagination: harvest.Pagination{
@@ -906,12 +923,12 @@ func TestTimeEntryList_String(t *testing.T) {
 					{
 						ID:        harvest.Int64(636709355),
 						SpentDate: &spentDate,
-						Hours:     harvest.Float64(2.11),
+						Hours:     harvest.HoursP(2.11),
 					},
 					{
 						ID:        harvest.Int64(636709356),
 						SpentDate: &spentDate,
-						Hours:     harvest.Float64(3.5),
+						Hours:     harvest.HoursP(3.5),
 					},
 				},
 				Pagination: harvest.Pagination{
@@ -0,0 +1,118 @@
+package harvest
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// CurrentTimer returns the running time entry of the user with the given ID,
+// or nil if the user has no running timer.
+func (s *TimesheetService) CurrentTimer(ctx context.Context, userID int64) (*TimeEntry, *http.Response, error) {
+	list, resp, err := s.List(ctx, &TimeEntryListOptions{
+		UserID:      Int64(userID),
+		IsRunning:   Bool(true),
+		ListOptions: ListOptions{PerPage: 1},
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if len(list.TimeEntries) == 0 {
+		return nil, resp, nil
+	}
+
+	return list.TimeEntries[0], resp, nil
+}
+
+// StartTimer starts a timer for the currently authenticated user on the given
+// project and task, dated today. A timer that is already running is stopped
+// first. If the new timer can't be created, the stopped one is restarted.
+func (s *TimesheetService) StartTimer(
+	ctx context.Context,
+	projectID int64,
+	taskID int64,
+	notes string,
+) (*TimeEntry, *http.Response, error) {
+	running, resp, err := s.myTimer(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if running != nil {
+		if _, resp, err := s.StopTimeEntry(ctx, running.GetID()); err != nil {
+			return nil, resp, err
+		}
+	}
+
+	data := &TimeEntryCreateViaDuration{
+		ProjectID: Int64(projectID),
+		TaskID:    Int64(taskID),
+		SpentDate: DateP(Date{Time: time.Now()}),
+	}
+	if notes != "" {
+		data.Notes = String(notes)
+	}
+
+	timeEntry, resp, err := s.CreateTimeEntryViaDuration(ctx, data)
+	if err != nil {
+		s.restore(ctx, running)
+
+		return nil, resp, err
+	}
+
+	return timeEntry, resp, nil
+}
+
+// SwitchTimer stops the running timer of the currently authenticated user and
+// restarts the time entry with the given ID. If that entry is the one
+// running, it is left as is. If it can't be restarted, the stopped timer is
+// restarted.
+func (s *TimesheetService) SwitchTimer(ctx context.Context, timeEntryID int64) (*TimeEntry, *http.Response, error) {
+	running, resp, err := s.myTimer(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if running != nil && running.GetID() == timeEntryID {
+		return running, resp, nil
+	}
+
+	if running != nil {
+		if _, resp, err := s.StopTimeEntry(ctx, running.GetID()); err != nil {
+			return nil, resp, err
+		}
+	}
+
+	timeEntry, resp, err := s.RestartTimeEntry(ctx, timeEntryID)
+	if err != nil {
+		s.restore(ctx, running)
+
+		return nil, resp, err
+	}
+
+	return timeEntry, resp, nil
+}
+
+// myTimer returns the running timer of the currently authenticated user, or
+// nil.
+func (s *TimesheetService) myTimer(ctx context.Context) (*TimeEntry, *http.Response, error) {
+	user, resp, err := s.client.User.Current(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return s.CurrentTimer(ctx, user.GetID())
+}
+
+// restore restarts a timer that was stopped by a switch that failed halfway.
+// It is best effort: the error of the switch is what the caller needs.
+func (s *TimesheetService) restore(ctx context.Context, stopped *TimeEntry) {
+	if stopped == nil {
+		return
+	}
+
+	if _, _, err := s.RestartTimeEntry(ctx, stopped.GetID()); err != nil {
+		s.client.logger().WarnContext(ctx, "harvest: restarting stopped timer", "time_entry_id", stopped.GetID(), "error", err)
+	}
+}
@@ -0,0 +1,211 @@
+package harvest_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/becoded/go-harvest/harvest"
+)
+
+// setupTimer serves the endpoints the timer methods use for user 42, whose
+// time entry 5 is running if running is set. It returns the calls made.
+func setupTimer(t *testing.T, running bool, failCreate bool) (*harvest.APIClient, *[]string) {
+	t.Helper()
+
+	client, mux, teardown := setup(t)
+	t.Cleanup(teardown)
+
+	calls := &[]string{}
+
+	record := func(r *http.Request) {
+		call := r.Method + " " + r.URL.Path
+		if r.URL.RawQuery != "" {
+			call += "?" + r.URL.RawQuery
+		}
+
+		*calls = append(*calls, call)
+	}
+
+	mux.HandleFunc("GET /users/me", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		fmt.Fprint(w, `{"id":42}`)
+	})
+	mux.HandleFunc("GET /time_entries", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+
+		if running {
+			fmt.Fprint(w, `{"time_entries":[{"id":5,"is_running":true}]}`)
+		} else {
+			fmt.Fprint(w, `{"time_entries":[]}`)
+		}
+	})
+	mux.HandleFunc("POST /time_entries", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+
+		if failCreate {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"message":"Task isn't assigned to the project"}`)
+
+			return
+		}
+
+		var data harvest.TimeEntryCreateViaDuration
+
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&data))
+		assert.Equal(t, int64(1), data.GetProjectID())
+		assert.Equal(t, int64(2), data.GetTaskID())
+		assert.Equal(t, "Homepage", data.GetNotes())
+		assert.Equal(t, time.Now().Format("2006-01-02"), data.GetSpentDate().Format("2006-01-02"))
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":9,"is_running":true}`)
+	})
+	mux.HandleFunc("PATCH /time_entries/{id}/{action}", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		fmt.Fprintf(w, `{"id":%s,"is_running":%t}`, r.PathValue("id"), r.PathValue("action") == "restart")
+	})
+
+	return client, calls
+}
+
+func TestTimesheetService_CurrentTimer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		running bool
+		want    *harvest.TimeEntry
+	}{
+		{
+			name:    "running",
+			running: true,
+			want:    &harvest.TimeEntry{ID: harvest.Int64(5), IsRunning: harvest.Bool(true)},
+		},
+		{
+			name: "no timer",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, calls := setupTimer(t, tt.running, false)
+
+			got, _, err := client.Timesheet.CurrentTimer(context.Background(), 42)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, []string{"GET /time_entries?is_running=true&per_page=1&user_id=42"}, *calls)
+		})
+	}
+}
+
+func TestTimesheetService_StartTimer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		running    bool
+		failCreate bool
+		wantCalls  []string
+	}{
+		{
+			name:    "stops the running timer first",
+			running: true,
+			wantCalls: []string{
+				"GET /users/me",
+				"GET /time_entries?is_running=true&per_page=1&user_id=42",
+				"PATCH /time_entries/5/stop",
+				"POST /time_entries",
+			},
+		},
+		{
+			name: "nothing running",
+			wantCalls: []string{
+				"GET /users/me",
+				"GET /time_entries?is_running=true&per_page=1&user_id=42",
+				"POST /time_entries",
+			},
+		},
+		{
+			name:       "restarts the stopped timer on failure",
+			running:    true,
+			failCreate: true,
+			wantCalls: []string{
+				"GET /users/me",
+				"GET /time_entries?is_running=true&per_page=1&user_id=42",
+				"PATCH /time_entries/5/stop",
+				"POST /time_entries",
+				"PATCH /time_entries/5/restart",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, calls := setupTimer(t, tt.running, tt.failCreate)
+
+			got, _, err := client.Timesheet.StartTimer(context.Background(), 1, 2, "Homepage")
+			if tt.failCreate {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(9), got.GetID())
+			}
+
+			assert.Equal(t, tt.wantCalls, *calls)
+		})
+	}
+}
+
+func TestTimesheetService_SwitchTimer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		timeEntryID int64
+		wantCalls   []string
+	}{
+		{
+			name:        "switches to another entry",
+			timeEntryID: 7,
+			wantCalls: []string{
+				"GET /users/me",
+				"GET /time_entries?is_running=true&per_page=1&user_id=42",
+				"PATCH /time_entries/5/stop",
+				"PATCH /time_entries/7/restart",
+			},
+		},
+		{
+			name:        "already running",
+			timeEntryID: 5,
+			wantCalls: []string{
+				"GET /users/me",
+				"GET /time_entries?is_running=true&per_page=1&user_id=42",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, calls := setupTimer(t, true, false)
+
+			got, _, err := client.Timesheet.SwitchTimer(context.Background(), tt.timeEntryID)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.timeEntryID, got.GetID())
+			assert.True(t, got.GetIsRunning())
+			assert.Equal(t, tt.wantCalls, *calls)
+		})
+	}
+}
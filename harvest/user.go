@@ -77,11 +77,11 @@ func (c UserList) String() string {
 
 type UserCreateRequest struct {
 	// required	The first name of the user.
-	FirstName *string `json:"first_name"`
+	FirstName *string `json:"first_name" validate:"required"`
 	// required	The last name of the user.
-	LastName *string `json:"last_name"`
+	LastName *string `json:"last_name" validate:"required"`
 	// required	The email address of the user.
-	Email *string `json:"email"`
+	Email *string `json:"email" validate:"required"`
 	// optional	The telephone number for the user.
 	Telephone *string `json:"telephone,omitempty"`
 	// optional	The user's timezone. Defaults to the company's timezone. See a list of supported time zones.
@@ -114,6 +114,11 @@ type UserCreateRequest struct {
 	Roles []*string `json:"roles,omitempty"`
 }
 
+// Validate reports required fields that are missing.
+func (r *UserCreateRequest) Validate() error {
+	return validate(r)
+}
+
 type UserUpdateRequest struct {
 	// The first name of the user. Can't be updated if the user is inactive.
 	FirstName *string `json:"first_name,omitempty"`
@@ -151,6 +156,11 @@ type UserUpdateRequest struct {
 	Roles []*string `json:"roles,omitempty"`
 }
 
+// Validate checks the request before it is sent.
+func (r *UserUpdateRequest) Validate() error {
+	return validate(r)
+}
+
 type UserListOptions struct {
 	// Pass true to only return active projects and false to return inactive projects.
 	IsActive bool `url:"is_active,omitempty"`
@@ -0,0 +1,179 @@
+package harvest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Validation error codes, as used in the Code field of Error.
+const (
+	CodeMissingField = "missing_field"
+	CodeInvalid      = "invalid"
+)
+
+// ErrValidation is matched by every *ValidationError.
+var ErrValidation = errors.New("request validation failed")
+
+// A ValidationError reports the fields of a request body that failed
+// client-side validation. The Errors have the same shape as those of an API
+// ErrorResponse, so both can be handled alike.
+type ValidationError struct {
+	Errors []Error
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i := range e.Errors {
+		msgs[i] = e.Errors[i].Field + " " + e.Errors[i].Message
+	}
+
+	return fmt.Sprintf("%v: %v", ErrValidation, strings.Join(msgs, ", "))
+}
+
+func (e *ValidationError) Unwrap() error {
+	return ErrValidation
+}
+
+// validator is implemented by request bodies that check themselves before
+// NewRequest encodes them.
+type validator interface {
+	Validate() error
+}
+
+// validate checks the struct v points to against the rules in its validate
+// tags, and the rules of the nested request bodies it contains:
+//
+//	required       the field must be set and, for strings and slices, not empty
+//	oneof=a b c    a set field must hold one of the listed values
+//
+// A nil v is checked as if it pointed to the zero value.
+func validate(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			rv = reflect.Zero(rv.Type().Elem())
+		} else {
+			rv = rv.Elem()
+		}
+	}
+
+	var errs []Error
+
+	validateStruct(rv, "", &errs)
+
+	if len(errs) > 0 {
+		return &ValidationError{Errors: errs}
+	}
+
+	return nil
+}
+
+var validatorType = reflect.TypeFor[validator]() //nolint: gochecknoglobals
+
+func validateStruct(rv reflect.Value, prefix string, errs *[]Error) {
+	rt := rv.Type()
+
+	for i := range rt.NumField() {
+		f := rt.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		name := prefix + jsonName(f)
+		fv := rv.Field(i)
+
+		for _, rule := range strings.Split(f.Tag.Get("validate"), ",") {
+			if e, ok := checkRule(rule, fv); !ok {
+				*errs = append(*errs, Error{Resource: rt.Name(), Field: name, Code: e.Code, Message: e.Message})
+			}
+		}
+
+		validateNested(fv, name, errs)
+	}
+}
+
+// validateNested descends into struct, pointer and slice fields holding
+// request bodies that implement validator.
+func validateNested(fv reflect.Value, name string, errs *[]Error) {
+	for fv.Kind() == reflect.Ptr {
+		if fv.IsNil() {
+			return
+		}
+
+		fv = fv.Elem()
+	}
+
+	switch fv.Kind() { //nolint: exhaustive
+	case reflect.Struct:
+		if reflect.PointerTo(fv.Type()).Implements(validatorType) {
+			validateStruct(fv, name+".", errs)
+		}
+	case reflect.Slice:
+		for i := range fv.Len() {
+			validateNested(fv.Index(i), fmt.Sprintf("%s[%d]", name, i), errs)
+		}
+	}
+}
+
+// checkRule applies a single validate rule to fv. It reports the code and
+// message of the failure, if any.
+func checkRule(rule string, fv reflect.Value) (Error, bool) {
+	switch {
+	case rule == "required":
+		if isBlank(fv) {
+			return Error{Code: CodeMissingField, Message: "can't be blank"}, false
+		}
+	case strings.HasPrefix(rule, "oneof="):
+		for fv.Kind() == reflect.Ptr {
+			if fv.IsNil() {
+				return Error{}, true
+			}
+
+			fv = fv.Elem()
+		}
+
+		if fv.Kind() != reflect.String || fv.String() == "" {
+			return Error{}, true
+		}
+
+		options := strings.Fields(strings.TrimPrefix(rule, "oneof="))
+		for _, o := range options {
+			if fv.String() == o {
+				return Error{}, true
+			}
+		}
+
+		return Error{Code: CodeInvalid, Message: "must be one of: " + strings.Join(options, ", ")}, false
+	}
+
+	return Error{}, true
+}
+
+func isBlank(fv reflect.Value) bool {
+	for fv.Kind() == reflect.Ptr {
+		if fv.IsNil() {
+			return true
+		}
+
+		fv = fv.Elem()
+	}
+
+	switch fv.Kind() { //nolint: exhaustive
+	case reflect.String, reflect.Slice, reflect.Map:
+		return fv.Len() == 0
+	default:
+		return false
+	}
+}
+
+// jsonName returns the name f is encoded under.
+func jsonName(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	if name == "" {
+		return f.Name
+	}
+
+	return name
+}
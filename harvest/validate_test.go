@@ -0,0 +1,148 @@
+package harvest_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/becoded/go-harvest/harvest"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		request interface{ Validate() error }
+		want    []harvest.Error
+	}{
+		{
+			name: "complete time entry",
+			request: &harvest.TimeEntryCreateViaDuration{
+				ProjectID: harvest.Int64(1),
+				TaskID:    harvest.Int64(2),
+				SpentDate: harvest.DateP(harvest.Date{}),
+			},
+		},
+		{
+			name:    "time entry without project and date",
+			request: &harvest.TimeEntryCreateViaDuration{TaskID: harvest.Int64(2)},
+			want: []harvest.Error{
+				{
+					Resource: "TimeEntryCreateViaDuration",
+					Field:    "project_id",
+					Code:     harvest.CodeMissingField,
+					Message:  "can't be blank",
+				},
+				{
+					Resource: "TimeEntryCreateViaDuration",
+					Field:    "spent_date",
+					Code:     harvest.CodeMissingField,
+					Message:  "can't be blank",
+				},
+			},
+		},
+		{
+			name:    "nil request",
+			request: (*harvest.ClientCreateRequest)(nil),
+			want: []harvest.Error{
+				{Resource: "ClientCreateRequest", Field: "name", Code: harvest.CodeMissingField, Message: "can't be blank"},
+			},
+		},
+		{
+			name:    "empty string",
+			request: &harvest.TaskCreateRequest{Name: harvest.String("")},
+			want: []harvest.Error{
+				{Resource: "TaskCreateRequest", Field: "name", Code: harvest.CodeMissingField, Message: "can't be blank"},
+			},
+		},
+		{
+			name: "nested line items",
+			request: &harvest.InvoiceCreateRequest{
+				ClientID: harvest.Int64(1),
+				LineItems: &[]harvest.InvoiceLineItemRequest{
+					{Kind: harvest.String("Service"), UnitPrice: harvest.Float64(10)},
+					{Kind: harvest.String("Service")},
+				},
+				LineItemsImport: &harvest.InvoiceLineItemImportRequest{
+					ProjectIDs: &[]int64{},
+					Time:       &harvest.InvoiceLineItemImportTimeRequest{SummaryType: harvest.String("weekly")},
+				},
+			},
+			want: []harvest.Error{
+				{
+					Resource: "InvoiceLineItemRequest",
+					Field:    "line_items[1].unit_price",
+					Code:     harvest.CodeMissingField,
+					Message:  "can't be blank",
+				},
+				{
+					Resource: "InvoiceLineItemImportRequest",
+					Field:    "line_items_import.project_ids",
+					Code:     harvest.CodeMissingField,
+					Message:  "can't be blank",
+				},
+				{
+					Resource: "InvoiceLineItemImportTimeRequest",
+					Field:    "line_items_import.time.summary_type",
+					Code:     harvest.CodeInvalid,
+					Message:  "must be one of: project, task, people, detailed",
+				},
+			},
+		},
+		{
+			name:    "invalid event type",
+			request: &harvest.EventTypeRequest{EventType: "archive"},
+			want: []harvest.Error{
+				{
+					Resource: "EventTypeRequest",
+					Field:    "event_type",
+					Code:     harvest.CodeInvalid,
+					Message:  "must be one of: close, draft, re-open, send",
+				},
+			},
+		},
+		{
+			name:    "update without fields",
+			request: &harvest.TimeEntryUpdate{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.request.Validate()
+			if tt.want == nil {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			var verr *harvest.ValidationError
+
+			assert.ErrorIs(t, err, harvest.ErrValidation)
+			assert.ErrorAs(t, err, &verr)
+			assert.Equal(t, tt.want, verr.Errors)
+		})
+	}
+}
+
+func TestValidate_beforeSending(t *testing.T) {
+	t.Parallel()
+
+	client, mux, teardown := setup(t)
+	t.Cleanup(teardown)
+
+	mux.HandleFunc("/time_entries", func(_ http.ResponseWriter, r *http.Request) {
+		t.Errorf("invalid request %s %s was sent", r.Method, r.URL)
+	})
+
+	_, resp, err := client.Timesheet.CreateTimeEntryViaDuration(context.Background(), &harvest.TimeEntryCreateViaDuration{
+		ProjectID: harvest.Int64(1),
+	})
+	assert.Nil(t, resp)
+	assert.EqualError(t, err, "request validation failed: task_id can't be blank, spent_date can't be blank")
+}
@@ -0,0 +1,1764 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: services.go
+//
+// Generated by this command:
+//
+//	mockgen -source=services.go -destination=../harvestmock/services.go -package=harvestmock
+//
+
+// Package harvestmock is a generated GoMock package.
+package harvestmock
+
+import (
+	context "context"
+	http "net/http"
+	reflect "reflect"
+
+	harvest "github.com/becoded/go-harvest/harvest"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockClientAPI is a mock of ClientAPI interface.
+type MockClientAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockClientAPIMockRecorder is the mock recorder for MockClientAPI.
+type MockClientAPIMockRecorder struct {
+	mock *MockClientAPI
+}
+
+// NewMockClientAPI creates a new mock instance.
+func NewMockClientAPI(ctrl *gomock.Controller) *MockClientAPI {
+	mock := &MockClientAPI{ctrl: ctrl}
+	mock.recorder = &MockClientAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientAPI) EXPECT() *MockClientAPIMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockClientAPI) Create(ctx context.Context, data *harvest.ClientCreateRequest) (*harvest.Client, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, data)
+	ret0, _ := ret[0].(*harvest.Client)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockClientAPIMockRecorder) Create(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockClientAPI)(nil).Create), ctx, data)
+}
+
+// CreateClientContact mocks base method.
+func (m *MockClientAPI) CreateClientContact(ctx context.Context, data *harvest.ClientContactCreateRequest) (*harvest.ClientContact, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClientContact", ctx, data)
+	ret0, _ := ret[0].(*harvest.ClientContact)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateClientContact indicates an expected call of CreateClientContact.
+func (mr *MockClientAPIMockRecorder) CreateClientContact(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClientContact", reflect.TypeOf((*MockClientAPI)(nil).CreateClientContact), ctx, data)
+}
+
+// Delete mocks base method.
+func (m *MockClientAPI) Delete(ctx context.Context, clientID int64) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, clientID)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockClientAPIMockRecorder) Delete(ctx, clientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockClientAPI)(nil).Delete), ctx, clientID)
+}
+
+// DeleteClientContact mocks base method.
+func (m *MockClientAPI) DeleteClientContact(ctx context.Context, contactID int64) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteClientContact", ctx, contactID)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteClientContact indicates an expected call of DeleteClientContact.
+func (mr *MockClientAPIMockRecorder) DeleteClientContact(ctx, contactID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteClientContact", reflect.TypeOf((*MockClientAPI)(nil).DeleteClientContact), ctx, contactID)
+}
+
+// Get mocks base method.
+func (m *MockClientAPI) Get(ctx context.Context, clientID int64) (*harvest.Client, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, clientID)
+	ret0, _ := ret[0].(*harvest.Client)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockClientAPIMockRecorder) Get(ctx, clientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockClientAPI)(nil).Get), ctx, clientID)
+}
+
+// GetContact mocks base method.
+func (m *MockClientAPI) GetContact(ctx context.Context, clientContactID int64) (*harvest.ClientContact, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContact", ctx, clientContactID)
+	ret0, _ := ret[0].(*harvest.ClientContact)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetContact indicates an expected call of GetContact.
+func (mr *MockClientAPIMockRecorder) GetContact(ctx, clientContactID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContact", reflect.TypeOf((*MockClientAPI)(nil).GetContact), ctx, clientContactID)
+}
+
+// List mocks base method.
+func (m *MockClientAPI) List(ctx context.Context, opt *harvest.ClientListOptions) (*harvest.ClientList, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, opt)
+	ret0, _ := ret[0].(*harvest.ClientList)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockClientAPIMockRecorder) List(ctx, opt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockClientAPI)(nil).List), ctx, opt)
+}
+
+// ListContacts mocks base method.
+func (m *MockClientAPI) ListContacts(ctx context.Context, opt *harvest.ClientContactListOptions) (*harvest.ClientContactList, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListContacts", ctx, opt)
+	ret0, _ := ret[0].(*harvest.ClientContactList)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListContacts indicates an expected call of ListContacts.
+func (mr *MockClientAPIMockRecorder) ListContacts(ctx, opt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListContacts", reflect.TypeOf((*MockClientAPI)(nil).ListContacts), ctx, opt)
+}
+
+// Update mocks base method.
+func (m *MockClientAPI) Update(ctx context.Context, clientID int64, data *harvest.ClientUpdateRequest) (*harvest.Client, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, clientID, data)
+	ret0, _ := ret[0].(*harvest.Client)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockClientAPIMockRecorder) Update(ctx, clientID, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockClientAPI)(nil).Update), ctx, clientID, data)
+}
+
+// UpdateClientContact mocks base method.
+func (m *MockClientAPI) UpdateClientContact(ctx context.Context, contactID int64, data *harvest.ClientContactUpdateRequest) (*harvest.ClientContact, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClientContact", ctx, contactID, data)
+	ret0, _ := ret[0].(*harvest.ClientContact)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateClientContact indicates an expected call of UpdateClientContact.
+func (mr *MockClientAPIMockRecorder) UpdateClientContact(ctx, contactID, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClientContact", reflect.TypeOf((*MockClientAPI)(nil).UpdateClientContact), ctx, contactID, data)
+}
+
+// MockCompanyAPI is a mock of CompanyAPI interface.
+type MockCompanyAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockCompanyAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockCompanyAPIMockRecorder is the mock recorder for MockCompanyAPI.
+type MockCompanyAPIMockRecorder struct {
+	mock *MockCompanyAPI
+}
+
+// NewMockCompanyAPI creates a new mock instance.
+func NewMockCompanyAPI(ctrl *gomock.Controller) *MockCompanyAPI {
+	mock := &MockCompanyAPI{ctrl: ctrl}
+	mock.recorder = &MockCompanyAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCompanyAPI) EXPECT() *MockCompanyAPIMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockCompanyAPI) Get(ctx context.Context) (*harvest.Company, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].(*harvest.Company)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCompanyAPIMockRecorder) Get(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCompanyAPI)(nil).Get), ctx)
+}
+
+// MockEstimateAPI is a mock of EstimateAPI interface.
+type MockEstimateAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockEstimateAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockEstimateAPIMockRecorder is the mock recorder for MockEstimateAPI.
+type MockEstimateAPIMockRecorder struct {
+	mock *MockEstimateAPI
+}
+
+// NewMockEstimateAPI creates a new mock instance.
+func NewMockEstimateAPI(ctrl *gomock.Controller) *MockEstimateAPI {
+	mock := &MockEstimateAPI{ctrl: ctrl}
+	mock.recorder = &MockEstimateAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEstimateAPI) EXPECT() *MockEstimateAPIMockRecorder {
+	return m.recorder
+}
+
+// CreateEstimateMessage mocks base method.
+func (m *MockEstimateAPI) CreateEstimateMessage(ctx context.Context, estimateID int64, data *harvest.EstimateMessageCreateRequest) (*harvest.EstimateMessage, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEstimateMessage", ctx, estimateID, data)
+	ret0, _ := ret[0].(*harvest.EstimateMessage)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateEstimateMessage indicates an expected call of CreateEstimateMessage.
+func (mr *MockEstimateAPIMockRecorder) CreateEstimateMessage(ctx, estimateID, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEstimateMessage", reflect.TypeOf((*MockEstimateAPI)(nil).CreateEstimateMessage), ctx, estimateID, data)
+}
+
+// CreateItemCategory mocks base method.
+func (m *MockEstimateAPI) CreateItemCategory(ctx context.Context, data *harvest.EstimateItemCategoryRequest) (*harvest.EstimateItemCategory, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateItemCategory", ctx, data)
+	ret0, _ := ret[0].(*harvest.EstimateItemCategory)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateItemCategory indicates an expected call of CreateItemCategory.
+func (mr *MockEstimateAPIMockRecorder) CreateItemCategory(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateItemCategory", reflect.TypeOf((*MockEstimateAPI)(nil).CreateItemCategory), ctx, data)
+}
+
+// DeleteEstimateMessage mocks base method.
+func (m *MockEstimateAPI) DeleteEstimateMessage(ctx context.Context, estimateID, estimateMessageID int64) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEstimateMessage", ctx, estimateID, estimateMessageID)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEstimateMessage indicates an expected call of DeleteEstimateMessage.
+func (mr *MockEstimateAPIMockRecorder) DeleteEstimateMessage(ctx, estimateID, estimateMessageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEstimateMessage", reflect.TypeOf((*MockEstimateAPI)(nil).DeleteEstimateMessage), ctx, estimateID, estimateMessageID)
+}
+
+// DeleteItemCategory mocks base method.
+func (m *MockEstimateAPI) DeleteItemCategory(ctx context.Context, estimateItemCategoryID int64) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteItemCategory", ctx, estimateItemCategoryID)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteItemCategory indicates an expected call of DeleteItemCategory.
+func (mr *MockEstimateAPIMockRecorder) DeleteItemCategory(ctx, estimateItemCategoryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItemCategory", reflect.TypeOf((*MockEstimateAPI)(nil).DeleteItemCategory), ctx, estimateItemCategoryID)
+}
+
+// Get mocks base method.
+func (m *MockEstimateAPI) Get(ctx context.Context, estimateID int64) (*harvest.Estimate, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, estimateID)
+	ret0, _ := ret[0].(*harvest.Estimate)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockEstimateAPIMockRecorder) Get(ctx, estimateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockEstimateAPI)(nil).Get), ctx, estimateID)
+}
+
+// GetItemCategory mocks base method.
+func (m *MockEstimateAPI) GetItemCategory(ctx context.Context, estimateItemCategoryID int64) (*harvest.EstimateItemCategory, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemCategory", ctx, estimateItemCategoryID)
+	ret0, _ := ret[0].(*harvest.EstimateItemCategory)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetItemCategory indicates an expected call of GetItemCategory.
+func (mr *MockEstimateAPIMockRecorder) GetItemCategory(ctx, estimateItemCategoryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemCategory", reflect.TypeOf((*MockEstimateAPI)(nil).GetItemCategory), ctx, estimateItemCategoryID)
+}
+
+// List mocks base method.
+func (m *MockEstimateAPI) List(ctx context.Context, opt *harvest.EstimateListOptions) (*harvest.EstimateList, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, opt)
+	ret0, _ := ret[0].(*harvest.EstimateList)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockEstimateAPIMockRecorder) List(ctx, opt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockEstimateAPI)(nil).List), ctx, opt)
+}
+
+// ListEstimateMessages mocks base method.
+func (m *MockEstimateAPI) ListEstimateMessages(ctx context.Context, estimateID int64, opt *harvest.EstimateMessageListOptions) (*harvest.EstimateMessageList, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEstimateMessages", ctx, estimateID, opt)
+	ret0, _ := ret[0].(*harvest.EstimateMessageList)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListEstimateMessages indicates an expected call of ListEstimateMessages.
+func (mr *MockEstimateAPIMockRecorder) ListEstimateMessages(ctx, estimateID, opt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEstimateMessages", reflect.TypeOf((*MockEstimateAPI)(nil).ListEstimateMessages), ctx, estimateID, opt)
+}
+
+// ListItemCategories mocks base method.
+func (m *MockEstimateAPI) ListItemCategories(ctx context.Context, opt *harvest.EstimateItemCategoryListOptions) (*harvest.EstimateItemCategoryList, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListItemCategories", ctx, opt)
+	ret0, _ := ret[0].(*harvest.EstimateItemCategoryList)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListItemCategories indicates an expected call of ListItemCategories.
+func (mr *MockEstimateAPIMockRecorder) ListItemCategories(ctx, opt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItemCategories", reflect.TypeOf((*MockEstimateAPI)(nil).ListItemCategories), ctx, opt)
+}
+
+// MarkAsAccepted mocks base method.
+func (m *MockEstimateAPI) MarkAsAccepted(ctx context.Context, estimateID int64) (*harvest.EstimateMessage, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAsAccepted", ctx, estimateID)
+	ret0, _ := ret[0].(*harvest.EstimateMessage)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// MarkAsAccepted indicates an expected call of MarkAsAccepted.
+func (mr *MockEstimateAPIMockRecorder) MarkAsAccepted(ctx, estimateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsAccepted", reflect.TypeOf((*MockEstimateAPI)(nil).MarkAsAccepted), ctx, estimateID)
+}
+
+// MarkAsDeclined mocks base method.
+func (m *MockEstimateAPI) MarkAsDeclined(ctx context.Context, estimateID int64) (*harvest.EstimateMessage, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAsDeclined", ctx, estimateID)
+	ret0, _ := ret[0].(*harvest.EstimateMessage)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// MarkAsDeclined indicates an expected call of MarkAsDeclined.
+func (mr *MockEstimateAPIMockRecorder) MarkAsDeclined(ctx, estimateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsDeclined", reflect.TypeOf((*MockEstimateAPI)(nil).MarkAsDeclined), ctx, estimateID)
+}
+
+// MarkAsReopen mocks base method.
+func (m *MockEstimateAPI) MarkAsReopen(ctx context.Context, estimateID int64) (*harvest.EstimateMessage, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAsReopen", ctx, estimateID)
+	ret0, _ := ret[0].(*harvest.EstimateMessage)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// MarkAsReopen indicates an expected call of MarkAsReopen.
+func (mr *MockEstimateAPIMockRecorder) MarkAsReopen(ctx, estimateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsReopen", reflect.TypeOf((*MockEstimateAPI)(nil).MarkAsReopen), ctx, estimateID)
+}
+
+// MarkAsSent mocks base method.
+func (m *MockEstimateAPI) MarkAsSent(ctx context.Context, estimateID int64) (*harvest.EstimateMessage, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAsSent", ctx, estimateID)
+	ret0, _ := ret[0].(*harvest.EstimateMessage)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// MarkAsSent indicates an expected call of MarkAsSent.
+func (mr *MockEstimateAPIMockRecorder) MarkAsSent(ctx, estimateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsSent", reflect.TypeOf((*MockEstimateAPI)(nil).MarkAsSent), ctx, estimateID)
+}
+
+// SendEvent mocks base method.
+func (m *MockEstimateAPI) SendEvent(ctx context.Context, estimateID int64, data *harvest.EstimateEventTypeRequest) (*harvest.EstimateMessage, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendEvent", ctx, estimateID, data)
+	ret0, _ := ret[0].(*harvest.EstimateMessage)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SendEvent indicates an expected call of SendEvent.
+func (mr *MockEstimateAPIMockRecorder) SendEvent(ctx, estimateID, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEvent", reflect.TypeOf((*MockEstimateAPI)(nil).SendEvent), ctx, estimateID, data)
+}
+
+// UpdateItemCategory mocks base method.
+func (m *MockEstimateAPI) UpdateItemCategory(ctx context.Context, estimateItemCategoryID int64, data *harvest.EstimateItemCategoryRequest) (*harvest.EstimateItemCategory, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateItemCategory", ctx, estimateItemCategoryID, data)
+	ret0, _ := ret[0].(*harvest.EstimateItemCategory)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateItemCategory indicates an expected call of UpdateItemCategory.
+func (mr *MockEstimateAPIMockRecorder) UpdateItemCategory(ctx, estimateItemCategoryID, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItemCategory", reflect.TypeOf((*MockEstimateAPI)(nil).UpdateItemCategory), ctx, estimateItemCategoryID, data)
+}
+
+// MockExpenseAPI is a mock of ExpenseAPI interface.
+type MockExpenseAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockExpenseAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockExpenseAPIMockRecorder is the mock recorder for MockExpenseAPI.
+type MockExpenseAPIMockRecorder struct {
+	mock *MockExpenseAPI
+}
+
+// NewMockExpenseAPI creates a new mock instance.
+func NewMockExpenseAPI(ctrl *gomock.Controller) *MockExpenseAPI {
+	mock := &MockExpenseAPI{ctrl: ctrl}
+	mock.recorder = &MockExpenseAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExpenseAPI) EXPECT() *MockExpenseAPIMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockExpenseAPI) Create(ctx context.Context, data *harvest.ExpenseCreateRequest) (*harvest.Expense, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, data)
+	ret0, _ := ret[0].(*harvest.Expense)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockExpenseAPIMockRecorder) Create(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockExpenseAPI)(nil).Create), ctx, data)
+}
+
+// CreateExpenseCategory mocks base method.
+func (m *MockExpenseAPI) CreateExpenseCategory(ctx context.Context, data *harvest.ExpenseCategoryRequest) (*harvest.ExpenseCategory, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExpenseCategory", ctx, data)
+	ret0, _ := ret[0].(*harvest.ExpenseCategory)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateExpenseCategory indicates an expected call of CreateExpenseCategory.
+func (mr *MockExpenseAPIMockRecorder) CreateExpenseCategory(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExpenseCategory", reflect.TypeOf((*MockExpenseAPI)(nil).CreateExpenseCategory), ctx, data)
+}
+
+// Delete mocks base method.
+func (m *MockExpenseAPI) Delete(ctx context.Context, expenseID int64) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, expenseID)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockExpenseAPIMockRecorder) Delete(ctx, expenseID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockExpenseAPI)(nil).Delete), ctx, expenseID)
+}
+
+// DeleteExpenseCategory mocks base method.
+func (m *MockExpenseAPI) DeleteExpenseCategory(ctx context.Context, expenseCategoryID int64) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpenseCategory", ctx, expenseCategoryID)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpenseCategory indicates an expected call of DeleteExpenseCategory.
+func (mr *MockExpenseAPIMockRecorder) DeleteExpenseCategory(ctx, expenseCategoryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpenseCategory", reflect.TypeOf((*MockExpenseAPI)(nil).DeleteExpenseCategory), ctx, expenseCategoryID)
+}
+
+// Get mocks base method.
+func (m *MockExpenseAPI) Get(ctx context.Context, expenseID int64) (*harvest.Expense, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, expenseID)
+	ret0, _ := ret[0].(*harvest.Expense)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockExpenseAPIMockRecorder) Get(ctx, expenseID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockExpenseAPI)(nil).Get), ctx, expenseID)
+}
+
+// GetExpenseCategory mocks base method.
+func (m *MockExpenseAPI) GetExpenseCategory(ctx context.Context, expenseCategoryID int64) (*harvest.ExpenseCategory, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpenseCategory", ctx, expenseCategoryID)
+	ret0, _ := ret[0].(*harvest.ExpenseCategory)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetExpenseCategory indicates an expected call of GetExpenseCategory.
+func (mr *MockExpenseAPIMockRecorder) GetExpenseCategory(ctx, expenseCategoryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpenseCategory", reflect.TypeOf((*MockExpenseAPI)(nil).GetExpenseCategory), ctx, expenseCategoryID)
+}
+
+// List mocks base method.
+func (m *MockExpenseAPI) List(ctx context.Context, opt *harvest.ExpenseListOptions) (*harvest.ExpenseList, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, opt)
+	ret0, _ := ret[0].(*harvest.ExpenseList)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockExpenseAPIMockRecorder) List(ctx, opt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockExpenseAPI)(nil).List), ctx, opt)
+}
+
+// ListExpenseCategories mocks base method.
+func (m *MockExpenseAPI) ListExpenseCategories(ctx context.Context, opt *harvest.ExpenseCategoryListOptions) (*harvest.ExpenseCategoryList, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpenseCategories", ctx, opt)
+	ret0, _ := ret[0].(*harvest.ExpenseCategoryList)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListExpenseCategories indicates an expected call of ListExpenseCategories.
+func (mr *MockExpenseAPIMockRecorder) ListExpenseCategories(ctx, opt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpenseCategories", reflect.TypeOf((*MockExpenseAPI)(nil).ListExpenseCategories), ctx, opt)
+}
+
+// Update mocks base method.
+func (m *MockExpenseAPI) Update(ctx context.Context, expenseID int64, data *harvest.ExpenseUpdateRequest) (*harvest.Expense, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, expenseID, data)
+	ret0, _ := ret[0].(*harvest.Expense)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockExpenseAPIMockRecorder) Update(ctx, expenseID, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockExpenseAPI)(nil).Update), ctx, expenseID, data)
+}
+
+// UpdateExpenseCategory mocks base method.
+func (m *MockExpenseAPI) UpdateExpenseCategory(ctx context.Context, expenseCategoryID int64, data *harvest.ExpenseCategoryRequest) (*harvest.ExpenseCategory, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateExpenseCategory", ctx, expenseCategoryID, data)
+	ret0, _ := ret[0].(*harvest.ExpenseCategory)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateExpenseCategory indicates an expected call of UpdateExpenseCategory.
+func (mr *MockExpenseAPIMockRecorder) UpdateExpenseCategory(ctx, expenseCategoryID, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExpenseCategory", reflect.TypeOf((*MockExpenseAPI)(nil).UpdateExpenseCategory), ctx, expenseCategoryID, data)
+}
+
+// MockInvoiceAPI is a mock of InvoiceAPI interface.
+type MockInvoiceAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvoiceAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockInvoiceAPIMockRecorder is the mock recorder for MockInvoiceAPI.
+type MockInvoiceAPIMockRecorder struct {
+	mock *MockInvoiceAPI
+}
+
+// NewMockInvoiceAPI creates a new mock instance.
+func NewMockInvoiceAPI(ctrl *gomock.Controller) *MockInvoiceAPI {
+	mock := &MockInvoiceAPI{ctrl: ctrl}
+	mock.recorder = &MockInvoiceAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvoiceAPI) EXPECT() *MockInvoiceAPIMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockInvoiceAPI) Create(ctx context.Context, data *harvest.InvoiceCreateRequest) (*harvest.Invoice, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, data)
+	ret0, _ := ret[0].(*harvest.Invoice)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockInvoiceAPIMockRecorder) Create(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockInvoiceAPI)(nil).Create), ctx, data)
+}
+
+// CreateInvoiceMessage mocks base method.
+func (m *MockInvoiceAPI) CreateInvoiceMessage(ctx context.Context, invoiceID int64, data *harvest.InvoiceMessageCreateRequest) (*harvest.InvoiceMessage, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvoiceMessage", ctx, invoiceID, data)
+	ret0, _ := ret[0].(*harvest.InvoiceMessage)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateInvoiceMessage indicates an expected call of CreateInvoiceMessage.
+func (mr *MockInvoiceAPIMockRecorder) CreateInvoiceMessage(ctx, invoiceID, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvoiceMessage", reflect.TypeOf((*MockInvoiceAPI)(nil).CreateInvoiceMessage), ctx, invoiceID, data)
+}
+
+// CreateItemCategory mocks base method.
+func (m *MockInvoiceAPI) CreateItemCategory(ctx context.Context, data *harvest.InvoiceItemCategoryRequest) (*harvest.InvoiceItemCategory, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateItemCategory", ctx, data)
+	ret0, _ := ret[0].(*harvest.InvoiceItemCategory)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateItemCategory indicates an expected call of CreateItemCategory.
+func (mr *MockInvoiceAPIMockRecorder) CreateItemCategory(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateItemCategory", reflect.TypeOf((*MockInvoiceAPI)(nil).CreateItemCategory), ctx, data)
+}
+
+// CreatePayment mocks base method.
+func (m *MockInvoiceAPI) CreatePayment(ctx context.Context, invoiceID int64, data *harvest.InvoicePaymentRequest) (*harvest.InvoicePayment, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePayment", ctx, invoiceID, data)
+	ret0, _ := ret[0].(*harvest.InvoicePayment)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreatePayment indicates an expected call of CreatePayment.
+func (mr *MockInvoiceAPIMockRecorder) CreatePayment(ctx, invoiceID, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayment", reflect.TypeOf((*MockInvoiceAPI)(nil).CreatePayment), ctx, invoiceID, data)
+}
+
+// Delete mocks base method.
+func (m *MockInvoiceAPI) Delete(ctx context.Context, invoiceID int64) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, invoiceID)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockInvoiceAPIMockRecorder) Delete(ctx, invoiceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockInvoiceAPI)(nil).Delete), ctx, invoiceID)
+}
+
+// DeleteInvoiceMessage mocks base method.
+func (m *MockInvoiceAPI) DeleteInvoiceMessage(ctx context.Context, invoiceID, invoiceMessageID int64) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInvoiceMessage", ctx, invoiceID, invoiceMessageID)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteInvoiceMessage indicates an expected call of DeleteInvoiceMessage.
+func (mr *MockInvoiceAPIMockRecorder) DeleteInvoiceMessage(ctx, invoiceID, invoiceMessageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInvoiceMessage", reflect.TypeOf((*MockInvoiceAPI)(nil).DeleteInvoiceMessage), ctx, invoiceID, invoiceMessageID)
+}
+
+// DeleteItemCategory mocks base method.
+func (m *MockInvoiceAPI) DeleteItemCategory(ctx context.Context, invoiceItemCategoryID int64) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteItemCategory", ctx, invoiceItemCategoryID)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteItemCategory indicates an expected call of DeleteItemCategory.
+func (mr *MockInvoiceAPIMockRecorder) DeleteItemCategory(ctx, invoiceItemCategoryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItemCategory", reflect.TypeOf((*MockInvoiceAPI)(nil).DeleteItemCategory), ctx, invoiceItemCategoryID)
+}
+
+// DeletePayment mocks base method.
+func (m *MockInvoiceAPI) DeletePayment(ctx context.Context, invoiceID, invoicePaymentID int64) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePayment", ctx, invoiceID, invoicePaymentID)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePayment indicates an expected call of DeletePayment.
+func (mr *MockInvoiceAPIMockRecorder) DeletePayment(ctx, invoiceID, invoicePaymentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePayment", reflect.TypeOf((*MockInvoiceAPI)(nil).DeletePayment), ctx, invoiceID, invoicePaymentID)
+}
+
+// Get mocks base method.
+func (m *MockInvoiceAPI) Get(ctx context.Context, invoiceID int64) (*harvest.Invoice, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, invoiceID)
+	ret0, _ := ret[0].(*harvest.Invoice)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockInvoiceAPIMockRecorder) Get(ctx, invoiceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockInvoiceAPI)(nil).Get), ctx, invoiceID)
+}
+
+// GetItemCategory mocks base method.
+func (m *MockInvoiceAPI) GetItemCategory(ctx context.Context, invoiceItemCategoryID int64) (*harvest.InvoiceItemCategory, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemCategory", ctx, invoiceItemCategoryID)
+	ret0, _ := ret[0].(*harvest.InvoiceItemCategory)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetItemCategory indicates an expected call of GetItemCategory.
+func (mr *MockInvoiceAPIMockRecorder) GetItemCategory(ctx, invoiceItemCategoryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemCategory", reflect.TypeOf((*MockInvoiceAPI)(nil).GetItemCategory), ctx, invoiceItemCategoryID)
+}
+
+// List mocks base method.
+func (m *MockInvoiceAPI) List(ctx context.Context, opt *harvest.InvoiceListOptions) (*harvest.InvoiceList, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, opt)
+	ret0, _ := ret[0].(*harvest.InvoiceList)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockInvoiceAPIMockRecorder) List(ctx, opt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockInvoiceAPI)(nil).List), ctx, opt)
+}
+
+// ListInvoiceMessages mocks base method.
+func (m *MockInvoiceAPI) ListInvoiceMessages(ctx context.Context, invoiceID int64, opt *harvest.InvoiceMessageListOptions) (*harvest.InvoiceMessageList, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInvoiceMessages", ctx, invoiceID, opt)
+	ret0, _ := ret[0].(*harvest.InvoiceMessageList)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListInvoiceMessages indicates an expected call of ListInvoiceMessages.
+func (mr *MockInvoiceAPIMockRecorder) ListInvoiceMessages(ctx, invoiceID, opt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvoiceMessages", reflect.TypeOf((*MockInvoiceAPI)(nil).ListInvoiceMessages), ctx, invoiceID, opt)
+}
+
+// ListItemCategories mocks base method.
+func (m *MockInvoiceAPI) ListItemCategories(ctx context.Context, opt *harvest.InvoiceItemCategoryListOptions) (*harvest.InvoiceItemCategoryList, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListItemCategories", ctx, opt)
+	ret0, _ := ret[0].(*harvest.InvoiceItemCategoryList)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListItemCategories indicates an expected call of ListItemCategories.
+func (mr *MockInvoiceAPIMockRecorder) ListItemCategories(ctx, opt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItemCategories", reflect.TypeOf((*MockInvoiceAPI)(nil).ListItemCategories), ctx, opt)
+}
+
+// ListPayments mocks base method.
+func (m *MockInvoiceAPI) ListPayments(ctx context.Context, invoiceID int64, opt *harvest.InvoicePaymentListOptions) (*harvest.InvoicePaymentList, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPayments", ctx, invoiceID, opt)
+	ret0, _ := ret[0].(*harvest.InvoicePaymentList)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListPayments indicates an expected call of ListPayments.
+func (mr *MockInvoiceAPIMockRecorder) ListPayments(ctx, invoiceID, opt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPayments", reflect.TypeOf((*MockInvoiceAPI)(nil).ListPayments), ctx, invoiceID, opt)
+}
+
+// MarkAsClosed mocks base method.
+func (m *MockInvoiceAPI) MarkAsClosed(ctx context.Context, invoiceID int64) (*harvest.InvoiceMessage, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAsClosed", ctx, invoiceID)
+	ret0, _ := ret[0].(*harvest.InvoiceMessage)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// MarkAsClosed indicates an expected call of MarkAsClosed.
+func (mr *MockInvoiceAPIMockRecorder) MarkAsClosed(ctx, invoiceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsClosed", reflect.TypeOf((*MockInvoiceAPI)(nil).MarkAsClosed), ctx, invoiceID)
+}
+
+// MarkAsDraft mocks base method.
+func (m *MockInvoiceAPI) MarkAsDraft(ctx context.Context, invoiceID int64) (*harvest.InvoiceMessage, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAsDraft", ctx, invoiceID)
+	ret0, _ := ret[0].(*harvest.InvoiceMessage)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// MarkAsDraft indicates an expected call of MarkAsDraft.
+func (mr *MockInvoiceAPIMockRecorder) MarkAsDraft(ctx, invoiceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsDraft", reflect.TypeOf((*MockInvoiceAPI)(nil).MarkAsDraft), ctx, invoiceID)
+}
+
+// MarkAsReopen mocks base method.
+func (m *MockInvoiceAPI) MarkAsReopen(ctx context.Context, invoiceID int64) (*harvest.InvoiceMessage, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAsReopen", ctx, invoiceID)
+	ret0, _ := ret[0].(*harvest.InvoiceMessage)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// MarkAsReopen indicates an expected call of MarkAsReopen.
+func (mr *MockInvoiceAPIMockRecorder) MarkAsReopen(ctx, invoiceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsReopen", reflect.TypeOf((*MockInvoiceAPI)(nil).MarkAsReopen), ctx, invoiceID)
+}
+
+// MarkAsSent mocks base method.
+func (m *MockInvoiceAPI) MarkAsSent(ctx context.Context, invoiceID int64) (*harvest.InvoiceMessage, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAsSent", ctx, invoiceID)
+	ret0, _ := ret[0].(*harvest.InvoiceMessage)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// MarkAsSent indicates an expected call of MarkAsSent.
+func (mr *MockInvoiceAPIMockRecorder) MarkAsSent(ctx, invoiceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsSent", reflect.TypeOf((*MockInvoiceAPI)(nil).MarkAsSent), ctx, invoiceID)
+}
+
+// SendEvent mocks base method.
+func (m *MockInvoiceAPI) SendEvent(ctx context.Context, invoiceID int64, data *harvest.EventTypeRequest) (*harvest.InvoiceMessage, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendEvent", ctx, invoiceID, data)
+	ret0, _ := ret[0].(*harvest.InvoiceMessage)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SendEvent indicates an expected call of SendEvent.
+func (mr *MockInvoiceAPIMockRecorder) SendEvent(ctx, invoiceID, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEvent", reflect.TypeOf((*MockInvoiceAPI)(nil).SendEvent), ctx, invoiceID, data)
+}
+
+// Update mocks base method.
+func (m *MockInvoiceAPI) Update(ctx context.Context, invoiceID int64, data *harvest.InvoiceUpdateRequest) (*harvest.Invoice, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, invoiceID, data)
+	ret0, _ := ret[0].(*harvest.Invoice)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockInvoiceAPIMockRecorder) Update(ctx, invoiceID, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockInvoiceAPI)(nil).Update), ctx, invoiceID, data)
+}
+
+// UpdateItemCategory mocks base method.
+func (m *MockInvoiceAPI) UpdateItemCategory(ctx context.Context, invoiceItemCategoryID int64, data *harvest.InvoiceItemCategoryRequest) (*harvest.InvoiceItemCategory, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateItemCategory", ctx, invoiceItemCategoryID, data)
+	ret0, _ := ret[0].(*harvest.InvoiceItemCategory)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateItemCategory indicates an expected call of UpdateItemCategory.
+func (mr *MockInvoiceAPIMockRecorder) UpdateItemCategory(ctx, invoiceItemCategoryID, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItemCategory", reflect.TypeOf((*MockInvoiceAPI)(nil).UpdateItemCategory), ctx, invoiceItemCategoryID, data)
+}
+
+// MockProjectAPI is a mock of ProjectAPI interface.
+type MockProjectAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectAPIMockRecorder is the mock recorder for MockProjectAPI.
+type MockProjectAPIMockRecorder struct {
+	mock *MockProjectAPI
+}
+
+// NewMockProjectAPI creates a new mock instance.
+func NewMockProjectAPI(ctrl *gomock.Controller) *MockProjectAPI {
+	mock := &MockProjectAPI{ctrl: ctrl}
+	mock.recorder = &MockProjectAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectAPI) EXPECT() *MockProjectAPIMockRecorder {
+	return m.recorder
+}
+
+// CreateTaskAssignment mocks base method.
+func (m *MockProjectAPI) CreateTaskAssignment(ctx context.Context, projectID int64, data *harvest.ProjectTaskAssignmentCreateRequest) (*harvest.ProjectTaskAssignment, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTaskAssignment", ctx, projectID, data)
+	ret0, _ := ret[0].(*harvest.ProjectTaskAssignment)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateTaskAssignment indicates an expected call of CreateTaskAssignment.
+func (mr *MockProjectAPIMockRecorder) CreateTaskAssignment(ctx, projectID, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTaskAssignment", reflect.TypeOf((*MockProjectAPI)(nil).CreateTaskAssignment), ctx, projectID, data)
+}
+
+// Get mocks base method.
+func (m *MockProjectAPI) Get(ctx context.Context, projectID int64) (*harvest.Project, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, projectID)
+	ret0, _ := ret[0].(*harvest.Project)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockProjectAPIMockRecorder) Get(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockProjectAPI)(nil).Get), ctx, projectID)
+}
+
+// GetTaskAssignment mocks base method.
+func (m *MockProjectAPI) GetTaskAssignment(ctx context.Context, projectID, taskAssignmentID int64) (*harvest.ProjectTaskAssignment, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskAssignment", ctx, projectID, taskAssignmentID)
+	ret0, _ := ret[0].(*harvest.ProjectTaskAssignment)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTaskAssignment indicates an expected call of GetTaskAssignment.
+func (mr *MockProjectAPIMockRecorder) GetTaskAssignment(ctx, projectID, taskAssignmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskAssignment", reflect.TypeOf((*MockProjectAPI)(nil).GetTaskAssignment), ctx, projectID, taskAssignmentID)
+}
+
+// GetUserAssignment mocks base method.
+func (m *MockProjectAPI) GetUserAssignment(ctx context.Context, projectID, userAssignmentID int64) (*harvest.ProjectUserAssignment, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserAssignment", ctx, projectID, userAssignmentID)
+	ret0, _ := ret[0].(*harvest.ProjectUserAssignment)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserAssignment indicates an expected call of GetUserAssignment.
+func (mr *MockProjectAPIMockRecorder) GetUserAssignment(ctx, projectID, userAssignmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAssignment", reflect.TypeOf((*MockProjectAPI)(nil).GetUserAssignment), ctx, projectID, userAssignmentID)
+}
+
+// List mocks base method.
+func (m *MockProjectAPI) List(ctx context.Context, opt *harvest.ProjectListOptions) (*harvest.ProjectList, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, opt)
+	ret0, _ := ret[0].(*harvest.ProjectList)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockProjectAPIMockRecorder) List(ctx, opt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProjectAPI)(nil).List), ctx, opt)
+}
+
+// ListTaskAssignments mocks base method.
+func (m *MockProjectAPI) ListTaskAssignments(ctx context.Context, projectID int64, opt *harvest.ProjectTaskAssignmentListOptions) (*harvest.ProjectTaskAssignmentList, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaskAssignments", ctx, projectID, opt)
+	ret0, _ := ret[0].(*harvest.ProjectTaskAssignmentList)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListTaskAssignments indicates an expected call of ListTaskAssignments.
+func (mr *MockProjectAPIMockRecorder) ListTaskAssignments(ctx, projectID, opt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskAssignments", reflect.TypeOf((*MockProjectAPI)(nil).ListTaskAssignments), ctx, projectID, opt)
+}
+
+// ListUserAssignments mocks base method.
+func (m *MockProjectAPI) ListUserAssignments(ctx context.Context, projectID int64, opt *harvest.ProjectUserAssignmentListOptions) (*harvest.ProjectUserAssignmentList, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserAssignments", ctx, projectID, opt)
+	ret0, _ := ret[0].(*harvest.ProjectUserAssignmentList)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListUserAssignments indicates an expected call of ListUserAssignments.
+func (mr *MockProjectAPIMockRecorder) ListUserAssignments(ctx, projectID, opt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserAssignments", reflect.TypeOf((*MockProjectAPI)(nil).ListUserAssignments), ctx, projectID, opt)
+}
+
+// MockRoleAPI is a mock of RoleAPI interface.
+type MockRoleAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockRoleAPIMockRecorder is the mock recorder for MockRoleAPI.
+type MockRoleAPIMockRecorder struct {
+	mock *MockRoleAPI
+}
+
+// NewMockRoleAPI creates a new mock instance.
+func NewMockRoleAPI(ctrl *gomock.Controller) *MockRoleAPI {
+	mock := &MockRoleAPI{ctrl: ctrl}
+	mock.recorder = &MockRoleAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleAPI) EXPECT() *MockRoleAPIMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRoleAPI) Create(ctx context.Context, data *harvest.RoleCreateRequest) (*harvest.Role, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, data)
+	ret0, _ := ret[0].(*harvest.Role)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRoleAPIMockRecorder) Create(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRoleAPI)(nil).Create), ctx, data)
+}
+
+// Delete mocks base method.
+func (m *MockRoleAPI) Delete(ctx context.Context, roleID int64) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, roleID)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRoleAPIMockRecorder) Delete(ctx, roleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRoleAPI)(nil).Delete), ctx, roleID)
+}
+
+// Get mocks base method.
+func (m *MockRoleAPI) Get(ctx context.Context, roleID int64) (*harvest.Role, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, roleID)
+	ret0, _ := ret[0].(*harvest.Role)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockRoleAPIMockRecorder) Get(ctx, roleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRoleAPI)(nil).Get), ctx, roleID)
+}
+
+// List mocks base method.
+func (m *MockRoleAPI) List(ctx context.Context, opt *harvest.RoleListOptions) (*harvest.RoleList, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, opt)
+	ret0, _ := ret[0].(*harvest.RoleList)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockRoleAPIMockRecorder) List(ctx, opt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRoleAPI)(nil).List), ctx, opt)
+}
+
+// Update mocks base method.
+func (m *MockRoleAPI) Update(ctx context.Context, roleID int64, data *harvest.RoleUpdateRequest) (*harvest.Role, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, roleID, data)
+	ret0, _ := ret[0].(*harvest.Role)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRoleAPIMockRecorder) Update(ctx, roleID, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRoleAPI)(nil).Update), ctx, roleID, data)
+}
+
+// MockTaskAPI is a mock of TaskAPI interface.
+type MockTaskAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockTaskAPIMockRecorder is the mock recorder for MockTaskAPI.
+type MockTaskAPIMockRecorder struct {
+	mock *MockTaskAPI
+}
+
+// NewMockTaskAPI creates a new mock instance.
+func NewMockTaskAPI(ctrl *gomock.Controller) *MockTaskAPI {
+	mock := &MockTaskAPI{ctrl: ctrl}
+	mock.recorder = &MockTaskAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskAPI) EXPECT() *MockTaskAPIMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTaskAPI) Create(ctx context.Context, data *harvest.TaskCreateRequest) (*harvest.Task, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, data)
+	ret0, _ := ret[0].(*harvest.Task)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTaskAPIMockRecorder) Create(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTaskAPI)(nil).Create), ctx, data)
+}
+
+// Delete mocks base method.
+func (m *MockTaskAPI) Delete(ctx context.Context, taskID int64) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, taskID)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTaskAPIMockRecorder) Delete(ctx, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTaskAPI)(nil).Delete), ctx, taskID)
+}
+
+// Get mocks base method.
+func (m *MockTaskAPI) Get(ctx context.Context, taskID int64) (*harvest.Task, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, taskID)
+	ret0, _ := ret[0].(*harvest.Task)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTaskAPIMockRecorder) Get(ctx, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTaskAPI)(nil).Get), ctx, taskID)
+}
+
+// List mocks base method.
+func (m *MockTaskAPI) List(ctx context.Context, opt *harvest.TaskListOptions) (*harvest.TaskList, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, opt)
+	ret0, _ := ret[0].(*harvest.TaskList)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockTaskAPIMockRecorder) List(ctx, opt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTaskAPI)(nil).List), ctx, opt)
+}
+
+// Update mocks base method.
+func (m *MockTaskAPI) Update(ctx context.Context, taskID int64, data *harvest.TaskUpdateRequest) (*harvest.Task, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, taskID, data)
+	ret0, _ := ret[0].(*harvest.Task)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTaskAPIMockRecorder) Update(ctx, taskID, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTaskAPI)(nil).Update), ctx, taskID, data)
+}
+
+// MockTimesheetAPI is a mock of TimesheetAPI interface.
+type MockTimesheetAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockTimesheetAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockTimesheetAPIMockRecorder is the mock recorder for MockTimesheetAPI.
+type MockTimesheetAPIMockRecorder struct {
+	mock *MockTimesheetAPI
+}
+
+// NewMockTimesheetAPI creates a new mock instance.
+func NewMockTimesheetAPI(ctrl *gomock.Controller) *MockTimesheetAPI {
+	mock := &MockTimesheetAPI{ctrl: ctrl}
+	mock.recorder = &MockTimesheetAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTimesheetAPI) EXPECT() *MockTimesheetAPIMockRecorder {
+	return m.recorder
+}
+
+// CreateTimeEntryViaDuration mocks base method.
+func (m *MockTimesheetAPI) CreateTimeEntryViaDuration(ctx context.Context, data *harvest.TimeEntryCreateViaDuration) (*harvest.TimeEntry, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTimeEntryViaDuration", ctx, data)
+	ret0, _ := ret[0].(*harvest.TimeEntry)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateTimeEntryViaDuration indicates an expected call of CreateTimeEntryViaDuration.
+func (mr *MockTimesheetAPIMockRecorder) CreateTimeEntryViaDuration(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTimeEntryViaDuration", reflect.TypeOf((*MockTimesheetAPI)(nil).CreateTimeEntryViaDuration), ctx, data)
+}
+
+// CreateTimeEntryViaStartEndTime mocks base method.
+func (m *MockTimesheetAPI) CreateTimeEntryViaStartEndTime(ctx context.Context, data *harvest.TimeEntryCreateViaStartEndTime) (*harvest.TimeEntry, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTimeEntryViaStartEndTime", ctx, data)
+	ret0, _ := ret[0].(*harvest.TimeEntry)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateTimeEntryViaStartEndTime indicates an expected call of CreateTimeEntryViaStartEndTime.
+func (mr *MockTimesheetAPIMockRecorder) CreateTimeEntryViaStartEndTime(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTimeEntryViaStartEndTime", reflect.TypeOf((*MockTimesheetAPI)(nil).CreateTimeEntryViaStartEndTime), ctx, data)
+}
+
+// CurrentTimer mocks base method.
+func (m *MockTimesheetAPI) CurrentTimer(ctx context.Context, userID int64) (*harvest.TimeEntry, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CurrentTimer", ctx, userID)
+	ret0, _ := ret[0].(*harvest.TimeEntry)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CurrentTimer indicates an expected call of CurrentTimer.
+func (mr *MockTimesheetAPIMockRecorder) CurrentTimer(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentTimer", reflect.TypeOf((*MockTimesheetAPI)(nil).CurrentTimer), ctx, userID)
+}
+
+// DeleteExternalReference mocks base method.
+func (m *MockTimesheetAPI) DeleteExternalReference(ctx context.Context, timeEntryID int64) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExternalReference", ctx, timeEntryID)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExternalReference indicates an expected call of DeleteExternalReference.
+func (mr *MockTimesheetAPIMockRecorder) DeleteExternalReference(ctx, timeEntryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExternalReference", reflect.TypeOf((*MockTimesheetAPI)(nil).DeleteExternalReference), ctx, timeEntryID)
+}
+
+// DeleteTimeEntry mocks base method.
+func (m *MockTimesheetAPI) DeleteTimeEntry(ctx context.Context, timeEntryID int64) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTimeEntry", ctx, timeEntryID)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTimeEntry indicates an expected call of DeleteTimeEntry.
+func (mr *MockTimesheetAPIMockRecorder) DeleteTimeEntry(ctx, timeEntryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTimeEntry", reflect.TypeOf((*MockTimesheetAPI)(nil).DeleteTimeEntry), ctx, timeEntryID)
+}
+
+// Get mocks base method.
+func (m *MockTimesheetAPI) Get(ctx context.Context, timeEntryID int64) (*harvest.TimeEntry, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, timeEntryID)
+	ret0, _ := ret[0].(*harvest.TimeEntry)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTimesheetAPIMockRecorder) Get(ctx, timeEntryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTimesheetAPI)(nil).Get), ctx, timeEntryID)
+}
+
+// List mocks base method.
+func (m *MockTimesheetAPI) List(ctx context.Context, opt *harvest.TimeEntryListOptions) (*harvest.TimeEntryList, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, opt)
+	ret0, _ := ret[0].(*harvest.TimeEntryList)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockTimesheetAPIMockRecorder) List(ctx, opt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTimesheetAPI)(nil).List), ctx, opt)
+}
+
+// ListByExternalReference mocks base method.
+func (m *MockTimesheetAPI) ListByExternalReference(ctx context.Context, opt *harvest.TimeEntryListOptions, referenceID string) ([]*harvest.TimeEntry, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByExternalReference", ctx, opt, referenceID)
+	ret0, _ := ret[0].([]*harvest.TimeEntry)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByExternalReference indicates an expected call of ListByExternalReference.
+func (mr *MockTimesheetAPIMockRecorder) ListByExternalReference(ctx, opt, referenceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByExternalReference", reflect.TypeOf((*MockTimesheetAPI)(nil).ListByExternalReference), ctx, opt, referenceID)
+}
+
+// RestartTimeEntry mocks base method.
+func (m *MockTimesheetAPI) RestartTimeEntry(ctx context.Context, timeEntryID int64) (*harvest.TimeEntry, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestartTimeEntry", ctx, timeEntryID)
+	ret0, _ := ret[0].(*harvest.TimeEntry)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RestartTimeEntry indicates an expected call of RestartTimeEntry.
+func (mr *MockTimesheetAPIMockRecorder) RestartTimeEntry(ctx, timeEntryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestartTimeEntry", reflect.TypeOf((*MockTimesheetAPI)(nil).RestartTimeEntry), ctx, timeEntryID)
+}
+
+// StartTimer mocks base method.
+func (m *MockTimesheetAPI) StartTimer(ctx context.Context, projectID, taskID int64, notes string) (*harvest.TimeEntry, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTimer", ctx, projectID, taskID, notes)
+	ret0, _ := ret[0].(*harvest.TimeEntry)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// StartTimer indicates an expected call of StartTimer.
+func (mr *MockTimesheetAPIMockRecorder) StartTimer(ctx, projectID, taskID, notes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTimer", reflect.TypeOf((*MockTimesheetAPI)(nil).StartTimer), ctx, projectID, taskID, notes)
+}
+
+// StopTimeEntry mocks base method.
+func (m *MockTimesheetAPI) StopTimeEntry(ctx context.Context, timeEntryID int64) (*harvest.TimeEntry, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopTimeEntry", ctx, timeEntryID)
+	ret0, _ := ret[0].(*harvest.TimeEntry)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// StopTimeEntry indicates an expected call of StopTimeEntry.
+func (mr *MockTimesheetAPIMockRecorder) StopTimeEntry(ctx, timeEntryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopTimeEntry", reflect.TypeOf((*MockTimesheetAPI)(nil).StopTimeEntry), ctx, timeEntryID)
+}
+
+// SwitchTimer mocks base method.
+func (m *MockTimesheetAPI) SwitchTimer(ctx context.Context, timeEntryID int64) (*harvest.TimeEntry, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SwitchTimer", ctx, timeEntryID)
+	ret0, _ := ret[0].(*harvest.TimeEntry)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SwitchTimer indicates an expected call of SwitchTimer.
+func (mr *MockTimesheetAPIMockRecorder) SwitchTimer(ctx, timeEntryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwitchTimer", reflect.TypeOf((*MockTimesheetAPI)(nil).SwitchTimer), ctx, timeEntryID)
+}
+
+// UpdateTimeEntry mocks base method.
+func (m *MockTimesheetAPI) UpdateTimeEntry(ctx context.Context, timeEntryID int64, data *harvest.TimeEntryUpdate) (*harvest.TimeEntry, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTimeEntry", ctx, timeEntryID, data)
+	ret0, _ := ret[0].(*harvest.TimeEntry)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateTimeEntry indicates an expected call of UpdateTimeEntry.
+func (mr *MockTimesheetAPIMockRecorder) UpdateTimeEntry(ctx, timeEntryID, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTimeEntry", reflect.TypeOf((*MockTimesheetAPI)(nil).UpdateTimeEntry), ctx, timeEntryID, data)
+}
+
+// MockUserAPI is a mock of UserAPI interface.
+type MockUserAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockUserAPIMockRecorder is the mock recorder for MockUserAPI.
+type MockUserAPIMockRecorder struct {
+	mock *MockUserAPI
+}
+
+// NewMockUserAPI creates a new mock instance.
+func NewMockUserAPI(ctrl *gomock.Controller) *MockUserAPI {
+	mock := &MockUserAPI{ctrl: ctrl}
+	mock.recorder = &MockUserAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserAPI) EXPECT() *MockUserAPIMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockUserAPI) Create(ctx context.Context, data *harvest.UserCreateRequest) (*harvest.User, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, data)
+	ret0, _ := ret[0].(*harvest.User)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUserAPIMockRecorder) Create(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserAPI)(nil).Create), ctx, data)
+}
+
+// Current mocks base method.
+func (m *MockUserAPI) Current(ctx context.Context) (*harvest.User, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current", ctx)
+	ret0, _ := ret[0].(*harvest.User)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockUserAPIMockRecorder) Current(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockUserAPI)(nil).Current), ctx)
+}
+
+// Delete mocks base method.
+func (m *MockUserAPI) Delete(ctx context.Context, userID int64) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUserAPIMockRecorder) Delete(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserAPI)(nil).Delete), ctx, userID)
+}
+
+// Get mocks base method.
+func (m *MockUserAPI) Get(ctx context.Context, userID int64) (*harvest.User, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userID)
+	ret0, _ := ret[0].(*harvest.User)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockUserAPIMockRecorder) Get(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockUserAPI)(nil).Get), ctx, userID)
+}
+
+// GetMyProjectAssignments mocks base method.
+func (m *MockUserAPI) GetMyProjectAssignments(ctx context.Context, opt *harvest.MyProjectAssignmentListOptions) (*harvest.UserProjectAssignmentList, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMyProjectAssignments", ctx, opt)
+	ret0, _ := ret[0].(*harvest.UserProjectAssignmentList)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMyProjectAssignments indicates an expected call of GetMyProjectAssignments.
+func (mr *MockUserAPIMockRecorder) GetMyProjectAssignments(ctx, opt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMyProjectAssignments", reflect.TypeOf((*MockUserAPI)(nil).GetMyProjectAssignments), ctx, opt)
+}
+
+// List mocks base method.
+func (m *MockUserAPI) List(ctx context.Context, opt *harvest.UserListOptions) (*harvest.UserList, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, opt)
+	ret0, _ := ret[0].(*harvest.UserList)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockUserAPIMockRecorder) List(ctx, opt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUserAPI)(nil).List), ctx, opt)
+}
+
+// ListProjectAssignments mocks base method.
+func (m *MockUserAPI) ListProjectAssignments(ctx context.Context, userID int64, opt *harvest.UserProjectAssignmentListOptions) (*harvest.UserProjectAssignmentList, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectAssignments", ctx, userID, opt)
+	ret0, _ := ret[0].(*harvest.UserProjectAssignmentList)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListProjectAssignments indicates an expected call of ListProjectAssignments.
+func (mr *MockUserAPIMockRecorder) ListProjectAssignments(ctx, userID, opt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectAssignments", reflect.TypeOf((*MockUserAPI)(nil).ListProjectAssignments), ctx, userID, opt)
+}
+
+// Update mocks base method.
+func (m *MockUserAPI) Update(ctx context.Context, userID int64, data *harvest.UserUpdateRequest) (*harvest.User, *http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, userID, data)
+	ret0, _ := ret[0].(*harvest.User)
+	ret1, _ := ret[1].(*http.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUserAPIMockRecorder) Update(ctx, userID, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserAPI)(nil).Update), ctx, userID, data)
+}
@@ -0,0 +1,70 @@
+package harvestmock_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/becoded/go-harvest/harvest"
+	"github.com/becoded/go-harvest/harvestmock"
+)
+
+// totalHours is an example of consumer code depending on the service interfaces.
+func totalHours(ctx context.Context, c *harvest.APIClient, userID int64) (harvest.Hours, error) {
+	list, _, err := c.Timesheet.List(ctx, &harvest.TimeEntryListOptions{UserID: harvest.Int64(userID)})
+	if err != nil {
+		return 0, err
+	}
+
+	var total harvest.Hours
+	for _, entry := range list.TimeEntries {
+		total += entry.GetHours()
+	}
+
+	return total, nil
+}
+
+func TestMockTimesheetAPI(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	timesheet := harvestmock.NewMockTimesheetAPI(ctrl)
+
+	client := harvest.NewAPIClient(nil)
+	client.Timesheet = timesheet
+
+	timesheet.EXPECT().
+		List(gomock.Any(), &harvest.TimeEntryListOptions{UserID: harvest.Int64(1782959)}).
+		Return(&harvest.TimeEntryList{
+			TimeEntries: []*harvest.TimeEntry{
+				{Hours: harvest.HoursP(2.11)},
+				{Hours: harvest.HoursP(1.39)},
+				{},
+			},
+		}, nil, nil)
+
+	got, err := totalHours(context.Background(), client, 1782959)
+	assert.NoError(t, err)
+	assert.InDelta(t, 3.5, got.Float64(), 1e-9)
+}
+
+func TestMockInterfaces(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	var (
+		_ harvest.ClientAPI    = harvestmock.NewMockClientAPI(ctrl)
+		_ harvest.CompanyAPI   = harvestmock.NewMockCompanyAPI(ctrl)
+		_ harvest.EstimateAPI  = harvestmock.NewMockEstimateAPI(ctrl)
+		_ harvest.ExpenseAPI   = harvestmock.NewMockExpenseAPI(ctrl)
+		_ harvest.InvoiceAPI   = harvestmock.NewMockInvoiceAPI(ctrl)
+		_ harvest.ProjectAPI   = harvestmock.NewMockProjectAPI(ctrl)
+		_ harvest.RoleAPI      = harvestmock.NewMockRoleAPI(ctrl)
+		_ harvest.TaskAPI      = harvestmock.NewMockTaskAPI(ctrl)
+		_ harvest.TimesheetAPI = harvestmock.NewMockTimesheetAPI(ctrl)
+		_ harvest.UserAPI      = harvestmock.NewMockUserAPI(ctrl)
+	)
+}
@@ -0,0 +1,191 @@
+package harvesttest
+
+import (
+	"net/http"
+
+	"github.com/becoded/go-harvest/harvest"
+)
+
+// AddClient stores c, assigning an ID and timestamps, and returns a copy of
+// the stored client. IsActive defaults to true.
+func (s *Server) AddClient(c *harvest.Client) *harvest.Client {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return clone(s.addClient(clone(c)))
+}
+
+func (s *Server) addClient(c *harvest.Client) *harvest.Client {
+	now := s.timestamp()
+
+	c.ID = harvest.Int64(s.nextID())
+	if c.IsActive == nil {
+		c.IsActive = harvest.Bool(true)
+	}
+
+	if c.CreatedAt == nil {
+		c.CreatedAt = &now
+	}
+
+	c.UpdatedAt = &now
+	s.clients.put(*c.ID, c)
+
+	return c
+}
+
+// clientRef returns the id/name summary Harvest embeds in other resources.
+func (s *Server) clientRef(id int64) (*harvest.Client, bool) {
+	c, ok := s.clients.get(id)
+	if !ok {
+		return nil, false
+	}
+
+	return &harvest.Client{ID: c.ID, Name: c.Name, Currency: c.Currency}, true
+}
+
+func (s *Server) clientRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+BasePath+"clients", s.listClients)
+	mux.HandleFunc("POST "+BasePath+"clients", s.createClient)
+	mux.HandleFunc("GET "+BasePath+"clients/{id}", s.getClient)
+	mux.HandleFunc("PATCH "+BasePath+"clients/{id}", s.updateClient)
+	mux.HandleFunc("DELETE "+BasePath+"clients/{id}", s.deleteClient)
+}
+
+func (s *Server) listClients(w http.ResponseWriter, r *http.Request) {
+	f := newFilter(r)
+	isActive := f.boolean("is_active")
+	updatedSince := f.time("updated_since")
+
+	if f.err != nil {
+		writeValidation(w, f.err)
+
+		return
+	}
+
+	s.mu.Lock()
+	items := s.clients.list(func(c *harvest.Client) bool {
+		return matchBool(isActive, c.IsActive) && matchUpdatedSince(updatedSince, c.UpdatedAt)
+	})
+	s.mu.Unlock()
+
+	page, p, err := paginate(r, items)
+	if err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, harvest.ClientList{Clients: page, Pagination: p})
+}
+
+func (s *Server) getClient(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	c, found := s.clients.get(id)
+	c = clone(c)
+	s.mu.Unlock()
+
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, c)
+}
+
+func (s *Server) createClient(w http.ResponseWriter, r *http.Request) {
+	var req harvest.ClientCreateRequest
+	if err := decode(r, &req); err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	if req.Name == nil || *req.Name == "" {
+		writeValidation(w, required("name"))
+
+		return
+	}
+
+	s.mu.Lock()
+	c := clone(s.addClient(&harvest.Client{
+		Name:     req.Name,
+		IsActive: req.IsActive,
+		Address:  req.Address,
+		Currency: req.Currency,
+	}))
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusCreated, c)
+}
+
+func (s *Server) updateClient(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req harvest.ClientUpdateRequest
+	if err := decode(r, &req); err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, found := s.clients.get(id)
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	setIfNotNil(&c.Name, req.Name)
+	setIfNotNil(&c.IsActive, req.IsActive)
+	setIfNotNil(&c.Address, req.Address)
+	setIfNotNil(&c.Currency, req.Currency)
+	c.UpdatedAt = harvest.TimeTimeP(s.timestamp())
+
+	writeJSON(w, http.StatusOK, c)
+}
+
+func (s *Server) deleteClient(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, found := s.clients.get(id); !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	for _, p := range s.projects.rows {
+		if p.Client.GetID() == id {
+			writeError(w, http.StatusUnprocessableEntity, "Client has projects and can't be deleted")
+
+			return
+		}
+	}
+
+	s.clients.delete(id)
+	w.WriteHeader(http.StatusOK)
+}
+
+func setIfNotNil[T any](dst **T, src *T) {
+	if src != nil {
+		v := *src
+		*dst = &v
+	}
+}
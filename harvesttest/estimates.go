@@ -0,0 +1,282 @@
+package harvesttest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/becoded/go-harvest/harvest"
+)
+
+// Estimate states as reported by Harvest.
+const (
+	EstimateStateDraft    = "draft"
+	EstimateStateSent     = "sent"
+	EstimateStateAccepted = "accepted"
+	EstimateStateDeclined = "declined"
+)
+
+var estimateTransitions = map[string]transition{ //nolint: gochecknoglobals
+	"send":    {from: []string{EstimateStateDraft, EstimateStateSent}, to: EstimateStateSent},
+	"accept":  {from: []string{EstimateStateSent}, to: EstimateStateAccepted},
+	"decline": {from: []string{EstimateStateSent}, to: EstimateStateDeclined},
+	"re-open": {from: []string{EstimateStateAccepted, EstimateStateDeclined}, to: EstimateStateSent},
+}
+
+// AddEstimate stores e, assigning an ID and timestamps, and returns a copy of
+// the stored estimate. The client library can't create estimates, so tests
+// seed them here. State defaults to draft and amounts are computed from the
+// line items.
+func (s *Server) AddEstimate(e *harvest.Estimate) *harvest.Estimate {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e = clone(e)
+	now := s.timestamp()
+
+	e.ID = harvest.Int64(s.nextID())
+	if e.State == nil {
+		e.State = harvest.String(EstimateStateDraft)
+	}
+
+	if e.Number == nil {
+		e.Number = harvest.String(strconv.FormatInt(*e.ID, 10))
+	}
+
+	if e.CreatedAt == nil {
+		e.CreatedAt = &now
+	}
+
+	e.UpdatedAt = &now
+	computeEstimateAmounts(e)
+	s.estimates.put(*e.ID, e)
+
+	return clone(e)
+}
+
+func (s *Server) estimateRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+BasePath+"estimates", s.listEstimates)
+	mux.HandleFunc("GET "+BasePath+"estimates/{id}", s.getEstimate)
+
+	mux.HandleFunc("GET "+BasePath+"estimates/{id}/messages", s.listEstimateMessages)
+	mux.HandleFunc("POST "+BasePath+"estimates/{id}/messages", s.createEstimateMessage)
+	mux.HandleFunc("DELETE "+BasePath+"estimates/{id}/messages/{messageID}", s.deleteEstimateMessage)
+}
+
+func (s *Server) listEstimates(w http.ResponseWriter, r *http.Request) {
+	f := newFilter(r)
+	clientID := f.id("client_id")
+	updatedSince := f.time("updated_since")
+	state := f.str("state")
+
+	if f.err != nil {
+		writeValidation(w, f.err)
+
+		return
+	}
+
+	s.mu.Lock()
+	items := s.estimates.list(func(e *harvest.Estimate) bool {
+		return matchID(clientID, e.Client.GetID()) &&
+			matchUpdatedSince(updatedSince, e.UpdatedAt) &&
+			(state == "" || e.GetState() == state)
+	})
+	s.mu.Unlock()
+
+	page, p, err := paginate(r, items)
+	if err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, harvest.EstimateList{Estimates: page, Pagination: p})
+}
+
+func (s *Server) getEstimate(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	e, found := s.estimates.get(id)
+	e = clone(e)
+	s.mu.Unlock()
+
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, e)
+}
+
+func (s *Server) listEstimateMessages(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	_, found := s.estimates.get(id)
+	messages := make([]*harvest.EstimateMessage, 0, len(s.estimateMessages[id]))
+
+	for n := len(s.estimateMessages[id]) - 1; n >= 0; n-- {
+		messages = append(messages, clone(s.estimateMessages[id][n]))
+	}
+	s.mu.Unlock()
+
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	page, p, err := paginate(r, messages)
+	if err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, harvest.EstimateMessageList{EstimateMessages: page, Pagination: p})
+}
+
+func (s *Server) createEstimateMessage(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req messageRequest
+	if err := decode(r, &req); err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, found := s.estimates.get(id)
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	event := "send"
+	if req.EventType != nil {
+		event = *req.EventType
+	} else if req.Recipients == nil || len(*req.Recipients) == 0 {
+		writeValidation(w, required("recipients"))
+
+		return
+	}
+
+	t, ok := estimateTransitions[event]
+	if !ok || !t.allowed(e.GetState()) {
+		writeValidation(w, fmt.Errorf("event_type %q %w", event, errTransition))
+
+		return
+	}
+
+	now := s.timestamp()
+	e.State = harvest.String(t.to)
+	e.UpdatedAt = &now
+
+	switch event {
+	case "send":
+		e.SentAt = &now
+	case "accept":
+		e.AcceptedAt = &now
+	case "decline":
+		e.DeclinedAt = &now
+	case "re-open":
+		e.AcceptedAt = nil
+		e.DeclinedAt = nil
+	}
+
+	m := &harvest.EstimateMessage{
+		ID:        harvest.Int64(s.nextID()),
+		EventType: req.EventType,
+		Subject:   req.Subject,
+		Body:      req.Body,
+		CreatedAt: &now,
+		UpdatedAt: &now,
+	}
+
+	if req.Recipients != nil {
+		recipients := make([]harvest.EstimateMessageRecipient, 0, len(*req.Recipients))
+		for _, rcpt := range *req.Recipients {
+			recipients = append(recipients, harvest.EstimateMessageRecipient{Name: rcpt.Name, Email: rcpt.Email})
+		}
+
+		m.Recipients = &recipients
+	}
+
+	s.estimateMessages[id] = append(s.estimateMessages[id], m)
+
+	writeJSON(w, http.StatusCreated, m)
+}
+
+func (s *Server) deleteEstimateMessage(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	messageID, ok := pathID(w, r, "messageID")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	messages := s.estimateMessages[id]
+	for n, m := range messages {
+		if m.GetID() == messageID {
+			s.estimateMessages[id] = append(messages[:n], messages[n+1:]...)
+			w.WriteHeader(http.StatusOK)
+
+			return
+		}
+	}
+
+	writeNotFound(w)
+}
+
+func computeEstimateAmounts(e *harvest.Estimate) {
+	const percent = 100
+
+	var subtotal, taxed, taxed2 float64
+
+	if e.LineItems != nil {
+		for n := range *e.LineItems {
+			item := &(*e.LineItems)[n]
+			amount := float64(item.GetQuantity()) * item.GetUnitPrice()
+			item.Amount = harvest.Float64(amount)
+			subtotal += amount
+
+			if item.GetTaxed() {
+				taxed += amount
+			}
+
+			if item.GetTaxed2() {
+				taxed2 += amount
+			}
+		}
+	}
+
+	discount := subtotal * e.GetDiscount() / percent
+	tax := taxed * e.GetTax() / percent
+	tax2 := taxed2 * e.GetTax2() / percent
+
+	e.DiscountAmount = harvest.Float64(discount)
+	e.TaxAmount = harvest.Float64(tax)
+	e.Tax2Amount = harvest.Float64(tax2)
+	e.Amount = harvest.Float64(subtotal - discount + tax + tax2)
+}
@@ -0,0 +1,461 @@
+package harvesttest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/becoded/go-harvest/harvest"
+)
+
+// AddExpenseCategory stores c, assigning an ID and timestamps, and returns a
+// copy of the stored expense category. IsActive defaults to true.
+func (s *Server) AddExpenseCategory(c *harvest.ExpenseCategory) *harvest.ExpenseCategory {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return clone(s.addExpenseCategory(clone(c)))
+}
+
+func (s *Server) addExpenseCategory(c *harvest.ExpenseCategory) *harvest.ExpenseCategory {
+	now := s.timestamp()
+
+	c.ID = harvest.Int64(s.nextID())
+	if c.IsActive == nil {
+		c.IsActive = harvest.Bool(true)
+	}
+
+	if c.CreatedAt == nil {
+		c.CreatedAt = &now
+	}
+
+	c.UpdatedAt = &now
+	s.expenseCategories.put(*c.ID, c)
+
+	return c
+}
+
+// AddExpense stores e as is, assigning an ID and timestamps, and returns a
+// copy of the stored expense. Like AddTimeEntry it performs no validation.
+func (s *Server) AddExpense(e *harvest.Expense) *harvest.Expense {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e = clone(e)
+	now := s.timestamp()
+
+	e.ID = harvest.Int64(s.nextID())
+	if e.CreatedAt == nil {
+		e.CreatedAt = &now
+	}
+
+	e.UpdatedAt = &now
+	s.expenses.put(*e.ID, e)
+
+	return clone(e)
+}
+
+func (s *Server) expenseRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+BasePath+"expense_categories", s.listExpenseCategories)
+	mux.HandleFunc("POST "+BasePath+"expense_categories", s.createExpenseCategory)
+	mux.HandleFunc("GET "+BasePath+"expense_categories/{id}", s.getExpenseCategory)
+	mux.HandleFunc("PATCH "+BasePath+"expense_categories/{id}", s.updateExpenseCategory)
+	mux.HandleFunc("DELETE "+BasePath+"expense_categories/{id}", s.deleteExpenseCategory)
+
+	mux.HandleFunc("GET "+BasePath+"expenses", s.listExpenses)
+	mux.HandleFunc("POST "+BasePath+"expenses", s.createExpense)
+	mux.HandleFunc("GET "+BasePath+"expenses/{id}", s.getExpense)
+	mux.HandleFunc("PATCH "+BasePath+"expenses/{id}", s.updateExpense)
+	mux.HandleFunc("DELETE "+BasePath+"expenses/{id}", s.deleteExpense)
+}
+
+func (s *Server) listExpenseCategories(w http.ResponseWriter, r *http.Request) {
+	f := newFilter(r)
+	isActive := f.boolean("is_active")
+	updatedSince := f.time("updated_since")
+
+	if f.err != nil {
+		writeValidation(w, f.err)
+
+		return
+	}
+
+	s.mu.Lock()
+	items := s.expenseCategories.list(func(c *harvest.ExpenseCategory) bool {
+		return matchBool(isActive, c.IsActive) && matchUpdatedSince(updatedSince, c.UpdatedAt)
+	})
+	s.mu.Unlock()
+
+	page, p, err := paginate(r, items)
+	if err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, harvest.ExpenseCategoryList{ExpenseCategories: page, Pagination: p})
+}
+
+func (s *Server) getExpenseCategory(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	c, found := s.expenseCategories.get(id)
+	c = clone(c)
+	s.mu.Unlock()
+
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, c)
+}
+
+func (s *Server) createExpenseCategory(w http.ResponseWriter, r *http.Request) {
+	var req harvest.ExpenseCategoryRequest
+	if err := decode(r, &req); err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	if req.Name == nil || *req.Name == "" {
+		writeValidation(w, required("name"))
+
+		return
+	}
+
+	s.mu.Lock()
+	c := clone(s.addExpenseCategory(&harvest.ExpenseCategory{
+		Name:      req.Name,
+		UnitName:  req.UnitName,
+		UnitPrice: req.UnitPrice,
+		IsActive:  req.IsActive,
+	}))
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusCreated, c)
+}
+
+func (s *Server) updateExpenseCategory(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req harvest.ExpenseCategoryRequest
+	if err := decode(r, &req); err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, found := s.expenseCategories.get(id)
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	setIfNotNil(&c.Name, req.Name)
+	setIfNotNil(&c.UnitName, req.UnitName)
+	setIfNotNil(&c.UnitPrice, req.UnitPrice)
+	setIfNotNil(&c.IsActive, req.IsActive)
+	c.UpdatedAt = harvest.TimeTimeP(s.timestamp())
+
+	writeJSON(w, http.StatusOK, c)
+}
+
+func (s *Server) deleteExpenseCategory(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.expenseCategories.delete(id) {
+		writeNotFound(w)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) listExpenses(w http.ResponseWriter, r *http.Request) {
+	f := newFilter(r)
+	userID := f.id("user_id")
+	clientID := f.id("client_id")
+	projectID := f.id("project_id")
+	isBilled := f.boolean("is_billed")
+	updatedSince := f.time("updated_since")
+	from := f.date("from")
+	to := f.date("to")
+
+	if f.err != nil {
+		writeValidation(w, f.err)
+
+		return
+	}
+
+	s.mu.Lock()
+	items := s.expenses.list(func(e *harvest.Expense) bool {
+		return matchID(userID, e.User.GetID()) &&
+			matchID(clientID, e.Client.GetID()) &&
+			matchID(projectID, e.Project.GetID()) &&
+			matchBool(isBilled, e.IsBilled) &&
+			matchUpdatedSince(updatedSince, e.UpdatedAt) &&
+			matchDateRange(from, to, e.SpentDate)
+	})
+	s.mu.Unlock()
+
+	page, p, err := paginate(r, items)
+	if err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, harvest.ExpenseList{Expenses: page, Pagination: p})
+}
+
+func (s *Server) getExpense(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	e, found := s.expenses.get(id)
+	e = clone(e)
+	s.mu.Unlock()
+
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, e)
+}
+
+func (s *Server) createExpense(w http.ResponseWriter, r *http.Request) {
+	var req harvest.ExpenseCreateRequest
+	if err := decode(r, &req); err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	switch {
+	case req.ProjectID == nil:
+		writeValidation(w, required("project_id"))
+
+		return
+	case req.ExpenseCategoryID == nil:
+		writeValidation(w, required("expense_category_id"))
+
+		return
+	case req.SpentDate == nil:
+		writeValidation(w, required("spent_date"))
+
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	userID := s.currentUserID
+	if req.UserID != nil {
+		userID = *req.UserID
+	}
+
+	now := s.timestamp()
+	e := &harvest.Expense{
+		SpentDate: req.SpentDate,
+		Notes:     req.Notes,
+		Billable:  req.Billable,
+		IsClosed:  harvest.Bool(false),
+		IsLocked:  harvest.Bool(false),
+		IsBilled:  harvest.Bool(false),
+		CreatedAt: &now,
+		UpdatedAt: &now,
+	}
+
+	if e.Billable == nil {
+		e.Billable = harvest.Bool(true)
+	}
+
+	if err := s.assignExpense(e, userID, *req.ProjectID, *req.ExpenseCategoryID); err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	s.applyCost(e, req.Units, req.TotalCost)
+
+	e.ID = harvest.Int64(s.nextID())
+	s.expenses.put(*e.ID, e)
+
+	writeJSON(w, http.StatusCreated, e)
+}
+
+func (s *Server) updateExpense(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req harvest.ExpenseUpdateRequest
+	if err := decode(r, &req); err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, found := s.expenses.get(id)
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	if e.GetIsLocked() {
+		writeValidation(w, errLocked)
+
+		return
+	}
+
+	if req.ProjectID != nil || req.ExpenseCategoryID != nil {
+		projectID, categoryID := e.Project.GetID(), e.ExpenseCategory.GetID()
+		if req.ProjectID != nil {
+			projectID = *req.ProjectID
+		}
+
+		if req.ExpenseCategoryID != nil {
+			categoryID = *req.ExpenseCategoryID
+		}
+
+		updated := clone(e)
+		if err := s.assignExpense(updated, e.User.GetID(), projectID, categoryID); err != nil {
+			writeValidation(w, err)
+
+			return
+		}
+
+		*e = *updated
+	}
+
+	setIfNotNil(&e.SpentDate, req.SpentDate)
+	setIfNotNil(&e.Notes, req.Notes)
+	setIfNotNil(&e.Billable, req.Billable)
+
+	if req.Units != nil || req.TotalCost != nil || req.ExpenseCategoryID != nil {
+		s.applyCost(e, req.Units, req.TotalCost)
+	}
+
+	if req.DeleteReceipt != nil && *req.DeleteReceipt {
+		e.Receipt = nil
+	}
+
+	e.UpdatedAt = harvest.TimeTimeP(s.timestamp())
+
+	writeJSON(w, http.StatusOK, e)
+}
+
+func (s *Server) deleteExpense(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, found := s.expenses.get(id)
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	if e.GetIsLocked() {
+		writeValidation(w, errLocked)
+
+		return
+	}
+
+	s.expenses.delete(id)
+	w.WriteHeader(http.StatusOK)
+}
+
+// assignExpense validates the project, expense category and user of an
+// expense and fills in the related objects of e.
+func (s *Server) assignExpense(e *harvest.Expense, userID, projectID, categoryID int64) error {
+	project, ok := s.projects.get(projectID)
+	if !ok {
+		return unknown("project")
+	}
+
+	if !project.GetIsActive() {
+		return fmt.Errorf("project %w", errArchived)
+	}
+
+	category, ok := s.expenseCategories.get(categoryID)
+	if !ok || !category.GetIsActive() {
+		return unknown("expense category")
+	}
+
+	user, ok := s.userRef(userID)
+	if !ok {
+		return unknown("user")
+	}
+
+	ua, ok := s.findUserAssignment(projectID, userID)
+	if !ok || !ua.GetIsActive() {
+		return fmt.Errorf("user %w", errNotAssigned)
+	}
+
+	e.User = user
+	e.Client = clone(project.Client)
+	e.Project, _ = s.projectRef(projectID)
+	e.ExpenseCategory = &harvest.ExpenseCategory{
+		ID:        category.ID,
+		Name:      category.Name,
+		UnitName:  category.UnitName,
+		UnitPrice: category.UnitPrice,
+	}
+	e.UserAssignment = clone(ua)
+
+	return nil
+}
+
+// applyCost sets units and total cost. For unit based categories the total
+// cost is computed from the unit price, as Harvest does.
+func (s *Server) applyCost(e *harvest.Expense, units *int64, totalCost *float64) {
+	if units != nil {
+		e.Units = harvest.Float64(float64(*units))
+	}
+
+	if price := e.ExpenseCategory.GetUnitPrice(); price != 0 && e.Units != nil {
+		e.TotalCost = harvest.Float64(*e.Units * price)
+
+		return
+	}
+
+	if totalCost != nil {
+		e.TotalCost = harvest.Float64(*totalCost)
+	}
+}
@@ -0,0 +1,144 @@
+package harvesttest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/becoded/go-harvest/harvest"
+)
+
+var errInvalidParam = errors.New("is invalid")
+
+// filter reads the list filters of a request. The first parse error is kept
+// in err so handlers can check it once after reading all parameters.
+type filter struct {
+	q   url.Values
+	err error
+}
+
+func newFilter(r *http.Request) *filter {
+	return &filter{q: r.URL.Query()}
+}
+
+func (f *filter) fail(name string) {
+	if f.err == nil {
+		f.err = fmt.Errorf("%s %w", name, errInvalidParam)
+	}
+}
+
+func (f *filter) boolean(name string) *bool {
+	v := f.q.Get(name)
+	if v == "" {
+		return nil
+	}
+
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		f.fail(name)
+
+		return nil
+	}
+
+	return &b
+}
+
+func (f *filter) id(name string) *int64 {
+	v := f.q.Get(name)
+	if v == "" {
+		return nil
+	}
+
+	id, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		f.fail(name)
+
+		return nil
+	}
+
+	return &id
+}
+
+func (f *filter) time(name string) *time.Time {
+	v := f.q.Get(name)
+	if v == "" {
+		return nil
+	}
+
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		f.fail(name)
+
+		return nil
+	}
+
+	return &t
+}
+
+func (f *filter) date(name string) *time.Time {
+	v := f.q.Get(name)
+	if v == "" {
+		return nil
+	}
+
+	t, err := time.ParseInLocation("2006-01-02", v, time.UTC)
+	if err != nil {
+		f.fail(name)
+
+		return nil
+	}
+
+	return &t
+}
+
+func (f *filter) str(name string) string {
+	return f.q.Get(name)
+}
+
+func intParam(q url.Values, name string, def int) (int, error) {
+	v := q.Get(name)
+	if v == "" {
+		return def, nil
+	}
+
+	return strconv.Atoi(v)
+}
+
+func matchBool(want, got *bool) bool {
+	return want == nil || (got != nil && *got == *want)
+}
+
+func matchID(want *int64, got int64) bool {
+	return want == nil || *want == got
+}
+
+func matchUpdatedSince(since, updatedAt *time.Time) bool {
+	return since == nil || (updatedAt != nil && !updatedAt.Before(*since))
+}
+
+func matchDateRange(from, to *time.Time, d *harvest.Date) bool {
+	if from == nil && to == nil {
+		return true
+	}
+
+	if d == nil {
+		return false
+	}
+
+	day := dayOf(d.Time)
+
+	if from != nil && day.Before(*from) {
+		return false
+	}
+
+	return to == nil || !day.After(*to)
+}
+
+// dayOf returns the calendar day of t at midnight UTC, dropping the location
+// harvest.Date parses dates in.
+func dayOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
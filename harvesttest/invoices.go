@@ -0,0 +1,507 @@
+package harvesttest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/becoded/go-harvest/harvest"
+)
+
+var errTransition = errors.New("is not allowed in the current state")
+
+// Invoice states as reported by Harvest.
+const (
+	InvoiceStateDraft  = "draft"
+	InvoiceStateOpen   = "open"
+	InvoiceStatePaid   = "paid"
+	InvoiceStateClosed = "closed"
+)
+
+// messageRequest is the union of the invoice and estimate message bodies. The
+// event_type field of harvest.InvoiceMessageCreateRequest is mistyped, so the
+// fake decodes messages itself.
+type messageRequest struct {
+	EventType  *string                            `json:"event_type"`
+	Recipients *[]harvest.InvoiceMessageRecipient `json:"recipients"`
+	Subject    *string                            `json:"subject"`
+	Body       *string                            `json:"body"`
+}
+
+// transition describes a state change triggered by a message event type.
+type transition struct {
+	from []string
+	to   string
+}
+
+var invoiceTransitions = map[string]transition{ //nolint: gochecknoglobals
+	"send":    {from: []string{InvoiceStateDraft, InvoiceStateOpen}, to: InvoiceStateOpen},
+	"close":   {from: []string{InvoiceStateOpen}, to: InvoiceStateClosed},
+	"re-open": {from: []string{InvoiceStateClosed}, to: InvoiceStateOpen},
+	"draft":   {from: []string{InvoiceStateOpen}, to: InvoiceStateDraft},
+}
+
+func (t transition) allowed(state string) bool {
+	for _, from := range t.from {
+		if from == state {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Server) invoiceRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+BasePath+"invoices", s.listInvoices)
+	mux.HandleFunc("POST "+BasePath+"invoices", s.createInvoice)
+	mux.HandleFunc("GET "+BasePath+"invoices/{id}", s.getInvoice)
+	mux.HandleFunc("PATCH "+BasePath+"invoices/{id}", s.updateInvoice)
+	mux.HandleFunc("DELETE "+BasePath+"invoices/{id}", s.deleteInvoice)
+
+	mux.HandleFunc("GET "+BasePath+"invoices/{id}/messages", s.listInvoiceMessages)
+	mux.HandleFunc("POST "+BasePath+"invoices/{id}/messages", s.createInvoiceMessage)
+	mux.HandleFunc("DELETE "+BasePath+"invoices/{id}/messages/{messageID}", s.deleteInvoiceMessage)
+}
+
+func (s *Server) listInvoices(w http.ResponseWriter, r *http.Request) {
+	f := newFilter(r)
+	clientID := f.id("client_id")
+	projectID := f.id("project_id")
+	updatedSince := f.time("updated_since")
+	state := f.str("state")
+
+	if f.err != nil {
+		writeValidation(w, f.err)
+
+		return
+	}
+
+	s.mu.Lock()
+	items := s.invoices.list(func(i *harvest.Invoice) bool {
+		return matchID(clientID, i.Client.GetID()) &&
+			(projectID == nil || invoiceHasProject(i, *projectID)) &&
+			matchUpdatedSince(updatedSince, i.UpdatedAt) &&
+			(state == "" || i.GetState() == state)
+	})
+	s.mu.Unlock()
+
+	page, p, err := paginate(r, items)
+	if err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, harvest.InvoiceList{Invoices: page, Pagination: p})
+}
+
+func (s *Server) getInvoice(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	i, found := s.invoices.get(id)
+	i = clone(i)
+	s.mu.Unlock()
+
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, i)
+}
+
+func (s *Server) createInvoice(w http.ResponseWriter, r *http.Request) {
+	var req harvest.InvoiceCreateRequest
+	if err := decode(r, &req); err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	if req.ClientID == nil {
+		writeValidation(w, required("client_id"))
+
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	client, ok := s.clientRef(*req.ClientID)
+	if !ok {
+		writeValidation(w, unknown("client"))
+
+		return
+	}
+
+	now := s.timestamp()
+	i := &harvest.Invoice{
+		ID:             harvest.Int64(s.nextID()),
+		Client:         client,
+		Creator:        &harvest.User{ID: harvest.Int64(s.currentUserID)},
+		Number:         req.Number,
+		PurchaseOrder:  req.PurchaseOrder,
+		Tax:            req.Tax,
+		Tax2:           req.Tax2,
+		Discount:       req.Discount,
+		Subject:        req.Subject,
+		Notes:          req.Notes,
+		Currency:       req.Currency,
+		IssueDate:      req.IssueDate,
+		DueDate:        req.DueDate,
+		PaymentTerm:    req.PaymentTerm,
+		PaymentOptions: req.PaymentOptions,
+		State:          harvest.String(InvoiceStateDraft),
+		CreatedAt:      &now,
+		UpdatedAt:      &now,
+	}
+
+	if i.Number == nil {
+		i.Number = harvest.String(strconv.FormatInt(*i.ID, 10))
+	}
+
+	if i.Currency == nil {
+		i.Currency = client.Currency
+	}
+
+	if req.LineItems != nil {
+		if err := s.applyLineItems(i, *req.LineItems); err != nil {
+			writeValidation(w, err)
+
+			return
+		}
+	}
+
+	computeInvoiceAmounts(i)
+	s.invoices.put(*i.ID, i)
+
+	writeJSON(w, http.StatusCreated, i)
+}
+
+func (s *Server) updateInvoice(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req harvest.InvoiceUpdateRequest
+	if err := decode(r, &req); err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i, found := s.invoices.get(id)
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	updated := clone(i)
+
+	if req.ClientID != nil {
+		client, ok := s.clientRef(*req.ClientID)
+		if !ok {
+			writeValidation(w, unknown("client"))
+
+			return
+		}
+
+		updated.Client = client
+	}
+
+	setIfNotNil(&updated.Number, req.Number)
+	setIfNotNil(&updated.PurchaseOrder, req.PurchaseOrder)
+	setIfNotNil(&updated.Tax, req.Tax)
+	setIfNotNil(&updated.Tax2, req.Tax2)
+	setIfNotNil(&updated.Discount, req.Discount)
+	setIfNotNil(&updated.Subject, req.Subject)
+	setIfNotNil(&updated.Notes, req.Notes)
+	setIfNotNil(&updated.Currency, req.Currency)
+	setIfNotNil(&updated.IssueDate, req.IssueDate)
+	setIfNotNil(&updated.DueDate, req.DueDate)
+
+	if req.LineItems != nil {
+		if err := s.applyLineItems(updated, *req.LineItems); err != nil {
+			writeValidation(w, err)
+
+			return
+		}
+	}
+
+	computeInvoiceAmounts(updated)
+	updated.UpdatedAt = harvest.TimeTimeP(s.timestamp())
+	*i = *updated
+
+	writeJSON(w, http.StatusOK, i)
+}
+
+func (s *Server) deleteInvoice(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.invoices.delete(id) {
+		writeNotFound(w)
+
+		return
+	}
+
+	delete(s.invoiceMessages, id)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) listInvoiceMessages(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	_, found := s.invoices.get(id)
+	messages := make([]*harvest.InvoiceMessage, 0, len(s.invoiceMessages[id]))
+
+	for n := len(s.invoiceMessages[id]) - 1; n >= 0; n-- {
+		messages = append(messages, clone(s.invoiceMessages[id][n]))
+	}
+	s.mu.Unlock()
+
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	page, p, err := paginate(r, messages)
+	if err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, harvest.InvoiceMessageList{InvoiceMessages: page, Pagination: p})
+}
+
+func (s *Server) createInvoiceMessage(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req messageRequest
+	if err := decode(r, &req); err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i, found := s.invoices.get(id)
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	event := "send"
+	if req.EventType != nil {
+		event = *req.EventType
+	} else if req.Recipients == nil || len(*req.Recipients) == 0 {
+		writeValidation(w, required("recipients"))
+
+		return
+	}
+
+	t, ok := invoiceTransitions[event]
+	if !ok || !t.allowed(i.GetState()) {
+		writeValidation(w, fmt.Errorf("event_type %q %w", event, errTransition))
+
+		return
+	}
+
+	now := s.timestamp()
+	i.State = harvest.String(t.to)
+	i.UpdatedAt = &now
+
+	switch event {
+	case "send":
+		i.SentAt = &now
+	case "close":
+		i.ClosedAt = &now
+	case "re-open":
+		i.ClosedAt = nil
+	case "draft":
+		i.SentAt = nil
+	}
+
+	m := &harvest.InvoiceMessage{
+		ID:         harvest.Int64(s.nextID()),
+		EventType:  req.EventType,
+		Recipients: req.Recipients,
+		Subject:    req.Subject,
+		Body:       req.Body,
+		CreatedAt:  &now,
+		UpdatedAt:  &now,
+	}
+	s.invoiceMessages[id] = append(s.invoiceMessages[id], m)
+
+	writeJSON(w, http.StatusCreated, m)
+}
+
+func (s *Server) deleteInvoiceMessage(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	messageID, ok := pathID(w, r, "messageID")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	messages := s.invoiceMessages[id]
+	for n, m := range messages {
+		if m.GetID() == messageID {
+			s.invoiceMessages[id] = append(messages[:n], messages[n+1:]...)
+			w.WriteHeader(http.StatusOK)
+
+			return
+		}
+	}
+
+	writeNotFound(w)
+}
+
+// applyLineItems creates, updates and removes line items of i. Items with an
+// ID update the existing item, items with _destroy remove it.
+func (s *Server) applyLineItems(i *harvest.Invoice, reqs []harvest.InvoiceLineItemRequest) error {
+	var items []harvest.InvoiceLineItem
+	if i.LineItems != nil {
+		items = append(items, *i.LineItems...)
+	}
+
+	for _, req := range reqs {
+		idx := -1
+
+		for n := range items {
+			if req.ID != nil && items[n].GetID() == *req.ID {
+				idx = n
+			}
+		}
+
+		if req.ID != nil && idx < 0 {
+			return unknown("line item")
+		}
+
+		if req.Destroy != nil && *req.Destroy {
+			if idx >= 0 {
+				items = append(items[:idx], items[idx+1:]...)
+			}
+
+			continue
+		}
+
+		if idx < 0 {
+			if req.Kind == nil {
+				return required("kind")
+			}
+
+			items = append(items, harvest.InvoiceLineItem{ID: harvest.Int64(s.nextID())})
+			idx = len(items) - 1
+		}
+
+		item := &items[idx]
+		setIfNotNil(&item.Kind, req.Kind)
+		setIfNotNil(&item.Description, req.Description)
+		setIfNotNil(&item.UnitPrice, req.UnitPrice)
+		setIfNotNil(&item.Taxed, req.Taxed)
+		setIfNotNil(&item.Taxed2, req.Taxed2)
+
+		if req.Quantity != nil {
+			item.Quantity = harvest.Float64(float64(*req.Quantity))
+		}
+
+		if req.ProjectID != nil {
+			project, ok := s.projectRef(*req.ProjectID)
+			if !ok {
+				return unknown("project")
+			}
+
+			item.Project = project
+		}
+	}
+
+	i.LineItems = &items
+
+	return nil
+}
+
+// computeInvoiceAmounts derives line item amounts, taxes, discount and the
+// invoice total the way Harvest does.
+func computeInvoiceAmounts(i *harvest.Invoice) {
+	const percent = 100
+
+	var subtotal, taxed, taxed2 float64
+
+	if i.LineItems != nil {
+		for n := range *i.LineItems {
+			item := &(*i.LineItems)[n]
+			amount := item.GetQuantity() * item.GetUnitPrice()
+			item.Amount = harvest.Float64(amount)
+			subtotal += amount
+
+			if item.GetTaxed() {
+				taxed += amount
+			}
+
+			if item.GetTaxed2() {
+				taxed2 += amount
+			}
+		}
+	}
+
+	discount := subtotal * i.GetDiscount() / percent
+	tax := taxed * i.GetTax() / percent
+	tax2 := taxed2 * i.GetTax2() / percent
+	amount := subtotal - discount + tax + tax2
+
+	i.DiscountAmount = harvest.Float64(discount)
+	i.TaxAmount = harvest.Float64(tax)
+	i.Tax2Amount = harvest.Float64(tax2)
+	i.Amount = harvest.Float64(amount)
+
+	if i.GetState() == InvoiceStatePaid {
+		i.DueAmount = harvest.Float64(0)
+	} else {
+		i.DueAmount = harvest.Float64(amount)
+	}
+}
+
+func invoiceHasProject(i *harvest.Invoice, projectID int64) bool {
+	for _, item := range i.GetLineItems() {
+		if item.Project.GetID() == projectID {
+			return true
+		}
+	}
+
+	return false
+}
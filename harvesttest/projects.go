@@ -0,0 +1,648 @@
+package harvesttest
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/becoded/go-harvest/harvest"
+)
+
+var errArchived = errors.New("is archived")
+
+// projectRequest is the body of project create and update requests. The
+// harvest package has no project mutation calls, so it is defined here.
+type projectRequest struct {
+	ClientID   *int64   `json:"client_id"`
+	Name       *string  `json:"name"`
+	Code       *string  `json:"code,omitempty"`
+	IsActive   *bool    `json:"is_active,omitempty"`
+	IsBillable *bool    `json:"is_billable,omitempty"`
+	BillBy     *string  `json:"bill_by,omitempty"`
+	BudgetBy   *string  `json:"budget_by,omitempty"`
+	HourlyRate *float64 `json:"hourly_rate,omitempty"`
+	Budget     *float64 `json:"budget,omitempty"`
+	Notes      *string  `json:"notes,omitempty"`
+}
+
+// userAssignmentRequest is the body of user assignment create requests.
+type userAssignmentRequest struct {
+	UserID           *int64   `json:"user_id"`
+	IsActive         *bool    `json:"is_active,omitempty"`
+	IsProjectManager *bool    `json:"is_project_manager,omitempty"`
+	HourlyRate       *float64 `json:"hourly_rate,omitempty"`
+	Budget           *float64 `json:"budget,omitempty"`
+}
+
+// AddProject stores p, assigning an ID and timestamps, and returns a copy of
+// the stored project. When p.Client carries the ID of a known client, it is
+// replaced by that client's summary. IsActive and IsBillable default to true.
+func (s *Server) AddProject(p *harvest.Project) *harvest.Project {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return clone(s.addProject(clone(p)))
+}
+
+func (s *Server) addProject(p *harvest.Project) *harvest.Project {
+	now := s.timestamp()
+
+	p.ID = harvest.Int64(s.nextID())
+	if ref, ok := s.clientRef(p.Client.GetID()); ok {
+		p.Client = ref
+	}
+
+	if p.IsActive == nil {
+		p.IsActive = harvest.Bool(true)
+	}
+
+	if p.IsBillable == nil {
+		p.IsBillable = harvest.Bool(true)
+	}
+
+	if p.CreatedAt == nil {
+		p.CreatedAt = &now
+	}
+
+	p.UpdatedAt = &now
+	s.projects.put(*p.ID, p)
+
+	return p
+}
+
+// AssignTask adds the task to the project and returns the task assignment.
+func (s *Server) AssignTask(projectID, taskID int64) *harvest.ProjectTaskAssignment {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, err := s.addTaskAssignment(projectID, &harvest.ProjectTaskAssignmentCreateRequest{TaskID: &taskID})
+	if err != nil {
+		panic(err)
+	}
+
+	return clone(a)
+}
+
+// AssignUser adds the user to the project and returns the user assignment.
+func (s *Server) AssignUser(projectID, userID int64) *harvest.ProjectUserAssignment {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, err := s.addUserAssignment(projectID, &userAssignmentRequest{UserID: &userID})
+	if err != nil {
+		panic(err)
+	}
+
+	return clone(a)
+}
+
+// SetTaskAssignmentActive archives or activates a task assignment.
+func (s *Server) SetTaskAssignmentActive(taskAssignmentID int64, active bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if a, ok := s.taskAssignments.get(taskAssignmentID); ok {
+		a.IsActive = harvest.Bool(active)
+		a.UpdatedAt = harvest.TimeTimeP(s.timestamp())
+	}
+}
+
+// projectRef returns the id/name/code summary Harvest embeds in other resources.
+func (s *Server) projectRef(id int64) (*harvest.Project, bool) {
+	p, ok := s.projects.get(id)
+	if !ok {
+		return nil, false
+	}
+
+	return &harvest.Project{ID: p.ID, Name: p.Name, Code: p.Code}, true
+}
+
+func (s *Server) addTaskAssignment(
+	projectID int64,
+	req *harvest.ProjectTaskAssignmentCreateRequest,
+) (*harvest.ProjectTaskAssignment, error) {
+	project, ok := s.projectRef(projectID)
+	if !ok {
+		return nil, unknown("project")
+	}
+
+	if req.TaskID == nil {
+		return nil, required("task_id")
+	}
+
+	task, ok := s.taskRef(*req.TaskID)
+	if !ok {
+		return nil, unknown("task")
+	}
+
+	now := s.timestamp()
+	a := &harvest.ProjectTaskAssignment{
+		ID:         harvest.Int64(s.nextID()),
+		Project:    project,
+		Task:       task,
+		IsActive:   harvest.Bool(true),
+		Billable:   harvest.Bool(false),
+		HourlyRate: req.HourlyRate,
+		Budget:     req.Budget,
+		CreatedAt:  &now,
+		UpdatedAt:  &now,
+	}
+	setIfNotNil(&a.IsActive, req.IsActive)
+	setIfNotNil(&a.Billable, req.Billable)
+
+	s.taskAssignments.put(*a.ID, a)
+
+	return a, nil
+}
+
+func (s *Server) addUserAssignment(projectID int64, req *userAssignmentRequest) (*harvest.ProjectUserAssignment, error) {
+	project, ok := s.projectRef(projectID)
+	if !ok {
+		return nil, unknown("project")
+	}
+
+	if req.UserID == nil {
+		return nil, required("user_id")
+	}
+
+	user, ok := s.userRef(*req.UserID)
+	if !ok {
+		return nil, unknown("user")
+	}
+
+	now := s.timestamp()
+	a := &harvest.ProjectUserAssignment{
+		ID:               harvest.Int64(s.nextID()),
+		Project:          project,
+		User:             user,
+		IsActive:         harvest.Bool(true),
+		IsProjectManager: harvest.Bool(false),
+		HourlyRate:       req.HourlyRate,
+		Budget:           req.Budget,
+		CreatedAt:        &now,
+		UpdatedAt:        &now,
+	}
+	setIfNotNil(&a.IsActive, req.IsActive)
+	setIfNotNil(&a.IsProjectManager, req.IsProjectManager)
+
+	s.userAssignments.put(*a.ID, a)
+
+	return a, nil
+}
+
+// findTaskAssignment returns the task assignment of taskID on projectID.
+func (s *Server) findTaskAssignment(projectID, taskID int64) (*harvest.ProjectTaskAssignment, bool) {
+	for _, a := range s.taskAssignments.rows {
+		if a.Project.GetID() == projectID && a.Task.GetID() == taskID {
+			return a, true
+		}
+	}
+
+	return nil, false
+}
+
+// findUserAssignment returns the user assignment of userID on projectID.
+func (s *Server) findUserAssignment(projectID, userID int64) (*harvest.ProjectUserAssignment, bool) {
+	for _, a := range s.userAssignments.rows {
+		if a.Project.GetID() == projectID && a.User.GetID() == userID {
+			return a, true
+		}
+	}
+
+	return nil, false
+}
+
+func (s *Server) projectRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+BasePath+"projects", s.listProjects)
+	mux.HandleFunc("POST "+BasePath+"projects", s.createProject)
+	mux.HandleFunc("GET "+BasePath+"projects/{id}", s.getProject)
+	mux.HandleFunc("PATCH "+BasePath+"projects/{id}", s.updateProject)
+	mux.HandleFunc("DELETE "+BasePath+"projects/{id}", s.deleteProject)
+
+	mux.HandleFunc("GET "+BasePath+"projects/{id}/task_assignments", s.listTaskAssignments)
+	mux.HandleFunc("POST "+BasePath+"projects/{id}/task_assignments", s.createTaskAssignment)
+	mux.HandleFunc("GET "+BasePath+"projects/{id}/task_assignments/{assignment}", s.getTaskAssignment)
+	mux.HandleFunc("DELETE "+BasePath+"projects/{id}/task_assignments/{assignment}", s.deleteTaskAssignment)
+
+	mux.HandleFunc("GET "+BasePath+"projects/{id}/user_assignments", s.listUserAssignments)
+	mux.HandleFunc("POST "+BasePath+"projects/{id}/user_assignments", s.createUserAssignment)
+	mux.HandleFunc("GET "+BasePath+"projects/{id}/user_assignments/{assignment}", s.getUserAssignment)
+	mux.HandleFunc("DELETE "+BasePath+"projects/{id}/user_assignments/{assignment}", s.deleteUserAssignment)
+}
+
+func (s *Server) listProjects(w http.ResponseWriter, r *http.Request) {
+	f := newFilter(r)
+	isActive := f.boolean("is_active")
+	clientID := f.id("client_id")
+	updatedSince := f.time("updated_since")
+
+	if f.err != nil {
+		writeValidation(w, f.err)
+
+		return
+	}
+
+	s.mu.Lock()
+	items := s.projects.list(func(p *harvest.Project) bool {
+		return matchBool(isActive, p.IsActive) &&
+			matchID(clientID, p.Client.GetID()) &&
+			matchUpdatedSince(updatedSince, p.UpdatedAt)
+	})
+	s.mu.Unlock()
+
+	page, p, err := paginate(r, items)
+	if err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, harvest.ProjectList{Projects: page, Pagination: p})
+}
+
+func (s *Server) getProject(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	p, found := s.projects.get(id)
+	p = clone(p)
+	s.mu.Unlock()
+
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, p)
+}
+
+func (s *Server) createProject(w http.ResponseWriter, r *http.Request) {
+	var req projectRequest
+	if err := decode(r, &req); err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	switch {
+	case req.ClientID == nil:
+		writeValidation(w, required("client_id"))
+
+		return
+	case req.Name == nil || *req.Name == "":
+		writeValidation(w, required("name"))
+
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.clients.get(*req.ClientID); !ok {
+		writeValidation(w, unknown("client"))
+
+		return
+	}
+
+	p := s.addProject(&harvest.Project{
+		Client:     &harvest.Client{ID: req.ClientID},
+		Name:       req.Name,
+		Code:       req.Code,
+		IsActive:   req.IsActive,
+		IsBillable: req.IsBillable,
+		BillBy:     req.BillBy,
+		BudgetBy:   req.BudgetBy,
+		HourlyRate: req.HourlyRate,
+		Budget:     req.Budget,
+		Notes:      req.Notes,
+	})
+
+	writeJSON(w, http.StatusCreated, p)
+}
+
+func (s *Server) updateProject(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req projectRequest
+	if err := decode(r, &req); err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, found := s.projects.get(id)
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	if req.ClientID != nil {
+		ref, ok := s.clientRef(*req.ClientID)
+		if !ok {
+			writeValidation(w, unknown("client"))
+
+			return
+		}
+
+		p.Client = ref
+	}
+
+	setIfNotNil(&p.Name, req.Name)
+	setIfNotNil(&p.Code, req.Code)
+	setIfNotNil(&p.IsActive, req.IsActive)
+	setIfNotNil(&p.IsBillable, req.IsBillable)
+	setIfNotNil(&p.BillBy, req.BillBy)
+	setIfNotNil(&p.BudgetBy, req.BudgetBy)
+	setIfNotNil(&p.HourlyRate, req.HourlyRate)
+	setIfNotNil(&p.Budget, req.Budget)
+	setIfNotNil(&p.Notes, req.Notes)
+	p.UpdatedAt = harvest.TimeTimeP(s.timestamp())
+
+	writeJSON(w, http.StatusOK, p)
+}
+
+func (s *Server) deleteProject(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.projects.delete(id) {
+		writeNotFound(w)
+
+		return
+	}
+
+	for aid, a := range s.taskAssignments.rows {
+		if a.Project.GetID() == id {
+			s.taskAssignments.delete(aid)
+		}
+	}
+
+	for aid, a := range s.userAssignments.rows {
+		if a.Project.GetID() == id {
+			s.userAssignments.delete(aid)
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) listTaskAssignments(w http.ResponseWriter, r *http.Request) {
+	projectID, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	f := newFilter(r)
+	isActive := f.boolean("is_active")
+	updatedSince := f.time("updated_since")
+
+	if f.err != nil {
+		writeValidation(w, f.err)
+
+		return
+	}
+
+	s.mu.Lock()
+	_, found := s.projects.get(projectID)
+	items := s.taskAssignments.list(func(a *harvest.ProjectTaskAssignment) bool {
+		return a.Project.GetID() == projectID &&
+			matchBool(isActive, a.IsActive) &&
+			matchUpdatedSince(updatedSince, a.UpdatedAt)
+	})
+	s.mu.Unlock()
+
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	page, p, err := paginate(r, items)
+	if err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, harvest.ProjectTaskAssignmentList{TaskAssignments: page, Pagination: p})
+}
+
+func (s *Server) getTaskAssignment(w http.ResponseWriter, r *http.Request) {
+	projectID, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	id, ok := pathID(w, r, "assignment")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	a, found := s.taskAssignments.get(id)
+	a = clone(a)
+	s.mu.Unlock()
+
+	if !found || a.Project.GetID() != projectID {
+		writeNotFound(w)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, a)
+}
+
+func (s *Server) createTaskAssignment(w http.ResponseWriter, r *http.Request) {
+	projectID, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req harvest.ProjectTaskAssignmentCreateRequest
+	if err := decode(r, &req); err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, found := s.projects.get(projectID); !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	a, err := s.addTaskAssignment(projectID, &req)
+	if err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, a)
+}
+
+func (s *Server) deleteTaskAssignment(w http.ResponseWriter, r *http.Request) {
+	projectID, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	id, ok := pathID(w, r, "assignment")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, found := s.taskAssignments.get(id)
+	if !found || a.Project.GetID() != projectID {
+		writeNotFound(w)
+
+		return
+	}
+
+	s.taskAssignments.delete(id)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) listUserAssignments(w http.ResponseWriter, r *http.Request) {
+	projectID, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	f := newFilter(r)
+	isActive := f.boolean("is_active")
+	updatedSince := f.time("updated_since")
+
+	if f.err != nil {
+		writeValidation(w, f.err)
+
+		return
+	}
+
+	s.mu.Lock()
+	_, found := s.projects.get(projectID)
+	items := s.userAssignments.list(func(a *harvest.ProjectUserAssignment) bool {
+		return a.Project.GetID() == projectID &&
+			matchBool(isActive, a.IsActive) &&
+			matchUpdatedSince(updatedSince, a.UpdatedAt)
+	})
+	s.mu.Unlock()
+
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	page, p, err := paginate(r, items)
+	if err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, harvest.ProjectUserAssignmentList{UserAssignments: page, Pagination: p})
+}
+
+func (s *Server) getUserAssignment(w http.ResponseWriter, r *http.Request) {
+	projectID, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	id, ok := pathID(w, r, "assignment")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	a, found := s.userAssignments.get(id)
+	a = clone(a)
+	s.mu.Unlock()
+
+	if !found || a.Project.GetID() != projectID {
+		writeNotFound(w)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, a)
+}
+
+func (s *Server) createUserAssignment(w http.ResponseWriter, r *http.Request) {
+	projectID, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req userAssignmentRequest
+	if err := decode(r, &req); err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, found := s.projects.get(projectID); !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	a, err := s.addUserAssignment(projectID, &req)
+	if err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, a)
+}
+
+func (s *Server) deleteUserAssignment(w http.ResponseWriter, r *http.Request) {
+	projectID, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	id, ok := pathID(w, r, "assignment")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, found := s.userAssignments.get(id)
+	if !found || a.Project.GetID() != projectID {
+		writeNotFound(w)
+
+		return
+	}
+
+	s.userAssignments.delete(id)
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,422 @@
+// Package harvesttest provides an in-memory fake of the Harvest API v2 for
+// integration tests.
+//
+// The Server keeps clients, projects, tasks, users, assignments, time
+// entries, expenses, invoices and estimates in memory and serves them over
+// HTTP the way the real API does, including pagination, list filters,
+// validation errors and (optionally) rate limiting:
+//
+//	srv := harvesttest.NewServer()
+//	defer srv.Close()
+//
+//	client := srv.Client()
+//	acme := srv.AddClient(&harvest.Client{Name: harvest.String("Acme")})
+//	list, _, err := client.Client.List(ctx, &harvest.ClientListOptions{})
+package harvesttest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/becoded/go-harvest/harvest"
+)
+
+const (
+	// BasePath is the path prefix under which the fake API is served.
+	BasePath = "/v2/"
+	// AccountID is the account ID configured on clients returned by Server.Client.
+	AccountID = "harvesttest"
+
+	defaultPerPage = 100
+	maxPerPage     = 2000
+)
+
+var (
+	errMissingField = errors.New("can't be blank")
+	errNotFound     = errors.New("not found")
+)
+
+// Server is a stateful fake Harvest API server. It is safe for concurrent use.
+type Server struct {
+	srv *httptest.Server
+
+	mu            sync.Mutex
+	now           func() time.Time
+	lastID        int64
+	currentUserID int64
+	company       *harvest.Company
+
+	clients           table[harvest.Client]
+	projects          table[harvest.Project]
+	tasks             table[harvest.Task]
+	users             table[harvest.User]
+	taskAssignments   table[harvest.ProjectTaskAssignment]
+	userAssignments   table[harvest.ProjectUserAssignment]
+	timeEntries       table[harvest.TimeEntry]
+	expenseCategories table[harvest.ExpenseCategory]
+	expenses          table[harvest.Expense]
+	invoices          table[harvest.Invoice]
+	estimates         table[harvest.Estimate]
+	invoiceMessages   map[int64][]*harvest.InvoiceMessage
+	estimateMessages  map[int64][]*harvest.EstimateMessage
+
+	rateLimit    int
+	rateWindow   time.Duration
+	requestTimes []time.Time
+	requestCount int
+}
+
+// NewServer starts a fake Harvest API server. It is seeded with a company
+// and a current user (the user returned by users/me). Callers must Close it.
+func NewServer() *Server {
+	s := &Server{
+		now:              time.Now,
+		invoiceMessages:  map[int64][]*harvest.InvoiceMessage{},
+		estimateMessages: map[int64][]*harvest.EstimateMessage{},
+	}
+
+	now := s.timestamp()
+	s.company = &harvest.Company{
+		Name:               harvest.String("Harvest Test"),
+		IsActive:           harvest.Bool(true),
+		WeekStartDay:       harvest.String("Monday"),
+		TimeFormat:         harvest.String(harvest.TimeFormatDecimal),
+		Clock:              harvest.String("24h"),
+		DecimalSymbol:      harvest.String("."),
+		ThousandsSeparator: harvest.String(","),
+		ExpenseFeature:     harvest.Bool(true),
+		InvoiceFeature:     harvest.Bool(true),
+		EstimateFeature:    harvest.Bool(true),
+	}
+
+	me := s.addUser(&harvest.User{
+		FirstName: harvest.String("Test"),
+		LastName:  harvest.String("User"),
+		Email:     harvest.String("test@example.com"),
+		Timezone:  harvest.String("Etc/UTC"),
+		IsAdmin:   harvest.Bool(true),
+		CreatedAt: &now,
+	})
+	s.currentUserID = *me.ID
+
+	s.srv = httptest.NewServer(s.routes())
+
+	return s
+}
+
+// URL returns the base URL of the fake API, including the trailing slash.
+func (s *Server) URL() string {
+	return s.srv.URL + BasePath
+}
+
+// Client returns a harvest.APIClient configured to talk to the server.
+func (s *Server) Client() *harvest.APIClient {
+	c := harvest.NewAPIClient(s.srv.Client())
+
+	u, err := url.Parse(s.URL())
+	if err != nil {
+		panic(err)
+	}
+
+	c.BaseURL = u
+	c.AccountID = AccountID
+
+	return c
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// SetClock replaces the clock used for created_at, updated_at and timers.
+func (s *Server) SetClock(now func() time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.now = now
+}
+
+// SetRateLimit makes the server answer with 429 Too Many Requests and a
+// Retry-After header once more than requests calls were made within window.
+// Harvest allows 100 requests per 15 seconds. A zero value disables the limit.
+func (s *Server) SetRateLimit(requests int, window time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rateLimit = requests
+	s.rateWindow = window
+	s.requestTimes = nil
+}
+
+// RequestCount returns the number of API requests the server has received.
+func (s *Server) RequestCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requestCount
+}
+
+// SetCompany replaces the company settings returned by the company endpoint.
+func (s *Server) SetCompany(c *harvest.Company) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cp := *c
+	s.company = &cp
+}
+
+// CurrentUser returns the user the server treats as authenticated.
+func (s *Server) CurrentUser() *harvest.User {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, _ := s.users.get(s.currentUserID)
+
+	return clone(u)
+}
+
+// SetCurrentUser changes the authenticated user to the user with the given ID.
+func (s *Server) SetCurrentUser(userID int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.currentUserID = userID
+}
+
+func (s *Server) routes() http.Handler {
+	mux := http.NewServeMux()
+
+	s.clientRoutes(mux)
+	s.projectRoutes(mux)
+	s.taskRoutes(mux)
+	s.userRoutes(mux)
+	s.timeEntryRoutes(mux)
+	s.expenseRoutes(mux)
+	s.invoiceRoutes(mux)
+	s.estimateRoutes(mux)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if retryAfter, limited := s.throttle(); limited {
+			w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+			writeError(w, http.StatusTooManyRequests, "API rate limit exceeded")
+
+			return
+		}
+
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// throttle records a request and reports whether it exceeds the rate limit,
+// together with the number of seconds to wait.
+func (s *Server) throttle() (int, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requestCount++
+
+	if s.rateLimit <= 0 {
+		return 0, false
+	}
+
+	now := s.now()
+	cutoff := now.Add(-s.rateWindow)
+
+	kept := s.requestTimes[:0]
+
+	for _, t := range s.requestTimes {
+		if t.After(cutoff) {
+			kept = append(kept, t)
+		}
+	}
+
+	s.requestTimes = kept
+
+	if len(s.requestTimes) >= s.rateLimit {
+		wait := s.requestTimes[0].Add(s.rateWindow).Sub(now)
+
+		return int(math.Max(1, math.Ceil(wait.Seconds()))), true
+	}
+
+	s.requestTimes = append(s.requestTimes, now)
+
+	return 0, false
+}
+
+func (s *Server) nextID() int64 {
+	s.lastID++
+
+	return s.lastID
+}
+
+func (s *Server) timestamp() time.Time {
+	return s.now().UTC().Truncate(time.Second)
+}
+
+// table is an in-memory collection of records keyed by ID.
+type table[T any] struct {
+	rows map[int64]*T
+}
+
+func (t *table[T]) put(id int64, v *T) {
+	if t.rows == nil {
+		t.rows = map[int64]*T{}
+	}
+
+	t.rows[id] = v
+}
+
+func (t *table[T]) get(id int64) (*T, bool) {
+	v, ok := t.rows[id]
+
+	return v, ok
+}
+
+func (t *table[T]) delete(id int64) bool {
+	_, ok := t.rows[id]
+	delete(t.rows, id)
+
+	return ok
+}
+
+// list returns clones of the records accepted by keep, newest (highest ID) first.
+func (t *table[T]) list(keep func(*T) bool) []*T {
+	ids := make([]int64, 0, len(t.rows))
+	for id := range t.rows {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] > ids[j] })
+
+	out := make([]*T, 0, len(ids))
+
+	for _, id := range ids {
+		if keep == nil || keep(t.rows[id]) {
+			out = append(out, clone(t.rows[id]))
+		}
+	}
+
+	return out
+}
+
+func clone[T any](v *T) *T {
+	if v == nil {
+		return nil
+	}
+
+	cp := *v
+
+	return &cp
+}
+
+// paginate slices items according to the page and per_page query parameters.
+func paginate[T any](r *http.Request, items []*T) ([]*T, harvest.Pagination, error) {
+	q := r.URL.Query()
+
+	page, err := intParam(q, "page", 1)
+	if err != nil || page < 1 {
+		return nil, harvest.Pagination{}, fmt.Errorf("page: %w", errInvalidParam)
+	}
+
+	perPage, err := intParam(q, "per_page", defaultPerPage)
+	if err != nil || perPage < 1 || perPage > maxPerPage {
+		return nil, harvest.Pagination{}, fmt.Errorf("per_page: %w", errInvalidParam)
+	}
+
+	total := len(items)
+	totalPages := (total + perPage - 1) / perPage
+
+	start := min((page-1)*perPage, total)
+	end := min(start+perPage, total)
+
+	p := harvest.Pagination{
+		PerPage:      harvest.Int(perPage),
+		TotalPages:   harvest.Int(totalPages),
+		TotalEntries: harvest.Int(total),
+		Page:         harvest.Int(page),
+		Links: &harvest.PageLinks{
+			First: harvest.String(pageLink(r, 1, perPage)),
+			Last:  harvest.String(pageLink(r, max(totalPages, 1), perPage)),
+		},
+	}
+
+	if page < totalPages {
+		p.NextPage = harvest.Int(page + 1)
+		p.Links.Next = harvest.String(pageLink(r, page+1, perPage))
+	}
+
+	if page > 1 {
+		p.PreviousPage = harvest.Int(page - 1)
+		p.Links.Previous = harvest.String(pageLink(r, page-1, perPage))
+	}
+
+	return items[start:end], p, nil
+}
+
+func pageLink(r *http.Request, page, perPage int) string {
+	u := url.URL{Scheme: "http", Host: r.Host, Path: r.URL.Path}
+	q := r.URL.Query()
+	q.Set("page", strconv.Itoa(page))
+	q.Set("per_page", strconv.Itoa(perPage))
+	u.RawQuery = q.Encode()
+
+	return u.String()
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", harvest.DefaultMediaType)
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"message": message})
+}
+
+func writeNotFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "Not Found")
+}
+
+// writeValidation answers with 422 Unprocessable Entity, as Harvest does for
+// invalid request bodies.
+func writeValidation(w http.ResponseWriter, err error) {
+	writeError(w, http.StatusUnprocessableEntity, err.Error())
+}
+
+func decode(r *http.Request, v interface{}) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return fmt.Errorf("invalid JSON body: %w", err)
+	}
+
+	return nil
+}
+
+func pathID(w http.ResponseWriter, r *http.Request, name string) (int64, bool) {
+	id, err := strconv.ParseInt(r.PathValue(name), 10, 64)
+	if err != nil {
+		writeNotFound(w)
+
+		return 0, false
+	}
+
+	return id, true
+}
+
+func required(field string) error {
+	return fmt.Errorf("%s %w", field, errMissingField)
+}
+
+func unknown(resource string) error {
+	return fmt.Errorf("%s %w", resource, errNotFound)
+}
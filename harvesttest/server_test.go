@@ -0,0 +1,408 @@
+package harvesttest_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/becoded/go-harvest/harvest"
+	"github.com/becoded/go-harvest/harvesttest"
+)
+
+// fixture seeds a project with one task that the current user is assigned to.
+type fixture struct {
+	srv     *harvesttest.Server
+	client  *harvest.APIClient
+	project *harvest.Project
+	task    *harvest.Task
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+
+	srv := harvesttest.NewServer()
+	t.Cleanup(srv.Close)
+
+	acme := srv.AddClient(&harvest.Client{Name: harvest.String("Acme"), Currency: harvest.String("EUR")})
+	project := srv.AddProject(&harvest.Project{
+		Client: acme,
+		Name:   harvest.String("Website"),
+		Code:   harvest.String("WEB"),
+	})
+	task := srv.AddTask(&harvest.Task{Name: harvest.String("Development")})
+
+	srv.AssignTask(project.GetID(), task.GetID())
+	srv.AssignUser(project.GetID(), srv.CurrentUser().GetID())
+
+	return &fixture{srv: srv, client: srv.Client(), project: project, task: task}
+}
+
+func date(s string) *harvest.Date {
+	d, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+
+	return &harvest.Date{Time: d}
+}
+
+func TestServer_Clients(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := harvesttest.NewServer()
+
+	defer srv.Close()
+
+	client := srv.Client()
+
+	created, resp, err := client.Client.Create(ctx, &harvest.ClientCreateRequest{Name: harvest.String("Acme")})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, "Acme", created.GetName())
+	assert.True(t, created.GetIsActive())
+
+	updated, _, err := client.Client.Update(ctx, created.GetID(), &harvest.ClientUpdateRequest{
+		IsActive: harvest.Bool(false),
+	})
+	assert.NoError(t, err)
+	assert.False(t, updated.GetIsActive())
+	assert.Equal(t, "Acme", updated.GetName())
+
+	got, _, err := client.Client.Get(ctx, created.GetID())
+	assert.NoError(t, err)
+	assert.False(t, got.GetIsActive())
+
+	_, err = client.Client.Delete(ctx, created.GetID())
+	assert.NoError(t, err)
+
+	_, resp, err = client.Client.Get(ctx, created.GetID())
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestServer_Validation(t *testing.T) {
+	t.Parallel()
+
+	srv := harvesttest.NewServer()
+	defer srv.Close()
+
+	// The typed request bodies are validated client-side, so send an
+	// untyped one to reach the server's validation.
+	ctx := context.Background()
+	client := srv.Client()
+
+	req, err := client.NewRequest(ctx, "POST", "clients", map[string]string{})
+	assert.NoError(t, err)
+
+	resp, err := client.Do(ctx, req, nil)
+
+	var errResp *harvest.ErrorResponse
+
+	assert.ErrorAs(t, err, &errResp)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	assert.Equal(t, "name can't be blank", errResp.Message)
+}
+
+func TestServer_Pagination(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := harvesttest.NewServer()
+
+	defer srv.Close()
+
+	for range 5 {
+		srv.AddTask(&harvest.Task{Name: harvest.String("Task")})
+	}
+
+	opt := &harvest.TaskListOptions{ListOptions: harvest.ListOptions{Page: 1, PerPage: 2}}
+
+	var ids []int64
+
+	for {
+		list, _, err := srv.Client().Task.List(ctx, opt)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, list.GetTotalEntries())
+		assert.Equal(t, 3, list.GetTotalPages())
+
+		for _, task := range list.Tasks {
+			ids = append(ids, task.GetID())
+		}
+
+		if list.NextPage == nil {
+			break
+		}
+
+		opt.Page = *list.NextPage
+	}
+
+	assert.Len(t, ids, 5)
+	assert.Greater(t, ids[0], ids[4], "newest first")
+}
+
+func TestServer_TimeEntries(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newFixture(t)
+
+	entry, _, err := f.client.Timesheet.CreateTimeEntryViaDuration(ctx, &harvest.TimeEntryCreateViaDuration{
+		ProjectID: f.project.ID,
+		TaskID:    f.task.ID,
+		SpentDate: date("2024-03-04"),
+		Hours:     harvest.HoursP(1.5),
+		Notes:     harvest.String("Homepage"),
+	})
+	assert.NoError(t, err)
+	assert.InDelta(t, 1.5, entry.GetHours().Float64(), 0.001)
+	assert.False(t, entry.GetIsRunning())
+	assert.Equal(t, "Acme", entry.Client.GetName())
+	assert.Equal(t, "Website", entry.Project.GetName())
+	assert.Equal(t, "Development", entry.Task.GetName())
+	assert.Equal(t, f.srv.CurrentUser().GetID(), entry.User.GetID())
+
+	_, _, err = f.client.Timesheet.CreateTimeEntryViaDuration(ctx, &harvest.TimeEntryCreateViaDuration{
+		ProjectID: f.project.ID,
+		TaskID:    f.task.ID,
+		SpentDate: date("2024-03-11"),
+		Hours:     harvest.HoursP(2),
+	})
+	assert.NoError(t, err)
+
+	list, _, err := f.client.Timesheet.List(ctx, &harvest.TimeEntryListOptions{
+		From: date("2024-03-04"),
+		To:   date("2024-03-10"),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, list.TimeEntries, 1)
+	assert.Equal(t, entry.GetID(), list.TimeEntries[0].GetID())
+
+	updated, _, err := f.client.Timesheet.UpdateTimeEntry(ctx, entry.GetID(), &harvest.TimeEntryUpdate{
+		Notes: harvest.String("Landing page"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Landing page", updated.GetNotes())
+	assert.Equal(t, f.project.GetID(), updated.Project.GetID())
+}
+
+func TestServer_ExternalReference(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newFixture(t)
+
+	ref, err := harvest.IssueReference("https://github.com/becoded/go-harvest/issues/42")
+	assert.NoError(t, err)
+
+	entry, _, err := f.client.Timesheet.CreateTimeEntryViaDuration(ctx, &harvest.TimeEntryCreateViaDuration{
+		ProjectID:         f.project.ID,
+		TaskID:            f.task.ID,
+		SpentDate:         date("2024-03-04"),
+		Hours:             harvest.HoursP(1.5),
+		ExternalReference: ref,
+	})
+	assert.NoError(t, err)
+
+	found, _, err := f.client.Timesheet.ListByExternalReference(ctx, nil, "becoded/go-harvest#42")
+	assert.NoError(t, err)
+	assert.Len(t, found, 1)
+
+	_, err = f.client.Timesheet.DeleteExternalReference(ctx, entry.GetID())
+	assert.NoError(t, err)
+
+	entry, _, err = f.client.Timesheet.Get(ctx, entry.GetID())
+	assert.NoError(t, err)
+	assert.Nil(t, entry.ExternalReference)
+}
+
+func TestServer_TimeEntryNotAssigned(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newFixture(t)
+	other := f.srv.AddTask(&harvest.Task{Name: harvest.String("Design")})
+
+	_, resp, err := f.client.Timesheet.CreateTimeEntryViaDuration(ctx, &harvest.TimeEntryCreateViaDuration{
+		ProjectID: f.project.ID,
+		TaskID:    other.ID,
+		SpentDate: date("2024-03-04"),
+		Hours:     harvest.HoursP(1),
+	})
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+}
+
+func TestServer_Timers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newFixture(t)
+
+	now := time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC)
+	f.srv.SetClock(func() time.Time { return now })
+
+	first, _, err := f.client.Timesheet.CreateTimeEntryViaDuration(ctx, &harvest.TimeEntryCreateViaDuration{
+		ProjectID: f.project.ID,
+		TaskID:    f.task.ID,
+		SpentDate: date("2024-03-04"),
+	})
+	assert.NoError(t, err)
+	assert.True(t, first.GetIsRunning())
+
+	now = now.Add(90 * time.Minute)
+
+	second, _, err := f.client.Timesheet.CreateTimeEntryViaDuration(ctx, &harvest.TimeEntryCreateViaDuration{
+		ProjectID: f.project.ID,
+		TaskID:    f.task.ID,
+		SpentDate: date("2024-03-04"),
+	})
+	assert.NoError(t, err)
+	assert.True(t, second.GetIsRunning())
+
+	first, _, err = f.client.Timesheet.Get(ctx, first.GetID())
+	assert.NoError(t, err)
+	assert.False(t, first.GetIsRunning(), "starting a timer stops the running one")
+	assert.InDelta(t, 1.5, first.GetHours().Float64(), 0.001)
+
+	now = now.Add(30 * time.Minute)
+
+	second, _, err = f.client.Timesheet.StopTimeEntry(ctx, second.GetID())
+	assert.NoError(t, err)
+	assert.False(t, second.GetIsRunning())
+	assert.InDelta(t, 0.5, second.GetHours().Float64(), 0.001)
+
+	_, resp, err := f.client.Timesheet.StopTimeEntry(ctx, second.GetID())
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+	first, _, err = f.client.Timesheet.RestartTimeEntry(ctx, first.GetID())
+	assert.NoError(t, err)
+	assert.True(t, first.GetIsRunning())
+}
+
+func TestServer_Expenses(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newFixture(t)
+	mileage := f.srv.AddExpenseCategory(&harvest.ExpenseCategory{
+		Name:      harvest.String("Mileage"),
+		UnitName:  harvest.String("mile"),
+		UnitPrice: harvest.Float64(0.5),
+	})
+
+	expense, _, err := f.client.Expense.Create(ctx, &harvest.ExpenseCreateRequest{
+		ProjectID:         f.project.ID,
+		ExpenseCategoryID: mileage.ID,
+		SpentDate:         date("2024-03-04"),
+		Units:             harvest.Int64(40),
+	})
+	assert.NoError(t, err)
+	assert.InDelta(t, 20.0, expense.GetTotalCost(), 0.001)
+	assert.True(t, expense.GetBillable())
+
+	list, _, err := f.client.Expense.List(ctx, &harvest.ExpenseListOptions{ProjectID: f.project.ID})
+	assert.NoError(t, err)
+	assert.Len(t, list.Expenses, 1)
+}
+
+func TestServer_InvoiceStates(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newFixture(t)
+
+	invoice, _, err := f.client.Invoice.Create(ctx, &harvest.InvoiceCreateRequest{
+		ClientID: f.project.Client.ID,
+		Tax:      harvest.Float64(10),
+		LineItems: &[]harvest.InvoiceLineItemRequest{{
+			ProjectID: f.project.ID,
+			Kind:      harvest.String("Service"),
+			Quantity:  harvest.Int64(2),
+			UnitPrice: harvest.Float64(100),
+			Taxed:     harvest.Bool(true),
+		}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, harvesttest.InvoiceStateDraft, invoice.GetState())
+	assert.Equal(t, "EUR", invoice.GetCurrency())
+	assert.InDelta(t, 220.0, invoice.GetAmount(), 0.001)
+
+	tests := []struct {
+		name  string
+		event func(context.Context, int64) (*harvest.InvoiceMessage, *http.Response, error)
+		want  string
+	}{
+		{name: "sent", event: f.client.Invoice.MarkAsSent, want: harvesttest.InvoiceStateOpen},
+		{name: "closed", event: f.client.Invoice.MarkAsClosed, want: harvesttest.InvoiceStateClosed},
+		{name: "re-opened", event: f.client.Invoice.MarkAsReopen, want: harvesttest.InvoiceStateOpen},
+		{name: "draft", event: f.client.Invoice.MarkAsDraft, want: harvesttest.InvoiceStateDraft},
+	}
+
+	for _, tt := range tests {
+		_, _, err := tt.event(ctx, invoice.GetID())
+		assert.NoError(t, err, tt.name)
+
+		got, _, err := f.client.Invoice.Get(ctx, invoice.GetID())
+		assert.NoError(t, err)
+		assert.Equal(t, tt.want, got.GetState(), tt.name)
+	}
+
+	_, resp, err := f.client.Invoice.MarkAsClosed(ctx, invoice.GetID())
+	assert.Error(t, err, "a draft can't be closed")
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+	messages, _, err := f.client.Invoice.ListInvoiceMessages(ctx, invoice.GetID(), &harvest.InvoiceMessageListOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, messages.InvoiceMessages, 4)
+}
+
+func TestServer_EstimateStates(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := newFixture(t)
+	estimate := f.srv.AddEstimate(&harvest.Estimate{Client: f.project.Client})
+
+	_, _, err := f.client.Estimate.MarkAsSent(ctx, estimate.GetID())
+	assert.NoError(t, err)
+
+	_, _, err = f.client.Estimate.MarkAsAccepted(ctx, estimate.GetID())
+	assert.NoError(t, err)
+
+	got, _, err := f.client.Estimate.Get(ctx, estimate.GetID())
+	assert.NoError(t, err)
+	assert.Equal(t, harvesttest.EstimateStateAccepted, got.GetState())
+	assert.NotNil(t, got.AcceptedAt)
+}
+
+func TestServer_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := harvesttest.NewServer()
+
+	defer srv.Close()
+
+	srv.SetRateLimit(2, 15*time.Second)
+
+	client := srv.Client()
+
+	for range 2 {
+		_, _, err := client.Company.Get(ctx)
+		assert.NoError(t, err)
+	}
+
+	_, _, err := client.Company.Get(ctx)
+
+	var rateErr *harvest.AbuseRateLimitError
+
+	assert.ErrorAs(t, err, &rateErr)
+	assert.NotNil(t, rateErr.RetryAfter)
+	assert.Positive(t, *rateErr.RetryAfter)
+	assert.Equal(t, 3, srv.RequestCount())
+}
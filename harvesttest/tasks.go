@@ -0,0 +1,185 @@
+package harvesttest
+
+import (
+	"net/http"
+
+	"github.com/becoded/go-harvest/harvest"
+)
+
+// AddTask stores t, assigning an ID and timestamps, and returns a copy of the
+// stored task. IsActive and BillableByDefault default to true.
+func (s *Server) AddTask(t *harvest.Task) *harvest.Task {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return clone(s.addTask(clone(t)))
+}
+
+func (s *Server) addTask(t *harvest.Task) *harvest.Task {
+	now := s.timestamp()
+
+	t.ID = harvest.Int64(s.nextID())
+	if t.IsActive == nil {
+		t.IsActive = harvest.Bool(true)
+	}
+
+	if t.BillableByDefault == nil {
+		t.BillableByDefault = harvest.Bool(true)
+	}
+
+	if t.IsDefault == nil {
+		t.IsDefault = harvest.Bool(false)
+	}
+
+	if t.CreatedAt == nil {
+		t.CreatedAt = &now
+	}
+
+	t.UpdatedAt = &now
+	s.tasks.put(*t.ID, t)
+
+	return t
+}
+
+// taskRef returns the id/name summary Harvest embeds in other resources.
+func (s *Server) taskRef(id int64) (*harvest.Task, bool) {
+	t, ok := s.tasks.get(id)
+	if !ok {
+		return nil, false
+	}
+
+	return &harvest.Task{ID: t.ID, Name: t.Name}, true
+}
+
+func (s *Server) taskRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+BasePath+"tasks", s.listTasks)
+	mux.HandleFunc("POST "+BasePath+"tasks", s.createTask)
+	mux.HandleFunc("GET "+BasePath+"tasks/{id}", s.getTask)
+	mux.HandleFunc("PATCH "+BasePath+"tasks/{id}", s.updateTask)
+	mux.HandleFunc("DELETE "+BasePath+"tasks/{id}", s.deleteTask)
+}
+
+func (s *Server) listTasks(w http.ResponseWriter, r *http.Request) {
+	f := newFilter(r)
+	isActive := f.boolean("is_active")
+	updatedSince := f.time("updated_since")
+
+	if f.err != nil {
+		writeValidation(w, f.err)
+
+		return
+	}
+
+	s.mu.Lock()
+	items := s.tasks.list(func(t *harvest.Task) bool {
+		return matchBool(isActive, t.IsActive) && matchUpdatedSince(updatedSince, t.UpdatedAt)
+	})
+	s.mu.Unlock()
+
+	page, p, err := paginate(r, items)
+	if err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, harvest.TaskList{Tasks: page, Pagination: p})
+}
+
+func (s *Server) getTask(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	t, found := s.tasks.get(id)
+	t = clone(t)
+	s.mu.Unlock()
+
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, t)
+}
+
+func (s *Server) createTask(w http.ResponseWriter, r *http.Request) {
+	var req harvest.TaskCreateRequest
+	if err := decode(r, &req); err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	if req.Name == nil || *req.Name == "" {
+		writeValidation(w, required("name"))
+
+		return
+	}
+
+	s.mu.Lock()
+	t := clone(s.addTask(&harvest.Task{
+		Name:              req.Name,
+		BillableByDefault: req.BillableByDefault,
+		DefaultHourlyRate: req.DefaultHourlyRate,
+		IsDefault:         req.IsDefault,
+		IsActive:          req.IsActive,
+	}))
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusCreated, t)
+}
+
+func (s *Server) updateTask(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req harvest.TaskUpdateRequest
+	if err := decode(r, &req); err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, found := s.tasks.get(id)
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	setIfNotNil(&t.Name, req.Name)
+	setIfNotNil(&t.BillableByDefault, req.BillableByDefault)
+	setIfNotNil(&t.DefaultHourlyRate, req.DefaultHourlyRate)
+	setIfNotNil(&t.IsDefault, req.IsDefault)
+	setIfNotNil(&t.IsActive, req.IsActive)
+	t.UpdatedAt = harvest.TimeTimeP(s.timestamp())
+
+	writeJSON(w, http.StatusOK, t)
+}
+
+func (s *Server) deleteTask(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.tasks.delete(id) {
+		writeNotFound(w)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,459 @@
+package harvesttest
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+
+	"github.com/becoded/go-harvest/harvest"
+)
+
+var (
+	errNotAssigned = errors.New("is not assigned to the project")
+	errNotRunning  = errors.New("time entry is not running")
+	errRunning     = errors.New("time entry is already running")
+	errLocked      = errors.New("is locked and can't be changed")
+)
+
+// timeEntryRequest is the union of the time entry create and update bodies.
+type timeEntryRequest struct {
+	UserID            *int64                     `json:"user_id"`
+	ProjectID         *int64                     `json:"project_id"`
+	TaskID            *int64                     `json:"task_id"`
+	SpentDate         *harvest.Date              `json:"spent_date"`
+	Hours             *harvest.Hours             `json:"hours"`
+	StartedTime       *harvest.Time              `json:"started_time"`
+	EndedTime         *harvest.Time              `json:"ended_time"`
+	Notes             *string                    `json:"notes"`
+	ExternalReference *harvest.ExternalReference `json:"external_reference"`
+}
+
+// AddTimeEntry stores e as is, assigning an ID and timestamps, and returns a
+// copy of the stored entry. Unlike the create endpoint it performs no
+// validation, which makes it suitable for seeding historical or locked data.
+func (s *Server) AddTimeEntry(e *harvest.TimeEntry) *harvest.TimeEntry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e = clone(e)
+	now := s.timestamp()
+
+	e.ID = harvest.Int64(s.nextID())
+	if e.CreatedAt == nil {
+		e.CreatedAt = &now
+	}
+
+	e.UpdatedAt = &now
+	s.timeEntries.put(*e.ID, e)
+
+	return clone(e)
+}
+
+// DeleteTimeEntry removes a time entry without going through the API, as if
+// it was deleted in the Harvest web interface.
+func (s *Server) DeleteTimeEntry(id int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.timeEntries.delete(id)
+}
+
+func (s *Server) timeEntryRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+BasePath+"time_entries", s.listTimeEntries)
+	mux.HandleFunc("POST "+BasePath+"time_entries", s.createTimeEntry)
+	mux.HandleFunc("GET "+BasePath+"time_entries/{id}", s.getTimeEntry)
+	mux.HandleFunc("PATCH "+BasePath+"time_entries/{id}", s.updateTimeEntry)
+	mux.HandleFunc("DELETE "+BasePath+"time_entries/{id}", s.deleteTimeEntry)
+	mux.HandleFunc("PATCH "+BasePath+"time_entries/{id}/stop", s.stopTimeEntry)
+	mux.HandleFunc("PATCH "+BasePath+"time_entries/{id}/restart", s.restartTimeEntry)
+	mux.HandleFunc("DELETE "+BasePath+"time_entries/{id}/external_reference", s.deleteExternalReference)
+}
+
+func (s *Server) listTimeEntries(w http.ResponseWriter, r *http.Request) {
+	f := newFilter(r)
+	userID := f.id("user_id")
+	clientID := f.id("client_id")
+	projectID := f.id("project_id")
+	taskID := f.id("task_id")
+	isBilled := f.boolean("is_billed")
+	isRunning := f.boolean("is_running")
+	updatedSince := f.time("updated_since")
+	from := f.date("from")
+	to := f.date("to")
+
+	if f.err != nil {
+		writeValidation(w, f.err)
+
+		return
+	}
+
+	s.mu.Lock()
+	items := s.timeEntries.list(func(e *harvest.TimeEntry) bool {
+		return matchID(userID, e.User.GetID()) &&
+			matchID(clientID, e.Client.GetID()) &&
+			matchID(projectID, e.Project.GetID()) &&
+			matchID(taskID, e.Task.GetID()) &&
+			matchBool(isBilled, e.IsBilled) &&
+			matchBool(isRunning, e.IsRunning) &&
+			matchUpdatedSince(updatedSince, e.UpdatedAt) &&
+			matchDateRange(from, to, e.SpentDate)
+	})
+	s.mu.Unlock()
+
+	page, p, err := paginate(r, items)
+	if err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, harvest.TimeEntryList{TimeEntries: page, Pagination: p})
+}
+
+func (s *Server) getTimeEntry(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	e, found := s.timeEntries.get(id)
+	e = clone(e)
+	s.mu.Unlock()
+
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, e)
+}
+
+func (s *Server) createTimeEntry(w http.ResponseWriter, r *http.Request) {
+	var req timeEntryRequest
+	if err := decode(r, &req); err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	switch {
+	case req.ProjectID == nil:
+		writeValidation(w, required("project_id"))
+
+		return
+	case req.TaskID == nil:
+		writeValidation(w, required("task_id"))
+
+		return
+	case req.SpentDate == nil:
+		writeValidation(w, required("spent_date"))
+
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	userID := s.currentUserID
+	if req.UserID != nil {
+		userID = *req.UserID
+	}
+
+	now := s.timestamp()
+	e := &harvest.TimeEntry{
+		SpentDate:         req.SpentDate,
+		Notes:             req.Notes,
+		ExternalReference: req.ExternalReference,
+		IsLocked:          harvest.Bool(false),
+		IsClosed:          harvest.Bool(false),
+		IsBilled:          harvest.Bool(false),
+		Budgeted:          harvest.Bool(true),
+		CreatedAt:         &now,
+		UpdatedAt:         &now,
+	}
+
+	if err := s.assignTimeEntry(e, userID, *req.ProjectID, *req.TaskID); err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	s.applyTimes(e, &req, true)
+
+	if e.GetIsRunning() {
+		s.stopRunningTimers(userID)
+	}
+
+	e.ID = harvest.Int64(s.nextID())
+	s.timeEntries.put(*e.ID, e)
+
+	writeJSON(w, http.StatusCreated, e)
+}
+
+func (s *Server) updateTimeEntry(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req timeEntryRequest
+	if err := decode(r, &req); err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, found := s.timeEntries.get(id)
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	if e.GetIsLocked() {
+		writeValidation(w, errLocked)
+
+		return
+	}
+
+	if req.ProjectID != nil || req.TaskID != nil {
+		projectID, taskID := e.Project.GetID(), e.Task.GetID()
+		if req.ProjectID != nil {
+			projectID = *req.ProjectID
+		}
+
+		if req.TaskID != nil {
+			taskID = *req.TaskID
+		}
+
+		updated := clone(e)
+		if err := s.assignTimeEntry(updated, e.User.GetID(), projectID, taskID); err != nil {
+			writeValidation(w, err)
+
+			return
+		}
+
+		*e = *updated
+	}
+
+	setIfNotNil(&e.SpentDate, req.SpentDate)
+	setIfNotNil(&e.Notes, req.Notes)
+	setIfNotNil(&e.ExternalReference, req.ExternalReference)
+	s.applyTimes(e, &req, false)
+	e.UpdatedAt = harvest.TimeTimeP(s.timestamp())
+
+	writeJSON(w, http.StatusOK, e)
+}
+
+func (s *Server) deleteTimeEntry(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, found := s.timeEntries.get(id)
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	if e.GetIsLocked() {
+		writeValidation(w, errLocked)
+
+		return
+	}
+
+	s.timeEntries.delete(id)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) deleteExternalReference(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, found := s.timeEntries.get(id)
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	now := s.timestamp()
+	e.ExternalReference = nil
+	e.UpdatedAt = &now
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) stopTimeEntry(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, found := s.timeEntries.get(id)
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	if !e.GetIsRunning() {
+		writeValidation(w, errNotRunning)
+
+		return
+	}
+
+	s.stopTimer(e)
+
+	writeJSON(w, http.StatusOK, e)
+}
+
+func (s *Server) restartTimeEntry(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, found := s.timeEntries.get(id)
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	if e.GetIsRunning() {
+		writeValidation(w, errRunning)
+
+		return
+	}
+
+	s.stopRunningTimers(e.User.GetID())
+
+	now := s.timestamp()
+	e.IsRunning = harvest.Bool(true)
+	e.TimerStartedAt = &now
+	e.UpdatedAt = &now
+
+	writeJSON(w, http.StatusOK, e)
+}
+
+// assignTimeEntry validates that the user may track time on the project and
+// task, like Harvest does, and fills in the related objects of e.
+func (s *Server) assignTimeEntry(e *harvest.TimeEntry, userID, projectID, taskID int64) error {
+	project, ok := s.projects.get(projectID)
+	if !ok {
+		return unknown("project")
+	}
+
+	if !project.GetIsActive() {
+		return fmt.Errorf("project %w", errArchived)
+	}
+
+	user, ok := s.userRef(userID)
+	if !ok {
+		return unknown("user")
+	}
+
+	ta, ok := s.findTaskAssignment(projectID, taskID)
+	if !ok || !ta.GetIsActive() {
+		return fmt.Errorf("task %w", errNotAssigned)
+	}
+
+	ua, ok := s.findUserAssignment(projectID, userID)
+	if !ok || !ua.GetIsActive() {
+		return fmt.Errorf("user %w", errNotAssigned)
+	}
+
+	e.User = user
+	e.Client = clone(project.Client)
+	e.Project, _ = s.projectRef(projectID)
+	e.Task = clone(ta.Task)
+	e.TaskAssignment = clone(ta)
+	e.UserAssignment = clone(ua)
+	e.Billable = harvest.Bool(project.GetIsBillable() && ta.GetBillable())
+	e.BillableRate = ta.HourlyRate
+	e.CostRate = nil
+
+	return nil
+}
+
+// applyTimes sets hours, start/end times and the timer state of e from req.
+func (s *Server) applyTimes(e *harvest.TimeEntry, req *timeEntryRequest, create bool) {
+	setIfNotNil(&e.StartedTime, req.StartedTime)
+	setIfNotNil(&e.EndedTime, req.EndedTime)
+
+	switch {
+	case req.Hours != nil:
+		e.Hours = req.Hours
+	case e.StartedTime != nil && e.EndedTime != nil:
+		e.Hours = harvest.HoursP(harvest.HoursFromDuration(e.EndedTime.Sub(e.StartedTime.Time)))
+	case create:
+		now := s.timestamp()
+		e.Hours = harvest.HoursP(0)
+		e.IsRunning = harvest.Bool(true)
+		e.TimerStartedAt = &now
+	}
+
+	if req.Hours != nil || req.EndedTime != nil {
+		e.IsRunning = harvest.Bool(false)
+		e.TimerStartedAt = nil
+	}
+
+	if e.IsRunning == nil {
+		e.IsRunning = harvest.Bool(false)
+	}
+
+	e.RoundedHours = harvest.HoursP(roundHours(e.GetHours()))
+}
+
+func (s *Server) stopTimer(e *harvest.TimeEntry) {
+	now := s.timestamp()
+
+	if e.TimerStartedAt != nil {
+		e.Hours = harvest.HoursP(e.GetHours() + harvest.HoursFromDuration(now.Sub(*e.TimerStartedAt)))
+		e.RoundedHours = harvest.HoursP(roundHours(e.GetHours()))
+	}
+
+	e.IsRunning = harvest.Bool(false)
+	e.TimerStartedAt = nil
+	e.UpdatedAt = &now
+}
+
+// stopRunningTimers stops the running timers of a user. Harvest only allows
+// one running timer per user.
+func (s *Server) stopRunningTimers(userID int64) {
+	for _, e := range s.timeEntries.rows {
+		if e.User.GetID() == userID && e.GetIsRunning() {
+			s.stopTimer(e)
+		}
+	}
+}
+
+// roundHours rounds to two decimals, the precision Harvest reports hours in.
+func roundHours(h harvest.Hours) harvest.Hours {
+	const precision = 100
+
+	return harvest.Hours(math.Round(float64(h)*precision) / precision)
+}
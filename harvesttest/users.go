@@ -0,0 +1,319 @@
+package harvesttest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/becoded/go-harvest/harvest"
+)
+
+// AddUser stores u, assigning an ID and timestamps, and returns a copy of the
+// stored user. IsActive defaults to true.
+func (s *Server) AddUser(u *harvest.User) *harvest.User {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return clone(s.addUser(clone(u)))
+}
+
+func (s *Server) addUser(u *harvest.User) *harvest.User {
+	now := s.timestamp()
+
+	u.ID = harvest.Int64(s.nextID())
+	if u.IsActive == nil {
+		u.IsActive = harvest.Bool(true)
+	}
+
+	if u.Name == nil {
+		u.Name = harvest.String(strings.TrimSpace(u.GetFirstName() + " " + u.GetLastName()))
+	}
+
+	if u.CreatedAt == nil {
+		u.CreatedAt = &now
+	}
+
+	u.UpdatedAt = &now
+	s.users.put(*u.ID, u)
+
+	return u
+}
+
+// userRef returns the id/name summary Harvest embeds in other resources.
+func (s *Server) userRef(id int64) (*harvest.User, bool) {
+	u, ok := s.users.get(id)
+	if !ok {
+		return nil, false
+	}
+
+	return &harvest.User{ID: u.ID, Name: u.Name}, true
+}
+
+func (s *Server) userRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+BasePath+"company", s.getCompany)
+
+	mux.HandleFunc("GET "+BasePath+"users", s.listUsers)
+	mux.HandleFunc("POST "+BasePath+"users", s.createUser)
+	mux.HandleFunc("GET "+BasePath+"users/me", s.getCurrentUser)
+	mux.HandleFunc("GET "+BasePath+"users/{id}", s.getUser)
+	mux.HandleFunc("PATCH "+BasePath+"users/{id}", s.updateUser)
+	mux.HandleFunc("DELETE "+BasePath+"users/{id}", s.deleteUser)
+	mux.HandleFunc("GET "+BasePath+"users/me/project_assignments", s.listMyProjectAssignments)
+	mux.HandleFunc("GET "+BasePath+"users/{id}/project_assignments", s.listProjectAssignments)
+}
+
+func (s *Server) getCompany(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	c := clone(s.company)
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, c)
+}
+
+func (s *Server) listUsers(w http.ResponseWriter, r *http.Request) {
+	f := newFilter(r)
+	isActive := f.boolean("is_active")
+	updatedSince := f.time("updated_since")
+
+	if f.err != nil {
+		writeValidation(w, f.err)
+
+		return
+	}
+
+	s.mu.Lock()
+	items := s.users.list(func(u *harvest.User) bool {
+		return matchBool(isActive, u.IsActive) && matchUpdatedSince(updatedSince, u.UpdatedAt)
+	})
+	s.mu.Unlock()
+
+	page, p, err := paginate(r, items)
+	if err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, harvest.UserList{Users: page, Pagination: p})
+}
+
+func (s *Server) getCurrentUser(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	u, found := s.users.get(s.currentUserID)
+	u = clone(u)
+	s.mu.Unlock()
+
+	if !found {
+		writeError(w, http.StatusUnauthorized, "Unauthorized")
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, u)
+}
+
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	u, found := s.users.get(id)
+	u = clone(u)
+	s.mu.Unlock()
+
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, u)
+}
+
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request) {
+	var req harvest.UserCreateRequest
+	if err := decode(r, &req); err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	switch {
+	case req.FirstName == nil || *req.FirstName == "":
+		writeValidation(w, required("first_name"))
+
+		return
+	case req.LastName == nil || *req.LastName == "":
+		writeValidation(w, required("last_name"))
+
+		return
+	case req.Email == nil || *req.Email == "":
+		writeValidation(w, required("email"))
+
+		return
+	}
+
+	s.mu.Lock()
+	u := clone(s.addUser(&harvest.User{
+		FirstName:         req.FirstName,
+		LastName:          req.LastName,
+		Email:             req.Email,
+		Telephone:         req.Telephone,
+		Timezone:          req.Timezone,
+		IsContractor:      req.IsContractor,
+		IsAdmin:           req.IsAdmin,
+		IsProjectManager:  req.IsProjectManager,
+		IsActive:          req.IsActive,
+		WeeklyCapacity:    req.WeeklyCapacity,
+		DefaultHourlyRate: req.DefaultHourlyRate,
+		CostRate:          req.CostRate,
+	}))
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusCreated, u)
+}
+
+func (s *Server) updateUser(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req harvest.UserUpdateRequest
+	if err := decode(r, &req); err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, found := s.users.get(id)
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	setIfNotNil(&u.FirstName, req.FirstName)
+	setIfNotNil(&u.LastName, req.LastName)
+	setIfNotNil(&u.Email, req.Email)
+	setIfNotNil(&u.Telephone, req.Telephone)
+	setIfNotNil(&u.Timezone, req.Timezone)
+	setIfNotNil(&u.IsActive, req.IsActive)
+	setIfNotNil(&u.WeeklyCapacity, req.WeeklyCapacity)
+	u.Name = harvest.String(strings.TrimSpace(u.GetFirstName() + " " + u.GetLastName()))
+	u.UpdatedAt = harvest.TimeTimeP(s.timestamp())
+
+	writeJSON(w, http.StatusOK, u)
+}
+
+func (s *Server) deleteUser(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.users.delete(id) {
+		writeNotFound(w)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) listMyProjectAssignments(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	userID := s.currentUserID
+	s.mu.Unlock()
+
+	s.writeProjectAssignments(w, r, userID)
+}
+
+func (s *Server) listProjectAssignments(w http.ResponseWriter, r *http.Request) {
+	userID, ok := pathID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	_, found := s.users.get(userID)
+	s.mu.Unlock()
+
+	if !found {
+		writeNotFound(w)
+
+		return
+	}
+
+	s.writeProjectAssignments(w, r, userID)
+}
+
+// writeProjectAssignments lists the active project assignments of a user on
+// active projects, each with all task assignments of the project.
+func (s *Server) writeProjectAssignments(w http.ResponseWriter, r *http.Request, userID int64) {
+	f := newFilter(r)
+	updatedSince := f.time("updated_since")
+
+	if f.err != nil {
+		writeValidation(w, f.err)
+
+		return
+	}
+
+	s.mu.Lock()
+
+	userAssignments := s.userAssignments.list(func(a *harvest.ProjectUserAssignment) bool {
+		return a.User.GetID() == userID && a.GetIsActive() && matchUpdatedSince(updatedSince, a.UpdatedAt)
+	})
+
+	items := make([]*harvest.UserProjectAssignment, 0, len(userAssignments))
+
+	for _, ua := range userAssignments {
+		project, ok := s.projects.get(ua.Project.GetID())
+		if !ok || !project.GetIsActive() {
+			continue
+		}
+
+		projectID := project.GetID()
+
+		var taskAssignments []harvest.ProjectTaskAssignment
+		for _, ta := range s.taskAssignments.list(func(a *harvest.ProjectTaskAssignment) bool {
+			return a.Project.GetID() == projectID
+		}) {
+			ta.Project = nil
+			taskAssignments = append(taskAssignments, *ta)
+		}
+
+		ref, _ := s.projectRef(projectID)
+		items = append(items, &harvest.UserProjectAssignment{
+			ID:               ua.ID,
+			IsActive:         ua.IsActive,
+			IsProjectManager: ua.IsProjectManager,
+			UseDefaultRates:  harvest.Bool(ua.HourlyRate == nil),
+			HourlyRate:       ua.HourlyRate,
+			Budget:           ua.Budget,
+			CreatedAt:        ua.CreatedAt,
+			UpdatedAt:        ua.UpdatedAt,
+			Project:          ref,
+			Client:           clone(project.Client),
+			TaskAssignments:  &taskAssignments,
+		})
+	}
+	s.mu.Unlock()
+
+	page, p, err := paginate(r, items)
+	if err != nil {
+		writeValidation(w, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, harvest.UserProjectAssignmentList{ProjectAssignments: page, Pagination: p})
+}
@@ -0,0 +1,375 @@
+package importer
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/becoded/go-harvest/harvest"
+	"github.com/becoded/go-harvest/resolve"
+)
+
+var (
+	// ErrNoTask is reported for an entry without a task, a mapped tag or a
+	// default task.
+	ErrNoTask = errors.New("no task, mapped tag or default task")
+	// ErrSpansDays is reported for an entry that doesn't end on the day it
+	// starts, which Harvest can't record.
+	ErrSpansDays = errors.New("entry doesn't end on the day it starts")
+)
+
+// A ForeignEntry is a time entry exported from another time tracker, such as
+// Toggl Track or Clockify.
+type ForeignEntry struct {
+	// Line is the line of a CSV file the entry is on, or its position in a
+	// JSON file, counting from 1.
+	Line int
+	// ID is the entry's ID in the other tracker, if the export has one.
+	ID          string
+	User        string
+	Email       string
+	Client      string
+	Project     string
+	Task        string
+	Description string
+	Tags        []string
+	// Start and End are in the time zone of the export; only their wall
+	// clock is used.
+	Start time.Time
+	End   time.Time
+}
+
+// A Mapping translates the names of another time tracker into Harvest names.
+// Names without a mapping are looked up as they are. It is usually kept in a
+// JSON file, see LoadMapping:
+//
+//	{
+//		"projects": {"Acme / Website redesign": "Acme / Website", "Internal": "Acme / Internal"},
+//		"tasks": {"Dev": "Development"},
+//		"tags": {"meeting": "Meetings"},
+//		"users": {"jane@old-domain.com": "jane@example.com"},
+//		"default_task": "Development"
+//	}
+type Mapping struct {
+	// Projects maps "client / project" or "project" of the other tracker to
+	// "client / project" or "project" in Harvest.
+	Projects map[string]string `json:"projects"`
+	// Tasks maps tasks to Harvest tasks.
+	Tasks map[string]string `json:"tasks"`
+	// Tags maps tags to Harvest tasks, for entries without a task. Toggl and
+	// Clockify users often use tags where Harvest uses tasks.
+	Tags map[string]string `json:"tags"`
+	// Users maps email addresses or names to Harvest users' names or email
+	// addresses.
+	Users map[string]string `json:"users"`
+	// DefaultTask is the Harvest task of entries without a task or a mapped
+	// tag.
+	DefaultTask string `json:"default_task"`
+}
+
+// LoadMapping reads a Mapping from a JSON file.
+func LoadMapping(path string) (*Mapping, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var m Mapping
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return &m, nil
+}
+
+// An Entry is a request converted from a ForeignEntry.
+type Entry struct {
+	Line    int
+	Request *harvest.TimeEntryCreateViaStartEndTime
+}
+
+// Unmapped counts the names of another tracker that match nothing, or more
+// than one thing, in Harvest, by the number of entries that use them. Add
+// them to the Mapping and convert again.
+type Unmapped struct {
+	Projects map[string]int
+	Tasks    map[string]int
+	Tags     map[string]int
+	Users    map[string]int
+}
+
+// Empty reports whether every name was mapped.
+func (u *Unmapped) Empty() bool {
+	return len(u.Projects)+len(u.Tasks)+len(u.Tags)+len(u.Users) == 0
+}
+
+// String lists the unmapped names by kind, most used first.
+func (u *Unmapped) String() string {
+	var b strings.Builder
+
+	for _, kind := range []struct {
+		name   string
+		counts map[string]int
+	}{
+		{"projects", u.Projects}, {"tasks", u.Tasks}, {"tags", u.Tags}, {"users", u.Users},
+	} {
+		if len(kind.counts) == 0 {
+			continue
+		}
+
+		names := slices.SortedFunc(maps.Keys(kind.counts), func(a, b string) int {
+			return cmp.Or(cmp.Compare(kind.counts[b], kind.counts[a]), cmp.Compare(a, b))
+		})
+
+		fmt.Fprintf(&b, "unmapped %s:\n", kind.name)
+
+		for _, name := range names {
+			fmt.Fprintf(&b, "  %s (%d entries)\n", name, kind.counts[name])
+		}
+	}
+
+	return b.String()
+}
+
+func (u *Unmapped) add(counts *map[string]int, name string) {
+	if *counts == nil {
+		*counts = map[string]int{}
+	}
+
+	(*counts)[name]++
+}
+
+// A Conversion is the outcome of Convert.
+type Conversion struct {
+	Entries  []Entry
+	Unmapped Unmapped
+	// Errors are the entries that couldn't be converted, by line.
+	Errors []*LineError
+	// Skipped is the number of entries left out on purpose, as opposed to
+	// those in Errors.
+	Skipped int
+}
+
+// Convert maps entries of another tracker onto requests, resolving the
+// mapped names and checking the assignments. m may be nil. Entries that
+// can't be converted are reported by line, and the names that didn't resolve
+// are summed up in the Unmapped of the result.
+func (im *Importer) Convert(ctx context.Context, entries []ForeignEntry, m *Mapping) *Conversion {
+	if m == nil {
+		m = &Mapping{}
+	}
+
+	c := &Conversion{}
+	seen := map[string]int{}
+
+	for _, fe := range entries {
+		req, err := im.convert(ctx, fe, m, &c.Unmapped)
+		if err != nil {
+			c.Errors = append(c.Errors, &LineError{Line: fe.Line, Err: err})
+
+			continue
+		}
+
+		// Identical entries without an ID are told apart by their position
+		// among each other.
+		if id := req.ExternalReference.GetID(); fe.ID == "" {
+			if seen[id]++; seen[id] > 1 {
+				req.ExternalReference.ID = harvest.String(fmt.Sprintf("%s-%d", id, seen[id]))
+			}
+		}
+
+		c.Entries = append(c.Entries, Entry{Line: fe.Line, Request: req})
+	}
+
+	return c
+}
+
+func (im *Importer) convert(
+	ctx context.Context,
+	fe ForeignEntry,
+	m *Mapping,
+	unmapped *Unmapped,
+) (*harvest.TimeEntryCreateViaStartEndTime, error) {
+	if fe.Start.Year() != fe.End.Year() || fe.Start.YearDay() != fe.End.YearDay() {
+		return nil, ErrSpansDays
+	}
+
+	project, err := im.mapProject(ctx, fe, m)
+	if err != nil {
+		if errors.As(err, new(*resolve.MatchError)) {
+			unmapped.add(&unmapped.Projects, join(fe.Client, fe.Project))
+		}
+
+		return nil, err
+	}
+
+	task, err := im.mapTask(ctx, project.GetID(), fe, m, unmapped)
+	if err != nil {
+		return nil, err
+	}
+
+	var userID int64
+
+	if name := cmp.Or(m.Users[fe.Email], m.Users[fe.User], fe.Email, fe.User); name != "" {
+		u, err := im.resolver.User(ctx, name)
+		if err != nil {
+			if errors.As(err, new(*resolve.MatchError)) {
+				unmapped.add(&unmapped.Users, cmp.Or(fe.Email, fe.User))
+			}
+
+			return nil, err
+		}
+
+		userID = u.GetID()
+	}
+
+	if err := im.checker.Check(ctx, userID, project.GetID(), task.GetID()); err != nil {
+		return nil, err
+	}
+
+	ref := fe.ID
+	if ref == "" {
+		fields := []string{
+			fe.User, fe.Email, fe.Client, fe.Project, fe.Task, fe.Description, strings.Join(fe.Tags, ","),
+			fe.Start.Format(time.RFC3339), fe.End.Format(time.RFC3339),
+		}
+		sum := sha256.Sum256([]byte(strings.Join(fields, "\x1f")))
+		ref = hex.EncodeToString(sum[:16])
+	}
+
+	req := &harvest.TimeEntryCreateViaStartEndTime{
+		ProjectID:   project.ID,
+		TaskID:      task.ID,
+		SpentDate:   harvest.DateP(harvest.Date{Time: fe.Start}),
+		StartedTime: harvest.TimeP(harvest.Time{Time: fe.Start}),
+		EndedTime:   harvest.TimeP(harvest.Time{Time: fe.End}),
+		ExternalReference: &harvest.ExternalReference{
+			ID:      harvest.String(ref),
+			GroupID: harvest.String(im.source),
+		},
+	}
+
+	if userID != 0 {
+		req.UserID = harvest.Int64(userID)
+	}
+
+	if fe.Description != "" {
+		req.Notes = harvest.String(fe.Description)
+	}
+
+	return req, nil
+}
+
+// mapProject resolves the entry's project, mapped by client and project or
+// by project alone.
+func (im *Importer) mapProject(ctx context.Context, fe ForeignEntry, m *Mapping) (*harvest.Project, error) {
+	target, ok := m.Projects[join(fe.Client, fe.Project)]
+	if !ok {
+		target, ok = m.Projects[fe.Project]
+	}
+
+	client, project := fe.Client, fe.Project
+	if ok {
+		client = ""
+		project = target
+
+		if before, after, found := strings.Cut(target, "/"); found {
+			client, project = before, after
+		}
+	}
+
+	var clientID int64
+
+	if client = strings.TrimSpace(client); client != "" {
+		c, err := im.resolver.Client(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+
+		clientID = c.GetID()
+	}
+
+	return im.resolver.Project(ctx, clientID, strings.TrimSpace(project))
+}
+
+// mapTask resolves the entry's task: its own, the first mapped tag or the
+// default task.
+func (im *Importer) mapTask(
+	ctx context.Context,
+	projectID int64,
+	fe ForeignEntry,
+	m *Mapping,
+	unmapped *Unmapped,
+) (*harvest.Task, error) {
+	var source, name string
+
+	switch {
+	case fe.Task != "":
+		source, name = fe.Task, cmp.Or(m.Tasks[fe.Task], fe.Task)
+	default:
+		for _, tag := range fe.Tags {
+			if task, ok := m.Tags[tag]; ok {
+				name = task
+
+				break
+			}
+		}
+
+		if name == "" {
+			name = m.DefaultTask
+		}
+	}
+
+	if name == "" {
+		for _, tag := range fe.Tags {
+			unmapped.add(&unmapped.Tags, tag)
+		}
+
+		return nil, ErrNoTask
+	}
+
+	task, err := im.resolver.Task(ctx, projectID, name)
+	if err != nil && errors.As(err, new(*resolve.MatchError)) {
+		unmapped.add(&unmapped.Tasks, cmp.Or(source, name))
+	}
+
+	return task, err
+}
+
+// ImportStartEndTime creates an entry for each converted entry that wasn't
+// imported before, like Import.
+func (im *Importer) ImportStartEndTime(ctx context.Context, entries []Entry) (*Report, error) {
+	report := &Report{DryRun: im.dryRun}
+	todo := make([]pending, len(entries))
+
+	for i, e := range entries {
+		todo[i] = pending{
+			line: e.Line,
+			day:  e.Request.SpentDate.Time,
+			ref:  e.Request.ExternalReference,
+			create: func(ctx context.Context) (*harvest.TimeEntry, *http.Response, error) {
+				return im.client.Timesheet.CreateTimeEntryViaStartEndTime(ctx, e.Request)
+			},
+		}
+	}
+
+	return report, im.run(ctx, report, todo)
+}
+
+func join(client, project string) string {
+	if client == "" {
+		return project
+	}
+
+	return client + " / " + project
+}
@@ -0,0 +1,118 @@
+package importer_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/becoded/go-harvest/harvest"
+	"github.com/becoded/go-harvest/harvesttest"
+	"github.com/becoded/go-harvest/importer"
+	"github.com/becoded/go-harvest/resolve"
+)
+
+const mappingJSON = `{
+	"projects": {"Acme Corp / Website redesign": "Acme / Website"},
+	"tasks": {"Dev": "Development"},
+	"tags": {"meeting": "Meetings"},
+	"users": {"jane@old-domain.com": "jane@example.com"}
+}`
+
+func TestImporter_Convert(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := harvesttest.NewServer()
+
+	defer srv.Close()
+
+	acme := srv.AddClient(&harvest.Client{Name: harvest.String("Acme")})
+	website := srv.AddProject(&harvest.Project{Client: acme, Name: harvest.String("Website")})
+	dev := srv.AddTask(&harvest.Task{Name: harvest.String("Development")})
+	meetings := srv.AddTask(&harvest.Task{Name: harvest.String("Meetings")})
+	jane := srv.AddUser(&harvest.User{
+		FirstName: harvest.String("Jane"),
+		LastName:  harvest.String("Doe"),
+		Email:     harvest.String("jane@example.com"),
+	})
+
+	srv.AssignTask(website.GetID(), dev.GetID())
+	srv.AssignTask(website.GetID(), meetings.GetID())
+	srv.AssignUser(website.GetID(), srv.CurrentUser().GetID())
+	srv.AssignUser(website.GetID(), jane.GetID())
+
+	path := filepath.Join(t.TempDir(), "mapping.json")
+	assert.NoError(t, os.WriteFile(path, []byte(mappingJSON), 0o600))
+
+	m, err := importer.LoadMapping(path)
+	assert.NoError(t, err)
+
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, 3, 4, hour, minute, 0, 0, time.UTC)
+	}
+
+	entries := []importer.ForeignEntry{
+		{
+			Line: 2, ID: "toggl:1", Email: "jane@old-domain.com", Client: "Acme Corp", Project: "Website redesign",
+			Task: "Dev", Description: "Homepage", Start: at(9, 0), End: at(10, 30),
+		},
+		{
+			Line: 3, Client: "Acme", Project: "Website", Tags: []string{"billable", "meeting"},
+			Start: at(11, 0), End: at(12, 0),
+		},
+		{Line: 4, Client: "Acme", Project: "Marketing", Task: "Dev", Start: at(13, 0), End: at(14, 0)},
+		{Line: 5, Client: "Acme", Project: "Marketing", Start: at(14, 0), End: at(15, 0)},
+		{Line: 6, Project: "Website", Task: "QA", Start: at(13, 0), End: at(14, 0)},
+		{Line: 7, Project: "Website", Tags: []string{"misc"}, Start: at(13, 0), End: at(14, 0)},
+		{Line: 8, Project: "Website", Task: "Dev", Email: "ghost@example.com", Start: at(13, 0), End: at(14, 0)},
+		{Line: 9, Project: "Website", Task: "Dev", Start: at(23, 0), End: at(25, 0)},
+	}
+
+	im := importer.New(srv.Client(), importer.WithResolver(resolve.New(srv.Client(), resolve.WithFuzzy(false))))
+	c := im.Convert(ctx, entries, m)
+
+	assert.Len(t, c.Entries, 2)
+	assert.Len(t, c.Errors, 6)
+
+	first := c.Entries[0].Request
+	assert.Equal(t, website.GetID(), first.GetProjectID())
+	assert.Equal(t, dev.GetID(), first.GetTaskID())
+	assert.Equal(t, jane.GetID(), first.GetUserID())
+	assert.Equal(t, "9:00am", first.StartedTime.String())
+	assert.Equal(t, "10:30am", first.EndedTime.String())
+	assert.Equal(t, "toggl:1", first.GetExternalReference().GetID())
+	assert.Equal(t, meetings.GetID(), c.Entries[1].Request.GetTaskID(), "tasks are mapped from tags")
+	assert.Nil(t, c.Entries[1].Request.UserID)
+
+	assert.ErrorIs(t, c.Errors[3], importer.ErrNoTask)
+	assert.ErrorIs(t, c.Errors[5], importer.ErrSpansDays)
+	assert.False(t, c.Unmapped.Empty())
+	assert.Equal(t, `unmapped projects:
+  Acme / Marketing (2 entries)
+unmapped tasks:
+  QA (1 entries)
+unmapped tags:
+  misc (1 entries)
+unmapped users:
+  ghost@example.com (1 entries)
+`, c.Unmapped.String())
+
+	report, err := im.ImportStartEndTime(ctx, c.Entries)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, report.Created)
+	assert.Equal(t, harvest.Hours(1.5), report.Entries[0].GetHours())
+
+	report, err = im.ImportStartEndTime(ctx, im.Convert(ctx, entries, m).Entries)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, report.Skipped, "entries imported before are skipped")
+
+	m.DefaultTask = "Development"
+	c = im.Convert(ctx, entries[5:6], m)
+	assert.Len(t, c.Entries, 1)
+	assert.Equal(t, dev.GetID(), c.Entries[0].Request.GetTaskID())
+	assert.True(t, c.Unmapped.Empty())
+}
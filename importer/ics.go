@@ -0,0 +1,541 @@
+package importer
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/becoded/go-harvest/harvest"
+)
+
+var (
+	// ErrNoRule is reported for a calendar event that matches no rule.
+	ErrNoRule = errors.New("no rule matches the event")
+	// ErrDuration is returned for an event DURATION that can't be parsed.
+	ErrDuration = errors.New("invalid duration")
+	// ErrContentLine is returned for a line of an iCalendar file without a
+	// property value.
+	ErrContentLine = errors.New("invalid content line")
+	// ErrRecurrence is reported by ConvertEvents for a recurring event whose
+	// occurrences weren't expanded, see ExpandEvents.
+	ErrRecurrence = errors.New("recurring event isn't expanded")
+)
+
+// An Event is a VEVENT of an iCalendar file.
+type Event struct {
+	// Line is the line of the file the event begins on.
+	Line int
+	UID  string
+	// RecurrenceID tells apart the occurrences of a recurring event, which
+	// share their UID. It is the original start of the occurrence, in UTC,
+	// such as "20240304T080000Z", or its date for all-day events.
+	RecurrenceID string
+	Summary      string
+	Description  string
+	// Status is CONFIRMED, TENTATIVE, CANCELLED or empty.
+	Status    string
+	URL       string
+	Organizer Attendee
+	Attendees []Attendee
+	// Start and End are in the time zone the event was written in. Times
+	// without a time zone are read in the local one.
+	Start time.Time
+	End   time.Time
+	// AllDay is set for events with dates instead of times.
+	AllDay bool
+	// RRule is the recurrence rule of a recurring event, such as
+	// "FREQ=WEEKLY;BYDAY=MO,WE", and ExDates are the occurrences it leaves
+	// out.
+	RRule   string
+	ExDates []time.Time
+}
+
+// An Attendee is an ATTENDEE or ORGANIZER of an event.
+type Attendee struct {
+	Email string
+	Name  string
+	// PartStat is the attendee's participation status, such as ACCEPTED or
+	// DECLINED.
+	PartStat string
+}
+
+// ReadICS reads the events of an iCalendar file, such as one exported from
+// Google Calendar or Outlook. A recurring event is read once, with the times
+// of its first occurrence and its RRule; ExpandEvents turns it into its
+// occurrences. Time zones are looked up by their TZID, which is either an IANA
+// name or a Windows one as Outlook writes them, such as
+// "W. Europe Standard Time"; VTIMEZONE components are ignored.
+func ReadICS(r io.Reader) ([]Event, error) {
+	var (
+		events []Event
+		ev     *Event
+		// depth counts the components nested in the event, such as VALARM,
+		// whose properties are ignored.
+		depth int
+	)
+
+	err := unfold(r, func(line int, name string, params map[string]string, value string) error {
+		switch {
+		case name == "BEGIN" && value == "VEVENT":
+			ev = &Event{Line: line}
+		case ev == nil:
+		case name == "BEGIN":
+			depth++
+		case name == "END" && depth > 0:
+			depth--
+		case name == "END" && value == "VEVENT":
+			if ev.End.IsZero() {
+				ev.End = ev.Start
+				if ev.AllDay {
+					ev.End = ev.Start.AddDate(0, 0, 1)
+				}
+			}
+
+			events = append(events, *ev)
+			ev = nil
+		case depth > 0:
+		default:
+			return ev.set(name, params, value)
+		}
+
+		return nil
+	})
+
+	return events, err
+}
+
+func (ev *Event) set(name string, params map[string]string, value string) error {
+	var err error
+
+	switch name {
+	case "UID":
+		ev.UID = value
+	case "RECURRENCE-ID":
+		var t time.Time
+		if t, err = parseICSTime(params, value); err == nil {
+			ev.RecurrenceID = recurrenceID(t, params["VALUE"] == "DATE" || len(value) == len("20060102"))
+		}
+	case "RRULE":
+		ev.RRule = value
+	case "EXDATE":
+		for v := range strings.SplitSeq(value, ",") {
+			var t time.Time
+			if t, err = parseICSTime(params, v); err != nil {
+				break
+			}
+
+			ev.ExDates = append(ev.ExDates, t)
+		}
+	case "SUMMARY":
+		ev.Summary = unescapeText(value)
+	case "DESCRIPTION":
+		ev.Description = unescapeText(value)
+	case "STATUS":
+		ev.Status = strings.ToUpper(value)
+	case "URL":
+		ev.URL = value
+	case "ORGANIZER":
+		ev.Organizer = attendee(params, value)
+	case "ATTENDEE":
+		ev.Attendees = append(ev.Attendees, attendee(params, value))
+	case "DTSTART":
+		ev.AllDay = params["VALUE"] == "DATE" || len(value) == len("20060102")
+		ev.Start, err = parseICSTime(params, value)
+	case "DTEND":
+		ev.End, err = parseICSTime(params, value)
+	case "DURATION":
+		var d time.Duration
+		if d, err = parseICSDuration(value); err == nil {
+			ev.End = ev.Start.Add(d)
+		}
+	}
+
+	return err
+}
+
+func attendee(params map[string]string, value string) Attendee {
+	email := value
+	if len(email) >= len("mailto:") && strings.EqualFold(email[:len("mailto:")], "mailto:") {
+		email = email[len("mailto:"):]
+	}
+
+	return Attendee{Email: email, Name: params["CN"], PartStat: strings.ToUpper(params["PARTSTAT"])}
+}
+
+// unfold calls fn with each content line of an iCalendar file, unfolded and
+// split into its name, parameters and value. Names and parameter names are
+// uppercased.
+func unfold(r io.Reader, fn func(line int, name string, params map[string]string, value string) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20) //nolint: mnd
+
+	var (
+		content     string
+		start, line int
+	)
+
+	flush := func() error {
+		if content == "" {
+			return nil
+		}
+
+		name, params, value, err := parseContentLine(content)
+		if err != nil {
+			return &LineError{Line: start, Err: err}
+		}
+
+		if err := fn(start, name, params, value); err != nil {
+			return &LineError{Line: start, Err: err}
+		}
+
+		return nil
+	}
+
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSuffix(scanner.Text(), "\r")
+
+		if text != "" && (text[0] == ' ' || text[0] == '\t') {
+			content += text[1:]
+
+			continue
+		}
+
+		if err := flush(); err != nil {
+			return err
+		}
+
+		content, start = strings.TrimPrefix(text, "\ufeff"), line
+	}
+
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	return flush()
+}
+
+// parseContentLine splits a content line such as
+// `DTSTART;TZID="Europe/Brussels":20240304T090000` at the first colon
+// outside of quotes.
+func parseContentLine(s string) (string, map[string]string, string, error) {
+	quoted := false
+	colon := -1
+
+	for i := 0; i < len(s) && colon < 0; i++ {
+		switch s[i] {
+		case '"':
+			quoted = !quoted
+		case ':':
+			if !quoted {
+				colon = i
+			}
+		}
+	}
+
+	if colon < 0 {
+		return "", nil, "", fmt.Errorf("%w: %q", ErrContentLine, s)
+	}
+
+	fields := splitParams(s[:colon])
+	params := map[string]string{}
+
+	for _, p := range fields[1:] {
+		k, v, _ := strings.Cut(p, "=")
+		params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+	}
+
+	return strings.ToUpper(fields[0]), params, s[colon+1:], nil
+}
+
+// splitParams splits a name and its parameters at the semicolons outside of
+// quotes.
+func splitParams(s string) []string {
+	var (
+		fields []string
+		quoted bool
+		from   int
+	)
+
+	for i := range len(s) {
+		switch s[i] {
+		case '"':
+			quoted = !quoted
+		case ';':
+			if !quoted {
+				fields = append(fields, s[from:i])
+				from = i + 1
+			}
+		}
+	}
+
+	return append(fields, s[from:])
+}
+
+func parseICSTime(params map[string]string, value string) (time.Time, error) {
+	loc := time.Local
+
+	if tzid := params["TZID"]; tzid != "" {
+		if iana, ok := windowsZones[tzid]; ok {
+			tzid = iana
+		}
+
+		l, err := time.LoadLocation(tzid)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: TZID %q", ErrTime, params["TZID"])
+		}
+
+		loc = l
+	}
+
+	return parseICSTimeIn(value, loc)
+}
+
+// parseICSTimeIn parses a DATE or DATE-TIME value. Times without a "Z" are
+// read in loc.
+func parseICSTimeIn(value string, loc *time.Location) (time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102T150405", "20060102"} {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			if strings.HasSuffix(layout, "Z") {
+				t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC)
+			}
+
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("%w: %q", ErrTime, value)
+}
+
+// icsDuration matches the DURATION values of RFC 5545, such as "PT1H30M".
+var icsDuration = regexp.MustCompile( //nolint: gochecknoglobals
+	`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`,
+)
+
+func parseICSDuration(s string) (time.Duration, error) {
+	m := icsDuration.FindStringSubmatch(s)
+	if m == nil || s == "P" || strings.HasSuffix(s, "T") {
+		return 0, fmt.Errorf("%w: %q", ErrDuration, s)
+	}
+
+	var d time.Duration
+
+	for i, unit := range []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second} {
+		n, _ := strconv.Atoi(m[i+2])
+		d += time.Duration(n) * unit
+	}
+
+	if m[1] == "-" {
+		d = -d
+	}
+
+	return d, nil
+}
+
+// unescapeText unescapes a TEXT value of RFC 5545.
+func unescapeText(s string) string {
+	return strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n").Replace(s)
+}
+
+// A Rule books the calendar events it matches on a project and task. A rule
+// with both patterns matches events that match both.
+type Rule struct {
+	// Title is matched against the event's summary.
+	Title *regexp.Regexp `json:"title"`
+	// Attendee is matched against the email address and name of the
+	// organizer and each attendee; one match is enough.
+	Attendee *regexp.Regexp `json:"attendee"`
+	// Project is "client / project" or "project".
+	Project string `json:"project"`
+	Task    string `json:"task"`
+}
+
+func (rule *Rule) match(ev Event) bool {
+	if rule.Title == nil && rule.Attendee == nil {
+		return false
+	}
+
+	if rule.Title != nil && !rule.Title.MatchString(ev.Summary) {
+		return false
+	}
+
+	if rule.Attendee == nil {
+		return true
+	}
+
+	for _, a := range append([]Attendee{ev.Organizer}, ev.Attendees...) {
+		if (a.Email != "" && rule.Attendee.MatchString(a.Email)) || (a.Name != "" && rule.Attendee.MatchString(a.Name)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CalendarRules turn calendar events into time entries. They are usually
+// kept in a JSON file, see LoadCalendarRules:
+//
+//	{
+//		"me": ["jane@example.com"],
+//		"timezone": "Europe/Brussels",
+//		"rules": [
+//			{"attendee": "@acme\\.com$", "project": "Acme / Website", "task": "Meetings"},
+//			{"title": "(?i)stand-?up|retro", "project": "Internal", "task": "Meetings"}
+//		]
+//	}
+type CalendarRules struct {
+	// Me are the email addresses of the calendar's owner, to tell which
+	// events they declined. Defaults to the authenticated user's.
+	Me []string `json:"me"`
+	// Timezone is the IANA name of the time zone the entries are tracked in.
+	// Defaults to the local one.
+	Timezone string `json:"timezone"`
+	// Rules are tried in order; the first one that matches wins.
+	Rules []Rule `json:"rules"`
+}
+
+// LoadCalendarRules reads CalendarRules from a JSON file.
+func LoadCalendarRules(path string) (*CalendarRules, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules CalendarRules
+	if err := json.Unmarshal(b, &rules); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return &rules, nil
+}
+
+// ConvertEvents turns calendar events into requests for review, to be
+// created with ImportStartEndTime. All-day, cancelled and declined events
+// are left out and counted in Skipped; events that match no rule are
+// reported as ErrNoRule, and recurring events that weren't expanded with
+// ExpandEvents as ErrRecurrence. rules may be nil, which matches nothing.
+// The summary becomes the notes, and the entries are referenced by the
+// event's UID and RecurrenceID, so that a calendar can be imported again.
+func (im *Importer) ConvertEvents(ctx context.Context, events []Event, rules *CalendarRules) (*Conversion, error) {
+	if rules == nil {
+		rules = &CalendarRules{}
+	}
+
+	loc := time.Local
+
+	if rules.Timezone != "" {
+		l, err := time.LoadLocation(rules.Timezone)
+		if err != nil {
+			return nil, err
+		}
+
+		loc = l
+	}
+
+	me := rules.Me
+	if len(me) == 0 {
+		u, _, err := im.client.User.Current(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		me = []string{u.GetEmail()}
+	}
+
+	var (
+		skipped int
+		errs    []*LineError
+		entries []ForeignEntry
+	)
+
+	urls := map[int]string{}
+
+	for _, ev := range events {
+		if ev.AllDay || ev.Status == "CANCELLED" || declined(ev, me) {
+			skipped++
+
+			continue
+		}
+
+		if ev.RRule != "" && ev.RecurrenceID == "" {
+			errs = append(errs, &LineError{Line: ev.Line, Err: fmt.Errorf("%w: %q", ErrRecurrence, ev.Summary)})
+
+			continue
+		}
+
+		if !ev.End.After(ev.Start) {
+			errs = append(errs, &LineError{Line: ev.Line, Err: fmt.Errorf("%w: event has no duration", ErrHours)})
+
+			continue
+		}
+
+		i := slices.IndexFunc(rules.Rules, func(rule Rule) bool { return rule.match(ev) })
+		if i < 0 {
+			errs = append(errs, &LineError{Line: ev.Line, Err: fmt.Errorf("%w %q", ErrNoRule, ev.Summary)})
+
+			continue
+		}
+
+		rule := rules.Rules[i]
+		client, project, found := strings.Cut(rule.Project, "/")
+
+		if !found {
+			client, project = "", rule.Project
+		}
+
+		fe := ForeignEntry{
+			Line:        ev.Line,
+			Client:      strings.TrimSpace(client),
+			Project:     strings.TrimSpace(project),
+			Task:        rule.Task,
+			Description: ev.Summary,
+			Start:       ev.Start.In(loc),
+			End:         ev.End.In(loc),
+		}
+
+		if ev.UID != "" {
+			fe.ID = ev.UID
+			if ev.RecurrenceID != "" {
+				fe.ID += "/" + ev.RecurrenceID
+			}
+		}
+
+		entries = append(entries, fe)
+		urls[ev.Line] = ev.URL
+	}
+
+	c := im.Convert(ctx, entries, nil)
+	c.Skipped = skipped
+	c.Errors = append(c.Errors, errs...)
+
+	for _, e := range c.Entries {
+		if url := urls[e.Line]; url != "" {
+			e.Request.ExternalReference.Permalink = harvest.String(url)
+		}
+	}
+
+	slices.SortStableFunc(c.Errors, func(a, b *LineError) int { return cmp.Compare(a.Line, b.Line) })
+
+	return c, nil
+}
+
+// declined reports whether one of the addresses in me declined the event.
+func declined(ev Event, me []string) bool {
+	for _, a := range ev.Attendees {
+		if a.PartStat == "DECLINED" && slices.ContainsFunc(me, func(m string) bool { return strings.EqualFold(m, a.Email) }) {
+			return true
+		}
+	}
+
+	return false
+}
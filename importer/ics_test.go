@@ -0,0 +1,259 @@
+package importer_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/becoded/go-harvest/harvest"
+	"github.com/becoded/go-harvest/harvesttest"
+	"github.com/becoded/go-harvest/importer"
+	"github.com/becoded/go-harvest/resolve"
+)
+
+const calendar = `BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//Example//Calendar//EN
+BEGIN:VEVENT
+UID:sync@example.com
+DTSTART;TZID=Europe/Brussels:20240304T090000
+DTEND;TZID=Europe/Brussels:20240304T100000
+SUMMARY:Acme sync\, roadmap
+URL:https://calendar.example.com/event/sync
+ORGANIZER;CN=Test User:mailto:test@example.com
+ATTENDEE;CN="Bob, Acme";PARTSTAT=ACCEPTED:mailto:bob@acm
+ e.com
+BEGIN:VALARM
+ACTION:DISPLAY
+SUMMARY:Reminder
+END:VALARM
+END:VEVENT
+BEGIN:VEVENT
+UID:standup@example.com
+DTSTART:20240304T080000Z
+DURATION:PT15M
+SUMMARY:Daily stand-up
+END:VEVENT
+BEGIN:VEVENT
+UID:offsite@example.com
+DTSTART;VALUE=DATE:20240305
+SUMMARY:Offsite
+END:VEVENT
+BEGIN:VEVENT
+UID:review@example.com
+DTSTART:20240304T130000Z
+DTEND:20240304T140000Z
+SUMMARY:Acme review
+ATTENDEE;PARTSTAT=DECLINED:MAILTO:Test@example.com
+ATTENDEE;PARTSTAT=ACCEPTED:mailto:bob@acme.com
+END:VEVENT
+BEGIN:VEVENT
+UID:cancelled@example.com
+DTSTART:20240304T150000Z
+DTEND:20240304T160000Z
+SUMMARY:Daily stand-up
+STATUS:CANCELLED
+END:VEVENT
+BEGIN:VEVENT
+UID:lunch@example.com
+DTSTART:20240304T110000Z
+DTEND:20240304T120000Z
+SUMMARY:Lunch
+END:VEVENT
+END:VCALENDAR
+`
+
+const calendarRules = `{
+	"timezone": "Europe/Brussels",
+	"rules": [
+		{"attendee": "@acme\\.com$", "project": "Acme / Website", "task": "Meetings"},
+		{"title": "(?i)stand-?up", "project": "Internal", "task": "Meetings"}
+	]
+}`
+
+func TestReadICS(t *testing.T) {
+	t.Parallel()
+
+	events, err := importer.ReadICS(strings.NewReader(strings.ReplaceAll(calendar, "\n", "\r\n")))
+	assert.NoError(t, err)
+	assert.Len(t, events, 6)
+
+	sync := events[0]
+	assert.Equal(t, 4, sync.Line)
+	assert.Equal(t, "Acme sync, roadmap", sync.Summary, "SUMMARY of the alarm is ignored")
+	assert.Equal(t, "test@example.com", sync.Organizer.Email)
+	assert.Equal(t, []importer.Attendee{{Email: "bob@acme.com", Name: "Bob, Acme", PartStat: "ACCEPTED"}}, sync.Attendees)
+	assert.Equal(t, "2024-03-04T09:00:00+01:00", sync.Start.Format(time.RFC3339))
+	assert.Equal(t, time.Hour, sync.End.Sub(sync.Start))
+
+	assert.Equal(t, 15*time.Minute, events[1].End.Sub(events[1].Start))
+	assert.True(t, events[2].AllDay)
+	assert.Equal(t, 24*time.Hour, events[2].End.Sub(events[2].Start))
+
+	events, err = importer.ReadICS(strings.NewReader(
+		"BEGIN:VEVENT\nDTSTART;TZID=W. Europe Standard Time:20240304T090000\nEND:VEVENT\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-03-04T09:00:00+01:00", events[0].Start.Format(time.RFC3339), "Outlook's Windows time zones")
+
+	_, err = importer.ReadICS(strings.NewReader("BEGIN:VEVENT\nDTSTART;TZID=Nowhere:20240304T090000\nEND:VEVENT\n"))
+	assert.ErrorIs(t, err, importer.ErrTime)
+
+	var lineErr *importer.LineError
+	if assert.ErrorAs(t, err, &lineErr) {
+		assert.Equal(t, 2, lineErr.Line)
+	}
+}
+
+const recurring = `BEGIN:VCALENDAR
+BEGIN:VEVENT
+UID:standup@example.com
+DTSTART;TZID=Europe/Brussels:20240325T093000
+DURATION:PT15M
+RRULE:FREQ=WEEKLY;BYDAY=MO,WE;COUNT=6
+EXDATE;TZID=Europe/Brussels:20240327T093000
+SUMMARY:Stand-up
+END:VEVENT
+BEGIN:VEVENT
+UID:standup@example.com
+RECURRENCE-ID;TZID=Europe/Brussels:20240403T093000
+DTSTART;TZID=Europe/Brussels:20240403T110000
+DURATION:PT15M
+SUMMARY:Stand-up (moved)
+END:VEVENT
+BEGIN:VEVENT
+UID:review@example.com
+DTSTART:20240131T140000Z
+DTEND:20240131T150000Z
+RRULE:FREQ=MONTHLY;INTERVAL=1
+SUMMARY:Monthly review
+END:VEVENT
+BEGIN:VEVENT
+UID:planning@example.com
+DTSTART:20240304T100000Z
+DTEND:20240304T110000Z
+RRULE:FREQ=MONTHLY;BYDAY=1MO
+SUMMARY:Planning
+END:VEVENT
+END:VCALENDAR
+`
+
+func TestExpandEvents(t *testing.T) {
+	t.Parallel()
+
+	events, err := importer.ReadICS(strings.NewReader(recurring))
+	assert.NoError(t, err)
+	assert.Equal(t, "20240403T073000Z", events[1].RecurrenceID)
+
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	var got []string
+
+	for _, ev := range importer.ExpandEvents(events, from, to) {
+		got = append(got, ev.Summary+" "+ev.Start.Format(time.RFC3339)+" "+ev.RecurrenceID)
+	}
+
+	assert.Equal(t, []string{
+		"Stand-up 2024-03-25T09:30:00+01:00 20240325T083000Z",
+		"Stand-up 2024-04-01T09:30:00+02:00 20240401T073000Z",
+		"Stand-up 2024-04-08T09:30:00+02:00 20240408T073000Z",
+		"Stand-up 2024-04-10T09:30:00+02:00 20240410T073000Z",
+		"Stand-up (moved) 2024-04-03T11:00:00+02:00 20240403T073000Z",
+		"Monthly review 2024-03-31T14:00:00Z 20240331T140000Z",
+		"Monthly review 2024-05-31T14:00:00Z 20240531T140000Z",
+		"Planning 2024-03-04T10:00:00Z ",
+	}, got, "the EXDATE and the moved occurrence count towards COUNT, months without a 31st are skipped "+
+		"and unsupported rules are kept")
+}
+
+func TestImporter_ConvertEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := harvesttest.NewServer()
+
+	defer srv.Close()
+
+	acme := srv.AddClient(&harvest.Client{Name: harvest.String("Acme")})
+	website := srv.AddProject(&harvest.Project{Client: acme, Name: harvest.String("Website")})
+	internal := srv.AddProject(&harvest.Project{Name: harvest.String("Internal")})
+	meetings := srv.AddTask(&harvest.Task{Name: harvest.String("Meetings")})
+
+	for _, p := range []*harvest.Project{website, internal} {
+		srv.AssignTask(p.GetID(), meetings.GetID())
+		srv.AssignUser(p.GetID(), srv.CurrentUser().GetID())
+	}
+
+	events, err := importer.ReadICS(strings.NewReader(calendar))
+	assert.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "rules.json")
+	assert.NoError(t, os.WriteFile(path, []byte(calendarRules), 0o600))
+
+	rules, err := importer.LoadCalendarRules(path)
+	assert.NoError(t, err)
+
+	im := importer.New(srv.Client(), importer.WithResolver(resolve.New(srv.Client(), resolve.WithFuzzy(false))))
+
+	c, err := im.ConvertEvents(ctx, events, rules)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, c.Skipped, "all-day, declined and cancelled events are skipped")
+	assert.Len(t, c.Entries, 2)
+
+	if assert.Len(t, c.Errors, 1) {
+		assert.ErrorIs(t, c.Errors[0], importer.ErrNoRule)
+	}
+
+	sync := c.Entries[0].Request
+	assert.Equal(t, website.GetID(), sync.GetProjectID())
+	assert.Equal(t, "9:00am", sync.StartedTime.String())
+	assert.Equal(t, "10:00am", sync.EndedTime.String())
+	assert.Equal(t, "Acme sync, roadmap", sync.GetNotes())
+	assert.Equal(t, &harvest.ExternalReference{
+		ID:        harvest.String("sync@example.com"),
+		GroupID:   harvest.String(importer.DefaultSource),
+		Permalink: harvest.String("https://calendar.example.com/event/sync"),
+	}, sync.ExternalReference)
+
+	standup := c.Entries[1].Request
+	assert.Equal(t, internal.GetID(), standup.GetProjectID())
+	assert.Equal(t, "9:00am", standup.StartedTime.String(), "UTC times are tracked in the rules' time zone")
+	assert.Equal(t, "9:15am", standup.EndedTime.String())
+
+	report, err := im.ImportStartEndTime(ctx, c.Entries)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, report.Created)
+
+	c, err = im.ConvertEvents(ctx, events, rules)
+	assert.NoError(t, err)
+
+	report, err = im.ImportStartEndTime(ctx, c.Entries)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, report.Skipped, "events imported before are skipped")
+
+	events, err = importer.ReadICS(strings.NewReader(recurring))
+	assert.NoError(t, err)
+
+	c, err = im.ConvertEvents(ctx, events[:1], rules)
+	assert.NoError(t, err)
+	assert.Empty(t, c.Entries)
+
+	if assert.Len(t, c.Errors, 1) {
+		assert.ErrorIs(t, c.Errors[0], importer.ErrRecurrence, "recurring events aren't booked once")
+	}
+
+	c, err = im.ConvertEvents(ctx, importer.ExpandEvents(events[:2], time.Time{}, time.Now()), rules)
+	assert.NoError(t, err)
+	assert.Len(t, c.Entries, 5)
+	assert.Empty(t, c.Errors)
+	assert.Equal(t, "standup@example.com/20240325T083000Z", c.Entries[0].Request.ExternalReference.GetID())
+
+	c, err = im.ConvertEvents(ctx, events[:2], nil)
+	assert.NoError(t, err)
+	assert.Len(t, c.Errors, 2, "without rules nothing matches")
+}
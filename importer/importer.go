@@ -0,0 +1,487 @@
+// Package importer creates time entries from records kept outside Harvest,
+// such as spreadsheets of historical timesheets.
+//
+// Records are read from CSV or JSON lines, their client, project, task and
+// user names are resolved to IDs, the user's assignments are checked, and the
+// entries are created via duration. Problems are reported per line instead of
+// stopping the import:
+//
+//	records, err := importer.ReadCSV(f)
+//	if err != nil {
+//		return err
+//	}
+//
+//	report, err := importer.New(service, importer.WithDryRun(true)).Import(ctx, records)
+//	if err != nil {
+//		return err
+//	}
+//
+//	fmt.Print(report) // 41 to create, 0 skipped, 1 failed
+//	                  // line 7: project "Webiste" is ambiguous: ...
+//
+// Every created entry carries an ExternalReference that identifies its
+// record, and records whose reference already exists are skipped, so an
+// import can be run again after fixing the failed lines.
+package importer
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/becoded/go-harvest/harvest"
+	"github.com/becoded/go-harvest/resolve"
+	"github.com/becoded/go-harvest/timesheet"
+)
+
+// DefaultSource is the ExternalReference.GroupID of imported entries.
+const DefaultSource = "go-harvest-import"
+
+// perPage is the page size used to list the entries imported before.
+const perPage = 2000
+
+var (
+	// ErrMissing is reported for a record without a required field.
+	ErrMissing = errors.New("missing field")
+	// ErrDate is reported for a date that isn't formatted as YYYY-MM-DD.
+	ErrDate = errors.New("invalid date, expected YYYY-MM-DD")
+	// ErrHours is reported for hours that aren't positive.
+	ErrHours = errors.New("hours must be positive")
+	// ErrDuplicateReference is reported for a record whose Reference was used
+	// by an earlier record.
+	ErrDuplicateReference = errors.New("duplicate reference")
+)
+
+// A Record is a time entry to import, as written in the source file.
+type Record struct {
+	// Line is the line of the source file the record starts on.
+	Line int
+	// Date is the day the time was spent, as YYYY-MM-DD.
+	Date string
+	// Client is optional; it narrows the projects Project is matched against.
+	Client  string
+	Project string
+	Task    string
+	// User is the name or email address of the user who tracked the time.
+	// Empty means the authenticated user.
+	User string
+	// Hours are decimal hours ("1.5"), hours and minutes ("1:30") or a
+	// duration ("90m").
+	Hours string
+	Notes string
+	// Reference identifies the record across imports. If empty, it is
+	// derived from the other fields.
+	Reference string
+}
+
+// A LineError is a problem with the record on Line.
+type LineError struct {
+	Line int
+	Err  error
+}
+
+func (e *LineError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+// A Report is the outcome of an import.
+type Report struct {
+	DryRun bool
+	// Created is the number of entries created, or that would be created in a
+	// dry run.
+	Created int
+	// Skipped is the number of records imported before.
+	Skipped int
+	// Entries are the created entries. They are empty in a dry run.
+	Entries []*harvest.TimeEntry
+	// Errors are the records that couldn't be imported, by line.
+	Errors []*LineError
+}
+
+// Err returns the errors of the report joined, or nil if there are none.
+func (r *Report) Err() error {
+	errs := make([]error, len(r.Errors))
+	for i, err := range r.Errors {
+		errs[i] = err
+	}
+
+	return errors.Join(errs...)
+}
+
+// String returns a summary followed by an error per line.
+func (r *Report) String() string {
+	var b strings.Builder
+
+	created := "created"
+	if r.DryRun {
+		created = "to create"
+	}
+
+	fmt.Fprintf(&b, "%d %s, %d skipped, %d failed\n", r.Created, created, r.Skipped, len(r.Errors))
+
+	for _, err := range r.Errors {
+		b.WriteString(err.Error())
+		b.WriteByte('\n')
+	}
+
+	return b.String()
+}
+
+// Importer creates time entries from records. Create one with New.
+type Importer struct {
+	client   *harvest.APIClient
+	resolver *resolve.Resolver
+	checker  *timesheet.AssignmentChecker
+	dryRun   bool
+	source   string
+	me       *harvest.User
+}
+
+// Option configures an Importer.
+type Option func(*Importer)
+
+// WithDryRun sets whether Import only validates the records, without creating
+// entries. Defaults to false.
+func WithDryRun(dryRun bool) Option {
+	return func(im *Importer) {
+		im.dryRun = dryRun
+	}
+}
+
+// WithSource sets the ExternalReference.GroupID of imported entries, which
+// scopes the references that are checked for duplicates. Defaults to
+// DefaultSource.
+func WithSource(source string) Option {
+	return func(im *Importer) {
+		im.source = source
+	}
+}
+
+// WithResolver sets the resolver for names, to share its cache. Defaults to a
+// new resolve.Resolver.
+func WithResolver(r *resolve.Resolver) Option {
+	return func(im *Importer) {
+		im.resolver = r
+	}
+}
+
+// WithAssignmentChecker sets the checker for assignments, to share its cache.
+// Defaults to a new timesheet.AssignmentChecker.
+func WithAssignmentChecker(c *timesheet.AssignmentChecker) Option {
+	return func(im *Importer) {
+		im.checker = c
+	}
+}
+
+// New returns an Importer that uses client.
+func New(client *harvest.APIClient, opts ...Option) *Importer {
+	im := &Importer{
+		client: client,
+		source: DefaultSource,
+	}
+
+	for _, opt := range opts {
+		opt(im)
+	}
+
+	if im.resolver == nil {
+		im.resolver = resolve.New(client)
+	}
+
+	if im.checker == nil {
+		im.checker = timesheet.NewAssignmentChecker(client)
+	}
+
+	return im
+}
+
+// pending is a request that passed validation.
+type pending struct {
+	line int
+	day  time.Time
+	ref  *harvest.ExternalReference
+	// derived is set for references derived from the record's fields.
+	derived bool
+	create  func(ctx context.Context) (*harvest.TimeEntry, *http.Response, error)
+}
+
+// Import validates the records and creates an entry for each valid record
+// that wasn't imported before. Invalid records and failed requests are
+// reported by line; the returned error is for failures that stop the whole
+// import, such as listing the existing entries.
+func (im *Importer) Import(ctx context.Context, records []Record) (*Report, error) {
+	report := &Report{DryRun: im.dryRun}
+
+	var todo []pending
+
+	for _, rec := range records {
+		req, err := im.request(ctx, rec)
+		if err != nil {
+			report.Errors = append(report.Errors, &LineError{Line: rec.Line, Err: err})
+
+			continue
+		}
+
+		todo = append(todo, pending{
+			line:    rec.Line,
+			day:     req.SpentDate.Time,
+			ref:     req.ExternalReference,
+			derived: strings.TrimSpace(rec.Reference) == "",
+			create: func(ctx context.Context) (*harvest.TimeEntry, *http.Response, error) {
+				return im.client.Timesheet.CreateTimeEntryViaDuration(ctx, req)
+			},
+		})
+	}
+
+	return report, im.run(ctx, report, todo)
+}
+
+// run creates the pending entries that weren't imported before, or only
+// counts them in a dry run.
+func (im *Importer) run(ctx context.Context, report *Report, todo []pending) error {
+	defer slices.SortStableFunc(report.Errors, func(a, b *LineError) int { return cmp.Compare(a.Line, b.Line) })
+
+	seen := map[string]int{}
+
+	var (
+		valid    []pending
+		from, to time.Time
+	)
+
+	for _, p := range todo {
+		// Identical records without a reference are told apart by their
+		// position among each other.
+		id := p.ref.GetID()
+		if seen[id]++; seen[id] > 1 {
+			if !p.derived {
+				report.Errors = append(report.Errors, &LineError{
+					Line: p.line,
+					Err:  fmt.Errorf("%w %q", ErrDuplicateReference, id),
+				})
+
+				continue
+			}
+
+			p.ref.ID = harvest.String(fmt.Sprintf("%s-%d", id, seen[id]))
+		}
+
+		if from.IsZero() || p.day.Before(from) {
+			from = p.day
+		}
+
+		if p.day.After(to) {
+			to = p.day
+		}
+
+		valid = append(valid, p)
+	}
+
+	if len(valid) == 0 {
+		return nil
+	}
+
+	imported, err := im.imported(ctx, from, to)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range valid {
+		if imported[p.ref.GetID()] {
+			report.Skipped++
+
+			continue
+		}
+
+		if im.dryRun {
+			report.Created++
+
+			continue
+		}
+
+		entry, _, err := p.create(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			report.Errors = append(report.Errors, &LineError{Line: p.line, Err: err})
+
+			continue
+		}
+
+		imported[p.ref.GetID()] = true
+		report.Created++
+		report.Entries = append(report.Entries, entry)
+	}
+
+	return nil
+}
+
+// request turns a record into a request, resolving its names and checking
+// the assignments.
+func (im *Importer) request(ctx context.Context, rec Record) (*harvest.TimeEntryCreateViaDuration, error) {
+	for _, f := range []struct{ name, value string }{
+		{"date", rec.Date}, {"project", rec.Project}, {"task", rec.Task}, {"hours", rec.Hours},
+	} {
+		if strings.TrimSpace(f.value) == "" {
+			return nil, fmt.Errorf("%w %q", ErrMissing, f.name)
+		}
+	}
+
+	day, err := time.Parse(time.DateOnly, strings.TrimSpace(rec.Date))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrDate, rec.Date)
+	}
+
+	hours, err := harvest.ParseHours(rec.Hours)
+	if err != nil {
+		return nil, fmt.Errorf("hours %q: %w", rec.Hours, err)
+	}
+
+	if hours <= 0 {
+		return nil, fmt.Errorf("%w: %q", ErrHours, rec.Hours)
+	}
+
+	var clientID, userID int64
+
+	if name := strings.TrimSpace(rec.Client); name != "" {
+		c, err := im.resolver.Client(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+
+		clientID = c.GetID()
+	}
+
+	project, err := im.resolver.Project(ctx, clientID, strings.TrimSpace(rec.Project))
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := im.resolver.Task(ctx, project.GetID(), strings.TrimSpace(rec.Task))
+	if err != nil {
+		return nil, err
+	}
+
+	if name := strings.TrimSpace(rec.User); name != "" {
+		u, err := im.resolver.User(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+
+		userID = u.GetID()
+	}
+
+	if err := im.checker.Check(ctx, userID, project.GetID(), task.GetID()); err != nil {
+		return nil, err
+	}
+
+	req := &harvest.TimeEntryCreateViaDuration{
+		ProjectID: project.ID,
+		TaskID:    task.ID,
+		SpentDate: harvest.DateP(harvest.Date{Time: day}),
+		Hours:     harvest.HoursP(hours),
+	}
+
+	if userID != 0 {
+		req.UserID = harvest.Int64(userID)
+	}
+
+	if notes := strings.TrimSpace(rec.Notes); notes != "" {
+		req.Notes = harvest.String(notes)
+	}
+
+	ref := strings.TrimSpace(rec.Reference)
+	if ref == "" {
+		// Entries without a user are the authenticated user's.
+		if userID == 0 {
+			me, err := im.currentUser(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			userID = me.GetID()
+		}
+
+		ref = reference(userID, req)
+	}
+
+	req.ExternalReference = &harvest.ExternalReference{
+		ID:      harvest.String(ref),
+		GroupID: harvest.String(im.source),
+	}
+
+	return req, nil
+}
+
+// currentUser returns the authenticated user, read once and then reused.
+func (im *Importer) currentUser(ctx context.Context) (*harvest.User, error) {
+	if im.me == nil {
+		me, _, err := im.client.User.Current(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		im.me = me
+	}
+
+	return im.me, nil
+}
+
+// reference derives a reference from the request a record became and the ID
+// of the user the entry is for: a hash of the date, the resolved project,
+// task and user IDs, the hours rounded to minutes and the notes. Records that
+// differ only in how they are written, such as "1.5" and "1:30" hours, a
+// misspelled project, or a client or user column left empty, get the same
+// reference.
+func reference(userID int64, req *harvest.TimeEntryCreateViaDuration) string {
+	h, m := req.GetHours().HoursMinutes()
+	key := fmt.Sprintf("%s\x1f%d\x1f%d\x1f%d\x1f%d:%02d\x1f%s",
+		req.GetSpentDate().Format(time.DateOnly), req.GetProjectID(), req.GetTaskID(), userID,
+		h, m, req.GetNotes())
+
+	sum := sha256.Sum256([]byte(key))
+
+	return hex.EncodeToString(sum[:16])
+}
+
+// imported returns the references of the source's entries between from and
+// to.
+func (im *Importer) imported(ctx context.Context, from, to time.Time) (map[string]bool, error) {
+	refs := map[string]bool{}
+	opt := &harvest.TimeEntryListOptions{
+		From:        harvest.DateP(harvest.Date{Time: from}),
+		To:          harvest.DateP(harvest.Date{Time: to}),
+		ListOptions: harvest.ListOptions{Page: 1, PerPage: perPage},
+	}
+
+	for {
+		list, _, err := im.client.Timesheet.List(ctx, opt)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, e := range list.TimeEntries {
+			if ref := e.GetExternalReference(); ref.GetGroupID() == im.source && ref.GetID() != "" {
+				refs[ref.GetID()] = true
+			}
+		}
+
+		if list.NextPage == nil {
+			return refs, nil
+		}
+
+		opt.Page = *list.NextPage
+	}
+}
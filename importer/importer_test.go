@@ -0,0 +1,212 @@
+package importer_test
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/becoded/go-harvest/harvest"
+	"github.com/becoded/go-harvest/harvesttest"
+	"github.com/becoded/go-harvest/importer"
+	"github.com/becoded/go-harvest/resolve"
+	"github.com/becoded/go-harvest/timesheet"
+)
+
+const timesheetCSV = `Date,Client,Project,Task,User,Hours,Notes
+2024-03-04,Acme,Website,Development,,1:30,Homepage
+2024-03-04,Acme,Website,Development,jane@example.com,2,
+2024-03-05,Acme,Webiste,Development,,1,Typos are forgiven
+2024-03-05,Acme,Website,Development,,1,Typos are forgiven
+2024-03-05,Acme,Website,Development,,1,Typos are forgiven
+2024-03-05,Acme,Website,Design,,1,
+2024-03-06,Acme,Website,Development,Bob,1,
+2024-03-06,Acme,Website,Development,,a lot,
+03/06/2024,Acme,Website,Development,,1,
+`
+
+func TestImporter_Import(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := harvesttest.NewServer()
+
+	defer srv.Close()
+
+	acme := srv.AddClient(&harvest.Client{Name: harvest.String("Acme")})
+	website := srv.AddProject(&harvest.Project{Client: acme, Name: harvest.String("Website")})
+	dev := srv.AddTask(&harvest.Task{Name: harvest.String("Development")})
+	jane := srv.AddUser(&harvest.User{
+		FirstName: harvest.String("Jane"),
+		LastName:  harvest.String("Doe"),
+		Email:     harvest.String("jane@example.com"),
+	})
+	srv.AddUser(&harvest.User{FirstName: harvest.String("Bob")})
+
+	srv.AssignTask(website.GetID(), dev.GetID())
+	srv.AssignUser(website.GetID(), srv.CurrentUser().GetID())
+	srv.AssignUser(website.GetID(), jane.GetID())
+
+	records, err := importer.ReadCSV(strings.NewReader(timesheetCSV))
+	assert.NoError(t, err)
+
+	client := srv.Client()
+	opts := []importer.Option{
+		importer.WithResolver(resolve.New(client)),
+		importer.WithAssignmentChecker(timesheet.NewAssignmentChecker(client)),
+	}
+
+	report, err := importer.New(client, append(opts, importer.WithDryRun(true))...).Import(ctx, records)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, report.Created)
+	assert.Empty(t, report.Entries)
+	assert.Equal(t, `5 to create, 0 skipped, 4 failed
+line 7: no task matches "Design"
+line 8: user is not assigned to active project `+strconv.FormatInt(website.GetID(), 10)+`
+line 9: hours "a lot": ErrHoursParse: should be decimal hours "1.5", hours and minutes "1:30" or a duration "90m"
+line 10: invalid date, expected YYYY-MM-DD: "03/06/2024"
+`, report.String())
+
+	var lineErr *importer.LineError
+
+	assert.ErrorAs(t, report.Err(), &lineErr)
+	assert.Equal(t, 7, lineErr.Line)
+	assert.ErrorIs(t, report.Err(), resolve.ErrNotFound)
+	assert.ErrorIs(t, report.Err(), timesheet.ErrNotAssigned)
+	assert.ErrorIs(t, report.Err(), importer.ErrDate)
+
+	list, _, err := client.Timesheet.List(ctx, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, list.TimeEntries, "a dry run creates nothing")
+
+	report, err = importer.New(client, opts...).Import(ctx, records)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, report.Created)
+	assert.Len(t, report.Errors, 4)
+	assert.Len(t, report.Entries, 5)
+
+	first := report.Entries[0]
+	assert.Equal(t, website.GetID(), first.GetProject().GetID())
+	assert.Equal(t, dev.GetID(), first.GetTask().GetID())
+	assert.Equal(t, harvest.Hours(1.5), first.GetHours())
+	assert.Equal(t, "Homepage", first.GetNotes())
+	assert.Equal(t, importer.DefaultSource, first.GetExternalReference().GetGroupID())
+	assert.Equal(t, jane.GetID(), report.Entries[1].GetUser().GetID())
+
+	refs := map[string]bool{}
+	for _, e := range report.Entries {
+		refs[e.GetExternalReference().GetID()] = true
+	}
+
+	assert.Len(t, refs, 5, "identical records get different references")
+
+	report, err = importer.New(client, opts...).Import(ctx, records)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, report.Created, "records imported before are skipped")
+	assert.Equal(t, 5, report.Skipped)
+
+	list, _, err = client.Timesheet.List(ctx, nil)
+	assert.NoError(t, err)
+	assert.Len(t, list.TimeEntries, 5)
+}
+
+func TestImporter_Import_references(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := harvesttest.NewServer()
+
+	defer srv.Close()
+
+	website := srv.AddProject(&harvest.Project{Name: harvest.String("Website")})
+	dev := srv.AddTask(&harvest.Task{Name: harvest.String("Development")})
+
+	srv.AssignTask(website.GetID(), dev.GetID())
+	srv.AssignUser(website.GetID(), srv.CurrentUser().GetID())
+
+	record := importer.Record{Date: "2024-03-04", Project: "Website", Task: "Development", Hours: "1"}
+	records := []importer.Record{record, record, record}
+
+	records[0].Line, records[0].Reference = 1, "JIRA-1"
+	records[1].Line, records[1].Reference = 2, "JIRA-1"
+	records[2].Line, records[2].Reference = 3, "JIRA-2"
+
+	report, err := importer.New(srv.Client(), importer.WithSource("jira")).Import(ctx, records)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, report.Created)
+	assert.Len(t, report.Errors, 1)
+	assert.ErrorIs(t, report.Errors[0], importer.ErrDuplicateReference)
+	assert.Equal(t, 2, report.Errors[0].Line)
+	assert.Equal(t, "JIRA-1", report.Entries[0].GetExternalReference().GetID())
+	assert.Equal(t, "jira", report.Entries[0].GetExternalReference().GetGroupID())
+
+	report, err = importer.New(srv.Client()).Import(ctx, records[2:])
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.Created, "references of other sources don't count")
+}
+
+func TestImporter_Import_derivedReferences(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := harvesttest.NewServer()
+
+	defer srv.Close()
+
+	website := srv.AddProject(&harvest.Project{Name: harvest.String("Website")})
+	dev := srv.AddTask(&harvest.Task{Name: harvest.String("Development")})
+
+	srv.AssignTask(website.GetID(), dev.GetID())
+	srv.AssignUser(website.GetID(), srv.CurrentUser().GetID())
+
+	im := importer.New(srv.Client())
+
+	report, err := im.Import(ctx, []importer.Record{
+		{Line: 1, Date: "2024-03-04", Project: "Website", Task: "Development", Hours: "1.5", Notes: "Homepage"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.Created)
+
+	report, err = im.Import(ctx, []importer.Record{
+		{Line: 1, Date: "2024-03-04", Project: "website", Task: "development", Hours: "1:30", Notes: " Homepage\n"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.Skipped, "records that differ only in how they are written are the same")
+}
+
+func TestImporter_Import_derivedReferencesOptionalColumns(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := harvesttest.NewServer()
+
+	defer srv.Close()
+
+	acme := srv.AddClient(&harvest.Client{Name: harvest.String("Acme")})
+	website := srv.AddProject(&harvest.Project{Client: acme, Name: harvest.String("Website")})
+	dev := srv.AddTask(&harvest.Task{Name: harvest.String("Development")})
+
+	srv.AssignTask(website.GetID(), dev.GetID())
+	srv.AssignUser(website.GetID(), srv.CurrentUser().GetID())
+
+	im := importer.New(srv.Client())
+
+	report, err := im.Import(ctx, []importer.Record{
+		{Line: 1, Date: "2024-03-04", Project: "Website", Task: "Development", Hours: "1"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.Created)
+
+	for _, rec := range []importer.Record{
+		{Line: 1, Date: "2024-03-04", Client: "Acme", Project: "Website", Task: "Development", Hours: "1"},
+		{Line: 1, Date: "2024-03-04", Project: "Website", Task: "Development", User: "Test User", Hours: "1"},
+		{Line: 1, Date: "2024-03-04", Client: "Acme", Project: "Website", Task: "Development", User: "Test User", Hours: "1"},
+	} {
+		report, err := im.Import(ctx, []importer.Record{rec})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, report.Skipped, "the client is implied by the project and the user defaults to "+
+			"the authenticated one: %+v", rec)
+	}
+}
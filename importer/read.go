@@ -0,0 +1,145 @@
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrHeader is returned by ReadCSV for a header without a required column.
+var ErrHeader = errors.New("missing column")
+
+// ReadCSV reads records from CSV with a header row. Columns are matched by
+// name, ignoring case: date, client, project, task, user, hours, notes and
+// reference. Other columns are ignored. Hours are parsed with
+// harvest.ParseHours, which doesn't know the company's number format, so
+// hours such as "1,50" written by the export package aren't read back.
+func ReadCSV(r io.Reader) ([]Record, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := columns(header, "date", "project", "task", "hours")
+	if err != nil {
+		return nil, err
+	}
+
+	var records []Record
+
+	for {
+		row, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := cr.FieldPos(0)
+		field := func(name string) string {
+			if i, ok := index[name]; ok && i < len(row) {
+				return row[i]
+			}
+
+			return ""
+		}
+
+		records = append(records, Record{
+			Line:      line,
+			Date:      field("date"),
+			Client:    field("client"),
+			Project:   field("project"),
+			Task:      field("task"),
+			User:      field("user"),
+			Hours:     field("hours"),
+			Notes:     field("notes"),
+			Reference: field("reference"),
+		})
+	}
+}
+
+// columns returns the index of each column by its lowercased name, and
+// fails if one of the required columns is missing.
+func columns(header []string, required ...string) (map[string]int, error) {
+	index := map[string]int{}
+
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if _, ok := index[name]; !ok {
+			index[name] = i
+		}
+	}
+
+	for _, name := range required {
+		if _, ok := index[name]; !ok {
+			return nil, fmt.Errorf("%w %q", ErrHeader, name)
+		}
+	}
+
+	return index, nil
+}
+
+// jsonRecord is a line of JSON lines input. Hours may be a number or a
+// string.
+type jsonRecord struct {
+	Date      string          `json:"date"`
+	Client    string          `json:"client"`
+	Project   string          `json:"project"`
+	Task      string          `json:"task"`
+	User      string          `json:"user"`
+	Hours     json.RawMessage `json:"hours"`
+	Notes     string          `json:"notes"`
+	Reference string          `json:"reference"`
+}
+
+// ReadJSONLines reads records from JSON lines: an object per line with the
+// fields date, client, project, task, user, hours, notes and reference.
+// Hours may be a number or a string. Blank lines are skipped; a line that
+// isn't a JSON object is returned as a *LineError.
+func ReadJSONLines(r io.Reader) ([]Record, error) {
+	var records []Record
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20) //nolint: mnd
+
+	for line := 1; scanner.Scan(); line++ {
+		b := bytes.TrimSpace(scanner.Bytes())
+		if len(b) == 0 {
+			continue
+		}
+
+		var jr jsonRecord
+		if err := json.Unmarshal(b, &jr); err != nil {
+			return nil, &LineError{Line: line, Err: err}
+		}
+
+		var hours string
+		if err := json.Unmarshal(jr.Hours, &hours); err != nil {
+			hours = string(jr.Hours)
+		}
+
+		records = append(records, Record{
+			Line:      line,
+			Date:      jr.Date,
+			Client:    jr.Client,
+			Project:   jr.Project,
+			Task:      jr.Task,
+			User:      jr.User,
+			Hours:     hours,
+			Notes:     jr.Notes,
+			Reference: jr.Reference,
+		})
+	}
+
+	return records, scanner.Err()
+}
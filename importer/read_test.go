@@ -0,0 +1,82 @@
+package importer_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/becoded/go-harvest/importer"
+)
+
+func TestReadCSV(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		in      string
+		want    []importer.Record
+		wantErr error
+	}{
+		{
+			name: "columns by name",
+			in: "\ufeffHours,Task,Project,Date,Billable?,Reference\n" +
+				"1.5,Development,Website,2024-03-04,Yes,JIRA-1\n" +
+				"2,Design,Website,2024-03-05,No,\n",
+			want: []importer.Record{
+				{Line: 2, Date: "2024-03-04", Project: "Website", Task: "Development", Hours: "1.5", Reference: "JIRA-1"},
+				{Line: 3, Date: "2024-03-05", Project: "Website", Task: "Design", Hours: "2"},
+			},
+		},
+		{
+			name: "multi-line notes",
+			in: "date,project,task,hours,notes\n" +
+				"2024-03-04,Website,Development,1,\"first\nsecond\"\n" +
+				"2024-03-05,Website,Development,1\n",
+			want: []importer.Record{
+				{Line: 2, Date: "2024-03-04", Project: "Website", Task: "Development", Hours: "1", Notes: "first\nsecond"},
+				{Line: 4, Date: "2024-03-05", Project: "Website", Task: "Development", Hours: "1"},
+			},
+		},
+		{
+			name:    "missing column",
+			in:      "date,project,hours\n2024-03-04,Website,1\n",
+			wantErr: importer.ErrHeader,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := importer.ReadCSV(strings.NewReader(tt.in))
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReadJSONLines(t *testing.T) {
+	t.Parallel()
+
+	in := `{"date": "2024-03-04", "client": "Acme", "project": "Website", "task": "Development", "hours": 1.5}
+
+{"date": "2024-03-05", "project": "Website", "task": "Design", "hours": "1:30", "user": "Jane", "notes": "Logo"}
+{"date": "2024-03-06", "project": "Website", "task": "Design"}
+`
+
+	got, err := importer.ReadJSONLines(strings.NewReader(in))
+	assert.NoError(t, err)
+	assert.Equal(t, []importer.Record{
+		{Line: 1, Date: "2024-03-04", Client: "Acme", Project: "Website", Task: "Development", Hours: "1.5"},
+		{Line: 3, Date: "2024-03-05", Project: "Website", Task: "Design", User: "Jane", Hours: "1:30", Notes: "Logo"},
+		{Line: 4, Date: "2024-03-06", Project: "Website", Task: "Design"},
+	}, got)
+
+	_, err = importer.ReadJSONLines(strings.NewReader(in + "not json\n"))
+
+	var lineErr *importer.LineError
+
+	assert.ErrorAs(t, err, &lineErr)
+	assert.Equal(t, 5, lineErr.Line)
+}
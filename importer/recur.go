@@ -0,0 +1,228 @@
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// errRRule is returned for a recurrence rule ExpandEvents can't expand.
+var errRRule = errors.New("unsupported recurrence rule")
+
+// ExpandEvents returns the events that start in [from, to), with each
+// recurring event replaced by its occurrences. Occurrences get the
+// RecurrenceID of their start, leave out the ExDates and make way for the
+// modified occurrences found in events, which share the UID of the
+// recurring event and have the RecurrenceID of the occurrence they replace.
+//
+// Rules are expanded by FREQ, INTERVAL, COUNT, UNTIL, WKST and BYDAY without
+// ordinals, such as "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH". Events with other
+// rules are kept as they are, for ConvertEvents to report.
+func ExpandEvents(events []Event, from, to time.Time) []Event {
+	modified := map[string]bool{}
+
+	for _, ev := range events {
+		if ev.RecurrenceID != "" {
+			modified[ev.UID+"/"+ev.RecurrenceID] = true
+		}
+	}
+
+	var expanded []Event
+
+	for _, ev := range events {
+		if ev.RRule == "" || ev.RecurrenceID != "" {
+			if !ev.Start.Before(from) && ev.Start.Before(to) {
+				expanded = append(expanded, ev)
+			}
+
+			continue
+		}
+
+		rule, err := parseRRule(ev.RRule, ev.Start.Location())
+		if err != nil {
+			if ev.Start.Before(to) {
+				expanded = append(expanded, ev)
+			}
+
+			continue
+		}
+
+		length := ev.End.Sub(ev.Start)
+
+		rule.each(ev.Start, to, func(start time.Time) {
+			id := recurrenceID(start, ev.AllDay)
+			if start.Before(from) || modified[ev.UID+"/"+id] || slices.ContainsFunc(ev.ExDates, start.Equal) {
+				return
+			}
+
+			occ := ev
+			occ.Start, occ.End = start, start.Add(length)
+			occ.RecurrenceID = id
+			occ.RRule, occ.ExDates = "", nil
+
+			expanded = append(expanded, occ)
+		})
+	}
+
+	return expanded
+}
+
+// recurrenceID formats the RecurrenceID of an occurrence starting at t.
+func recurrenceID(t time.Time, allDay bool) string {
+	if allDay {
+		return t.Format("20060102")
+	}
+
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// An rrule is a parsed RRULE.
+type rrule struct {
+	freq      string
+	interval  int
+	count     int
+	until     time.Time
+	byDay     []time.Weekday
+	weekStart time.Weekday
+}
+
+var weekdays = map[string]time.Weekday{ //nolint: gochecknoglobals
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+// parseRRule parses the parts of a rule that each supports. Floating UNTIL
+// times are read in loc.
+func parseRRule(s string, loc *time.Location) (*rrule, error) {
+	rule := &rrule{interval: 1, weekStart: time.Monday}
+
+	for part := range strings.SplitSeq(s, ";") {
+		name, value, _ := strings.Cut(part, "=")
+
+		var err error
+
+		switch strings.ToUpper(name) {
+		case "FREQ":
+			rule.freq = strings.ToUpper(value)
+		case "INTERVAL":
+			rule.interval, err = strconv.Atoi(value)
+			if rule.interval < 1 {
+				err = errRRule
+			}
+		case "COUNT":
+			rule.count, err = strconv.Atoi(value)
+		case "UNTIL":
+			rule.until, err = parseICSTimeIn(value, loc)
+			if len(value) == len("20060102") {
+				rule.until = rule.until.AddDate(0, 0, 1).Add(-time.Nanosecond)
+			}
+		case "WKST":
+			var ok bool
+			if rule.weekStart, ok = weekdays[strings.ToUpper(value)]; !ok {
+				err = errRRule
+			}
+		case "BYDAY":
+			for day := range strings.SplitSeq(value, ",") {
+				wd, ok := weekdays[strings.ToUpper(day)]
+				if !ok {
+					err = errRRule
+
+					break
+				}
+
+				rule.byDay = append(rule.byDay, wd)
+			}
+		default:
+			err = errRRule
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%w %q", errRRule, s)
+		}
+	}
+
+	switch rule.freq {
+	case "DAILY", "WEEKLY":
+	case "MONTHLY", "YEARLY":
+		if len(rule.byDay) > 0 {
+			return nil, fmt.Errorf("%w %q", errRRule, s)
+		}
+	default:
+		return nil, fmt.Errorf("%w %q", errRRule, s)
+	}
+
+	return rule, nil
+}
+
+// each calls fn with the start of every occurrence before to, beginning at
+// start. Occurrences keep the wall clock of start across daylight saving
+// changes; monthly and yearly ones skip the months that lack its day.
+func (rule *rrule) each(start, to time.Time, fn func(time.Time)) {
+	year, month, day := start.Date()
+	hour, minute, sec := start.Clock()
+
+	at := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, hour, minute, sec, start.Nanosecond(), start.Location())
+	}
+
+	days := rule.byDay
+	if len(days) == 0 && rule.freq == "WEEKLY" {
+		days = []time.Weekday{start.Weekday()}
+	}
+
+	const week = 7
+
+	n := 0
+
+	for k := 0; ; k++ {
+		var (
+			period     time.Time
+			candidates []time.Time
+		)
+
+		switch rule.freq {
+		case "DAILY":
+			period = at(year, month, day+k*rule.interval)
+			if len(days) == 0 || slices.Contains(days, period.Weekday()) {
+				candidates = append(candidates, period)
+			}
+		case "WEEKLY":
+			first := day - (int(start.Weekday())-int(rule.weekStart)+week)%week + k*week*rule.interval
+			period = at(year, month, first)
+
+			for i := range week {
+				t := at(year, month, first+i)
+				if slices.Contains(days, t.Weekday()) && !t.Before(start) {
+					candidates = append(candidates, t)
+				}
+			}
+		case "MONTHLY":
+			period = at(year, month+time.Month(k*rule.interval), 1)
+			if t := at(year, month+time.Month(k*rule.interval), day); t.Day() == day {
+				candidates = append(candidates, t)
+			}
+		case "YEARLY":
+			period = at(year+k*rule.interval, 1, 1)
+			if t := at(year+k*rule.interval, month, day); t.Day() == day {
+				candidates = append(candidates, t)
+			}
+		}
+
+		if !period.Before(to) || (!rule.until.IsZero() && period.After(rule.until)) {
+			return
+		}
+
+		for _, t := range candidates {
+			if !t.Before(to) || (rule.count > 0 && n == rule.count) || (!rule.until.IsZero() && t.After(rule.until)) {
+				return
+			}
+
+			n++
+
+			fn(t)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package importer
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultClockifyDateLayout is the date format of Clockify's CSV export for
+// users with the default US date setting.
+const DefaultClockifyDateLayout = "01/02/2006"
+
+// ErrTime is returned for a start or end that can't be parsed.
+var ErrTime = errors.New("invalid date or time")
+
+// clockLayouts are the time of day formats of Toggl and Clockify exports,
+// which follow the exporting user's 12 or 24 hour clock setting.
+var clockLayouts = []string{ //nolint: gochecknoglobals
+	"15:04:05", "15:04", "3:04:05 PM", "3:04 PM", "3:04:05PM", "3:04PM",
+}
+
+// ReadTogglCSV reads the detailed CSV export of Toggl Track, with the
+// columns User, Email, Client, Project, Task, Description, Tags, Start date,
+// Start time, End date and End time. Rows whose start or end can't be parsed
+// are left out and reported as a *LineError each, joined in the error that
+// is returned along with the other entries.
+func ReadTogglCSV(r io.Reader) ([]ForeignEntry, error) {
+	return readTrackerCSV(r, time.DateOnly)
+}
+
+// ReadClockifyCSV reads the detailed CSV export of Clockify, with the columns
+// User, Email, Client, Project, Task, Description, Tags, Start Date, Start
+// Time, End Date and End Time. Dates are formatted as set in the exporting
+// user's preferences; pass their layout, or "" for
+// DefaultClockifyDateLayout. Rows that can't be parsed are reported as in
+// ReadTogglCSV.
+func ReadClockifyCSV(r io.Reader, dateLayout string) ([]ForeignEntry, error) {
+	if dateLayout == "" {
+		dateLayout = DefaultClockifyDateLayout
+	}
+
+	return readTrackerCSV(r, dateLayout)
+}
+
+// togglEntry is an entry of Toggl's detailed report JSON.
+type togglEntry struct {
+	ID          int64     `json:"id"`
+	User        string    `json:"user"`
+	Email       string    `json:"email"`
+	Client      string    `json:"client"`
+	Project     string    `json:"project"`
+	Task        string    `json:"task"`
+	Description string    `json:"description"`
+	Tags        []string  `json:"tags"`
+	Start       time.Time `json:"start"`
+	End         time.Time `json:"end"`
+}
+
+// ReadTogglJSON reads Toggl Track's detailed report JSON: either the report,
+// an object with the entries in "data", or the entries as an array. Start
+// and end are RFC 3339 times; entries without an end are still running and
+// are skipped.
+func ReadTogglJSON(r io.Reader) ([]ForeignEntry, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []togglEntry
+
+	if err := json.Unmarshal(b, &entries); err != nil {
+		var report struct {
+			Data []togglEntry `json:"data"`
+		}
+
+		if err := json.Unmarshal(b, &report); err != nil {
+			return nil, err
+		}
+
+		entries = report.Data
+	}
+
+	var fes []ForeignEntry
+
+	for i, e := range entries {
+		if e.End.IsZero() {
+			continue
+		}
+
+		fe := ForeignEntry{
+			Line:        i + 1,
+			User:        e.User,
+			Email:       e.Email,
+			Client:      e.Client,
+			Project:     e.Project,
+			Task:        e.Task,
+			Description: e.Description,
+			Tags:        e.Tags,
+			Start:       e.Start,
+			End:         e.End,
+		}
+
+		if e.ID != 0 {
+			fe.ID = "toggl:" + strconv.FormatInt(e.ID, 10)
+		}
+
+		fes = append(fes, fe)
+	}
+
+	return fes, nil
+}
+
+// readTrackerCSV reads the CSV exports of Toggl and Clockify, which share
+// their column names up to case.
+func readTrackerCSV(r io.Reader, dateLayout string) ([]ForeignEntry, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := columns(header, "project", "start date", "start time", "end date", "end time")
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		entries []ForeignEntry
+		errs    []error
+	)
+
+	for {
+		row, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return entries, errors.Join(errs...)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := cr.FieldPos(0)
+		field := func(name string) string {
+			if i, ok := index[name]; ok && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+
+			return ""
+		}
+
+		start, err := parseDateTime(dateLayout, field("start date"), field("start time"))
+		if err != nil {
+			errs = append(errs, &LineError{Line: line, Err: err})
+
+			continue
+		}
+
+		end, err := parseDateTime(dateLayout, field("end date"), field("end time"))
+		if err != nil {
+			errs = append(errs, &LineError{Line: line, Err: err})
+
+			continue
+		}
+
+		var tags []string
+
+		for tag := range strings.SplitSeq(field("tags"), ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				tags = append(tags, tag)
+			}
+		}
+
+		entries = append(entries, ForeignEntry{
+			Line:        line,
+			User:        field("user"),
+			Email:       field("email"),
+			Client:      field("client"),
+			Project:     field("project"),
+			Task:        field("task"),
+			Description: field("description"),
+			Tags:        tags,
+			Start:       start,
+			End:         end,
+		})
+	}
+}
+
+func parseDateTime(dateLayout, date, clock string) (time.Time, error) {
+	for _, layout := range clockLayouts {
+		t, err := time.Parse(dateLayout+" "+layout, date+" "+strings.ToUpper(clock))
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("%w: %q", ErrTime, date+" "+clock)
+}
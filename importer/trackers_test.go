@@ -0,0 +1,112 @@
+package importer_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/becoded/go-harvest/importer"
+)
+
+func TestReadTogglCSV(t *testing.T) {
+	t.Parallel()
+
+	in := `User,Email,Client,Project,Task,Description,Billable,Start date,Start time,End date,End time,Duration,Tags
+Jane Doe,jane@example.com,Acme,Website,,Homepage,Yes,2024-03-04,09:00:00,2024-03-04,10:30:00,01:30:00,"design, review"
+Jane Doe,jane@example.com,Acme,Website,,Footer,Yes,2024-03-04,soon,2024-03-04,11:30:00,01:00:00,
+Jane Doe,jane@example.com,Acme,Website,,Menu,Yes,2024-03-04,11:30:00,2024-03-04,,,
+`
+
+	got, err := importer.ReadTogglCSV(strings.NewReader(in))
+	assert.ErrorIs(t, err, importer.ErrTime)
+	assert.Equal(t, "line 3: invalid date or time: \"2024-03-04 soon\"\n"+
+		"line 4: invalid date or time: \"2024-03-04 \"", err.Error(), "bad rows are reported and skipped")
+	assert.Equal(t, []importer.ForeignEntry{{
+		Line:        2,
+		User:        "Jane Doe",
+		Email:       "jane@example.com",
+		Client:      "Acme",
+		Project:     "Website",
+		Description: "Homepage",
+		Tags:        []string{"design", "review"},
+		Start:       time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC),
+		End:         time.Date(2024, 3, 4, 10, 30, 0, 0, time.UTC),
+	}}, got)
+}
+
+func TestReadClockifyCSV(t *testing.T) {
+	t.Parallel()
+
+	const header = "Project,Client,Description,Task,User,Group,Email,Tags,Billable," +
+		"Start Date,Start Time,End Date,End Time\n"
+
+	tests := []struct {
+		name       string
+		row        string
+		dateLayout string
+		want       time.Time
+		wantErr    error
+	}{
+		{
+			name: "default layout, 12 hour clock",
+			row:  "Website,Acme,Homepage,Development,Jane Doe,,,,Yes,03/04/2024,01:15:00 PM,03/04/2024,02:00:00 PM",
+			want: time.Date(2024, 3, 4, 13, 15, 0, 0, time.UTC),
+		},
+		{
+			name:       "custom layout, 24 hour clock",
+			row:        "Website,Acme,Homepage,Development,Jane Doe,,,,Yes,04.03.2024,13:15,04.03.2024,14:00",
+			dateLayout: "02.01.2006",
+			want:       time.Date(2024, 3, 4, 13, 15, 0, 0, time.UTC),
+		},
+		{
+			name:    "wrong layout",
+			row:     "Website,Acme,Homepage,Development,Jane Doe,,,,Yes,04.03.2024,13:15,04.03.2024,14:00",
+			wantErr: importer.ErrTime,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := importer.ReadClockifyCSV(strings.NewReader(header+tt.row+"\n"), tt.dateLayout)
+			if tt.wantErr != nil {
+				var lineErr *importer.LineError
+
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorAs(t, err, &lineErr)
+				assert.Equal(t, 2, lineErr.Line)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, got, 1)
+			assert.Equal(t, tt.want, got[0].Start)
+			assert.Equal(t, "Development", got[0].Task)
+		})
+	}
+}
+
+func TestReadTogglJSON(t *testing.T) {
+	t.Parallel()
+
+	entry := `{"id": 42, "user": "Jane Doe", "client": "Acme", "project": "Website", "description": "Homepage",
+		"tags": ["design"], "start": "2024-03-04T09:00:00+01:00", "end": "2024-03-04T10:30:00+01:00"}`
+	running := `{"id": 43, "project": "Website", "start": "2024-03-04T11:00:00+01:00", "end": null}`
+
+	for _, in := range []string{
+		`[` + entry + `, ` + running + `]`,
+		`{"total_count": 2, "data": [` + entry + `, ` + running + `]}`,
+	} {
+		got, err := importer.ReadTogglJSON(strings.NewReader(in))
+		assert.NoError(t, err)
+		assert.Len(t, got, 1, "running entries are skipped")
+		assert.Equal(t, "toggl:42", got[0].ID)
+		assert.Equal(t, 1, got[0].Line)
+		assert.Equal(t, []string{"design"}, got[0].Tags)
+		assert.Equal(t, "09:00", got[0].Start.Format("15:04"))
+	}
+}
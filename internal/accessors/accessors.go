@@ -0,0 +1,338 @@
+// Package accessors generates nil-safe getters for the pointer fields of the
+// structs in a Go package. It is used to produce harvest/accessors.go.
+package accessors
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// ErrNoPackage is returned when the directory contains no Go package to scan.
+var ErrNoPackage = errors.New("accessors: no Go package found")
+
+// OutputFile is the name of the generated file inside the package directory.
+const OutputFile = "accessors.go"
+
+// skipStructs lists struct types that are not models and get no accessors.
+var skipStructs = map[string]bool{ //nolint: gochecknoglobals
+	"APIClient": true,
+}
+
+// valueStructs lists struct types that behave like values, so their accessors
+// dereference the pointer instead of returning it.
+var valueStructs = map[string]bool{ //nolint: gochecknoglobals
+	"Date": true,
+	"Time": true,
+}
+
+// basicZero holds the zero value literals for predeclared and well-known types.
+var basicZero = map[string]string{ //nolint: gochecknoglobals
+	"bool":          "false",
+	"int":           "0",
+	"int64":         "0",
+	"float64":       "0",
+	"string":        `""`,
+	"time.Time":     "time.Time{}",
+	"time.Duration": "0",
+}
+
+type getter struct {
+	Receiver   string
+	StructName string
+	FieldName  string
+	FieldType  string
+	ZeroValue  string
+	Deref      bool
+}
+
+type pkgInfo struct {
+	name    string
+	structs map[string]*ast.StructType
+	named   map[string]ast.Expr
+	methods map[string]map[string]bool
+}
+
+// Generate parses the Go package in dir and returns the formatted source of
+// the accessors file. Test files and the previously generated file are ignored.
+func Generate(dir string) ([]byte, error) {
+	info, err := parsePackage(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(info.structs))
+	for name := range info.structs {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var getters []getter
+
+	usesTime := false
+
+	for _, name := range names {
+		if skipStructs[name] || !ast.IsExported(name) || valueStructs[name] {
+			continue
+		}
+
+		for _, field := range info.structs[name].Fields.List {
+			for _, fieldName := range field.Names {
+				if !fieldName.IsExported() || info.methods[name]["Get"+fieldName.Name] {
+					continue
+				}
+
+				g, ok := info.newGetter(name, fieldName.Name, field.Type)
+				if !ok {
+					continue
+				}
+
+				if strings.Contains(g.FieldType, "time.") {
+					usesTime = true
+				}
+
+				getters = append(getters, g)
+			}
+		}
+	}
+
+	sort.SliceStable(getters, func(i, j int) bool {
+		if getters[i].StructName != getters[j].StructName {
+			return getters[i].StructName < getters[j].StructName
+		}
+
+		return getters[i].FieldName < getters[j].FieldName
+	})
+
+	var buf bytes.Buffer
+
+	err = sourceTmpl.Execute(&buf, struct {
+		Package  string
+		UsesTime bool
+		Getters  []getter
+	}{info.name, usesTime, getters})
+	if err != nil {
+		return nil, err
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+func parsePackage(dir string) (*pkgInfo, error) {
+	fset := token.NewFileSet()
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	info := &pkgInfo{
+		structs: map[string]*ast.StructType{},
+		named:   map[string]ast.Expr{},
+		methods: map[string]map[string]bool{},
+	}
+
+	for _, path := range files {
+		base := filepath.Base(path)
+		if strings.HasSuffix(base, "_test.go") || base == OutputFile {
+			continue
+		}
+
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		f, err := parser.ParseFile(fset, path, src, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+
+		if strings.HasSuffix(f.Name.Name, "_test") || f.Name.Name == "main" {
+			continue
+		}
+
+		info.name = f.Name.Name
+		info.collect(f)
+	}
+
+	if info.name == "" {
+		return nil, fmt.Errorf("%w: %s", ErrNoPackage, dir)
+	}
+
+	return info, nil
+}
+
+func (p *pkgInfo) collect(f *ast.File) {
+	for _, decl := range f.Decls {
+		switch d := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				ts, ok := spec.(*ast.TypeSpec)
+				if !ok {
+					continue
+				}
+
+				p.named[ts.Name.Name] = ts.Type
+				if st, ok := ts.Type.(*ast.StructType); ok {
+					p.structs[ts.Name.Name] = st
+				}
+			}
+		case *ast.FuncDecl:
+			if d.Recv == nil || len(d.Recv.List) == 0 {
+				continue
+			}
+
+			recv := d.Recv.List[0].Type
+			if star, ok := recv.(*ast.StarExpr); ok {
+				recv = star.X
+			}
+
+			if ident, ok := recv.(*ast.Ident); ok {
+				if p.methods[ident.Name] == nil {
+					p.methods[ident.Name] = map[string]bool{}
+				}
+
+				p.methods[ident.Name][d.Name.Name] = true
+			}
+		}
+	}
+}
+
+// newGetter describes the accessor for a field of type expr. Only pointer
+// fields get an accessor.
+func (p *pkgInfo) newGetter(structName, fieldName string, expr ast.Expr) (getter, bool) {
+	star, ok := expr.(*ast.StarExpr)
+	if !ok {
+		return getter{}, false
+	}
+
+	g := getter{
+		Receiver:   strings.ToLower(structName[:1]),
+		StructName: structName,
+		FieldName:  fieldName,
+		Deref:      true,
+	}
+
+	switch x := star.X.(type) {
+	case *ast.Ident:
+		if zero, ok := basicZero[x.Name]; ok {
+			g.FieldType, g.ZeroValue = x.Name, zero
+
+			return g, true
+		}
+
+		underlying, ok := p.named[x.Name]
+		if !ok {
+			return getter{}, false
+		}
+
+		switch u := underlying.(type) {
+		case *ast.StructType:
+			if valueStructs[x.Name] {
+				g.FieldType, g.ZeroValue = x.Name, x.Name+"{}"
+
+				return g, true
+			}
+
+			g.FieldType, g.ZeroValue, g.Deref = "*"+x.Name, "nil", false
+
+			return g, true
+		case *ast.Ident:
+			if zero, ok := basicZero[u.Name]; ok {
+				g.FieldType, g.ZeroValue = x.Name, zero
+
+				return g, true
+			}
+		}
+	case *ast.SelectorExpr:
+		name := selectorName(x)
+		if zero, ok := basicZero[name]; ok {
+			g.FieldType, g.ZeroValue = name, zero
+
+			return g, true
+		}
+	case *ast.ArrayType:
+		if x.Len != nil {
+			return getter{}, false
+		}
+
+		elem, ok := elemName(x.Elt)
+		if !ok {
+			return getter{}, false
+		}
+
+		g.FieldType, g.ZeroValue = "[]"+elem, "nil"
+
+		return g, true
+	}
+
+	return getter{}, false
+}
+
+func selectorName(x *ast.SelectorExpr) string {
+	pkg, ok := x.X.(*ast.Ident)
+	if !ok {
+		return ""
+	}
+
+	return pkg.Name + "." + x.Sel.Name
+}
+
+func elemName(expr ast.Expr) (string, bool) {
+	switch x := expr.(type) {
+	case *ast.Ident:
+		return x.Name, true
+	case *ast.StarExpr:
+		name, ok := elemName(x.X)
+
+		return "*" + name, ok
+	case *ast.SelectorExpr:
+		name := selectorName(x)
+
+		return name, name != ""
+	}
+
+	return "", false
+}
+
+var sourceTmpl = template.Must(template.New("source").Parse(`// Code generated by gen-accessors; DO NOT EDIT.
+// Instead, edit the struct definitions and run "mage generate".
+
+package {{.Package}}
+{{if .UsesTime}}
+import "time"
+{{end}}
+{{range .Getters}}
+{{if .Deref -}}
+// Get{{.FieldName}} returns the {{.FieldName}} field if it's non-nil, zero value otherwise.
+{{- else -}}
+// Get{{.FieldName}} returns the {{.FieldName}} field, or nil if {{.StructName}} is nil.
+{{- end}}
+func ({{.Receiver}} *{{.StructName}}) Get{{.FieldName}}() {{.FieldType}} {
+{{- if .Deref}}
+	if {{.Receiver}} == nil || {{.Receiver}}.{{.FieldName}} == nil {
+		return {{.ZeroValue}}
+	}
+
+	return *{{.Receiver}}.{{.FieldName}}
+{{- else}}
+	if {{.Receiver}} == nil {
+		return nil
+	}
+
+	return {{.Receiver}}.{{.FieldName}}
+{{- end}}
+}
+{{end}}
+`)) //nolint: gochecknoglobals
@@ -0,0 +1,131 @@
+package accessors_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/becoded/go-harvest/internal/accessors"
+)
+
+const source = `package sample
+
+import "time"
+
+type Hours float64
+
+type Date struct {
+	time.Time
+}
+
+type Owner struct {
+	Name *string
+}
+
+type Model struct {
+	ID        *int64
+	Owner     *Owner
+	Hours     *Hours
+	SpentDate *Date
+	Tags      *[]string
+	Created   *time.Time
+	Plain     string
+	hidden    *string
+	Custom    *string
+}
+
+func (m *Model) GetCustom() string { return "custom" }
+`
+
+const want = `// Code generated by gen-accessors; DO NOT EDIT.
+// Instead, edit the struct definitions and run "mage generate".
+
+package sample
+
+import "time"
+
+// GetCreated returns the Created field if it's non-nil, zero value otherwise.
+func (m *Model) GetCreated() time.Time {
+	if m == nil || m.Created == nil {
+		return time.Time{}
+	}
+
+	return *m.Created
+}
+
+// GetHours returns the Hours field if it's non-nil, zero value otherwise.
+func (m *Model) GetHours() Hours {
+	if m == nil || m.Hours == nil {
+		return 0
+	}
+
+	return *m.Hours
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *Model) GetID() int64 {
+	if m == nil || m.ID == nil {
+		return 0
+	}
+
+	return *m.ID
+}
+
+// GetOwner returns the Owner field, or nil if Model is nil.
+func (m *Model) GetOwner() *Owner {
+	if m == nil {
+		return nil
+	}
+
+	return m.Owner
+}
+
+// GetSpentDate returns the SpentDate field if it's non-nil, zero value otherwise.
+func (m *Model) GetSpentDate() Date {
+	if m == nil || m.SpentDate == nil {
+		return Date{}
+	}
+
+	return *m.SpentDate
+}
+
+// GetTags returns the Tags field if it's non-nil, zero value otherwise.
+func (m *Model) GetTags() []string {
+	if m == nil || m.Tags == nil {
+		return nil
+	}
+
+	return *m.Tags
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (o *Owner) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+
+	return *o.Name
+}
+`
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	err := os.WriteFile(filepath.Join(dir, "sample.go"), []byte(source), 0o600)
+	assert.NoError(t, err)
+
+	got, err := accessors.Generate(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, want, string(got))
+}
+
+func TestGenerate_NoPackage(t *testing.T) {
+	t.Parallel()
+
+	_, err := accessors.Generate(t.TempDir())
+	assert.ErrorIs(t, err, accessors.ErrNoPackage)
+}
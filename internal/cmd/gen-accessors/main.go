@@ -0,0 +1,32 @@
+// gen-accessors generates nil-safe GetX accessors for every pointer field of
+// the structs in a package.
+//
+// It is meant to be used by go:generate:
+//
+//	//go:generate go run ../internal/cmd/gen-accessors
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/becoded/go-harvest/internal/accessors"
+)
+
+func main() {
+	dir := flag.String("dir", ".", "directory of the package to generate accessors for")
+	flag.Parse()
+
+	src, err := accessors.Generate(*dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	const perm = 0o600
+
+	if err := os.WriteFile(filepath.Join(*dir, accessors.OutputFile), src, perm); err != nil {
+		log.Fatal(err)
+	}
+}
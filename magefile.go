@@ -144,7 +144,7 @@ func getPackages() ([]string, error) {
 
 	var pkgPaths []string
 
-	prx := regexp.MustCompile("mage|mocks")
+	prx := regexp.MustCompile("mage|/mocks$")
 
 	for _, pkg := range pkgs {
 		matched := prx.MatchString(pkg.PkgPath)
@@ -0,0 +1,252 @@
+// Package otelharvest instruments Harvest API calls with OpenTelemetry.
+//
+// It provides a harvest.Middleware that runs around APIClient.Do. Every API
+// call gets a client span named after the service method, such as
+// "harvest.Timesheet.StopTimeEntry", carrying the service, method, resource,
+// resource ID, route, status code and retry count, and the call latency and
+// remaining rate-limit quota are recorded as metrics:
+//
+//	client := harvest.NewAPIClient(oauth2.NewClient(ctx, ts))
+//	client.Use(otelharvest.Middleware())
+//
+// Add it before other middleware to measure them too. Retries are counted
+// from harvest.Attempt, which bulk.Executor sets when it retries a call.
+//
+// Only the OpenTelemetry API is used. Unless a TracerProvider and
+// MeterProvider are configured, globally or through options, the
+// instrumentation is a no-op.
+package otelharvest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/becoded/go-harvest/harvest"
+)
+
+// ScopeName is the instrumentation scope of the tracer and meter.
+const ScopeName = "github.com/becoded/go-harvest/otelharvest"
+
+// Metric names.
+const (
+	MetricRequestDuration    = "harvest.client.request.duration"
+	MetricRateLimitRemaining = "harvest.client.rate_limit.remaining"
+)
+
+// Attribute keys specific to Harvest.
+const (
+	ServiceKey    = attribute.Key("harvest.service")
+	MethodKey     = attribute.Key("harvest.method")
+	ResourceKey   = attribute.Key("harvest.resource")
+	ResourceIDKey = attribute.Key("harvest.resource.id")
+)
+
+// instrumentation holds the tracer, instruments and rate-limit window shared
+// by the calls going through the middleware.
+type instrumentation struct {
+	tracer   trace.Tracer
+	duration metric.Float64Histogram
+	quota    metric.Int64Gauge
+	window   *rateWindow
+
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	rateLimit      int
+	rateWindow     time.Duration
+}
+
+// Option configures the middleware.
+type Option func(*instrumentation)
+
+// WithTracerProvider sets the TracerProvider. Defaults to the global one.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(in *instrumentation) {
+		in.tracerProvider = tp
+	}
+}
+
+// WithMeterProvider sets the MeterProvider. Defaults to the global one.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(in *instrumentation) {
+		in.meterProvider = mp
+	}
+}
+
+// WithRateLimit sets the request quota the remaining rate limit is computed
+// from. Harvest doesn't report the quota in its responses, so the middleware
+// counts the calls made within the window. Defaults to
+// harvest.DefaultRateLimit per harvest.DefaultRateWindow.
+func WithRateLimit(requests int, window time.Duration) Option {
+	return func(in *instrumentation) {
+		in.rateLimit = requests
+		in.rateWindow = window
+	}
+}
+
+// Middleware returns a harvest.Middleware that traces and measures API calls.
+func Middleware(opts ...Option) harvest.Middleware {
+	in := &instrumentation{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+		rateLimit:      harvest.DefaultRateLimit,
+		rateWindow:     harvest.DefaultRateWindow,
+	}
+
+	for _, opt := range opts {
+		opt(in)
+	}
+
+	in.tracer = in.tracerProvider.Tracer(ScopeName, trace.WithInstrumentationVersion(harvest.LibraryVersion))
+	meter := in.meterProvider.Meter(ScopeName, metric.WithInstrumentationVersion(harvest.LibraryVersion))
+
+	// Instrument creation only fails for invalid names; the no-op
+	// instruments returned alongside the error are safe to use.
+	in.duration, _ = meter.Float64Histogram(MetricRequestDuration,
+		metric.WithDescription("Duration of Harvest API calls."),
+		metric.WithUnit("s"),
+	)
+	in.quota, _ = meter.Int64Gauge(MetricRateLimitRemaining,
+		metric.WithDescription("Requests left in the current Harvest rate-limit window."),
+		metric.WithUnit("{request}"),
+	)
+	in.window = &rateWindow{limit: in.rateLimit, window: in.rateWindow}
+
+	return func(next harvest.Doer) harvest.Doer {
+		return harvest.DoerFunc(func(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
+			return in.do(ctx, next, req, v)
+		})
+	}
+}
+
+func (in *instrumentation) do(
+	ctx context.Context,
+	next harvest.Doer,
+	req *http.Request,
+	v interface{},
+) (*http.Response, error) {
+	call := parseRoute(req.URL.Path)
+	name := "harvest." + call.service + " " + req.Method + " " + call.route
+
+	attrs := []attribute.KeyValue{
+		ResourceKey.String(call.resource),
+		semconv.URLTemplate(call.route),
+		semconv.HTTPRequestMethodKey.String(req.Method),
+		semconv.ServerAddress(req.URL.Hostname()),
+	}
+
+	if service, method, ok := strings.Cut(harvest.Operation(req), "."); ok {
+		call.service = service
+		name = "harvest." + service + "." + method
+		attrs = append(attrs, MethodKey.String(method))
+	}
+
+	attrs = append(attrs, ServiceKey.String(call.service))
+
+	if call.id != 0 {
+		attrs = append(attrs, ResourceIDKey.Int64(call.id))
+	}
+
+	ctx, span := in.tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+	defer span.End()
+
+	if retries := harvest.Attempt(ctx) - 1; retries > 0 {
+		span.SetAttributes(semconv.HTTPRequestResendCount(retries))
+	}
+
+	start := time.Now()
+	remaining := in.window.take(start)
+
+	resp, err := next.Do(ctx, req, v)
+
+	elapsed := time.Since(start)
+
+	if resp != nil {
+		attrs = append(attrs, semconv.HTTPResponseStatusCode(resp.StatusCode))
+		span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+	}
+
+	var rateErr *harvest.AbuseRateLimitError
+	if errors.As(err, &rateErr) || resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		remaining = in.window.exhaust(start)
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	in.duration.Record(ctx, elapsed.Seconds(), metric.WithAttributeSet(metricSet(attrs)))
+	in.quota.Record(ctx, int64(remaining))
+
+	return resp, err
+}
+
+// metricSet drops the high-cardinality resource ID from the span attributes.
+func metricSet(attrs []attribute.KeyValue) attribute.Set {
+	kept := make([]attribute.KeyValue, 0, len(attrs))
+
+	for _, a := range attrs {
+		if a.Key != ResourceIDKey {
+			kept = append(kept, a)
+		}
+	}
+
+	return attribute.NewSet(kept...)
+}
+
+// rateWindow counts requests in a sliding window to estimate the remaining
+// quota.
+type rateWindow struct {
+	mu     sync.Mutex
+	limit  int
+	window time.Duration
+	sent   []time.Time
+}
+
+// take records a request sent at now and returns the remaining quota.
+func (w *rateWindow) take(now time.Time) int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.expire(now)
+	w.sent = append(w.sent, now)
+
+	return max(w.limit-len(w.sent), 0)
+}
+
+// exhaust marks the quota as used up, as reported by a 429 response.
+func (w *rateWindow) exhaust(now time.Time) int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for len(w.sent) < w.limit {
+		w.sent = append(w.sent, now)
+	}
+
+	return 0
+}
+
+func (w *rateWindow) expire(now time.Time) {
+	cutoff := now.Add(-w.window)
+
+	n := 0
+	for n < len(w.sent) && !w.sent[n].After(cutoff) {
+		n++
+	}
+
+	w.sent = w.sent[n:]
+}
@@ -0,0 +1,180 @@
+package otelharvest_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/becoded/go-harvest/harvest"
+	"github.com/becoded/go-harvest/harvesttest"
+	"github.com/becoded/go-harvest/otelharvest"
+)
+
+type instrumented struct {
+	client *harvest.APIClient
+	spans  *tracetest.SpanRecorder
+	reader *sdkmetric.ManualReader
+	srv    *harvesttest.Server
+}
+
+func newInstrumented(t *testing.T, opts ...otelharvest.Option) *instrumented {
+	t.Helper()
+
+	srv := harvesttest.NewServer()
+	t.Cleanup(srv.Close)
+
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+
+	opts = append(opts,
+		otelharvest.WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+		otelharvest.WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+	)
+
+	client := srv.Client()
+	client.Use(otelharvest.Middleware(opts...))
+
+	return &instrumented{client: client, spans: spans, reader: reader, srv: srv}
+}
+
+func attrs(kvs []attribute.KeyValue) map[attribute.Key]attribute.Value {
+	m := make(map[attribute.Key]attribute.Value, len(kvs))
+	for _, kv := range kvs {
+		m[kv.Key] = kv.Value
+	}
+
+	return m
+}
+
+func TestMiddleware_Spans(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	in := newInstrumented(t)
+	task := in.srv.AddTask(&harvest.Task{Name: harvest.String("Development")})
+
+	_, _, err := in.client.Task.Get(ctx, task.GetID())
+	assert.NoError(t, err)
+
+	_, _, err = in.client.Task.Get(ctx, 999)
+	assert.Error(t, err)
+
+	ended := in.spans.Ended()
+	assert.Len(t, ended, 2)
+
+	ok := ended[0]
+	assert.Equal(t, "harvest.Task.Get", ok.Name())
+	got := attrs(ok.Attributes())
+	assert.Equal(t, "Task", got[otelharvest.ServiceKey].AsString())
+	assert.Equal(t, "Get", got[otelharvest.MethodKey].AsString())
+	assert.Equal(t, "tasks", got[otelharvest.ResourceKey].AsString())
+	assert.Equal(t, "tasks/{id}", got["url.template"].AsString())
+	assert.Equal(t, task.GetID(), got[otelharvest.ResourceIDKey].AsInt64())
+	assert.Equal(t, "GET", got["http.request.method"].AsString())
+	assert.Equal(t, int64(http.StatusOK), got["http.response.status_code"].AsInt64())
+	assert.Equal(t, codes.Unset, ok.Status().Code)
+
+	notFound := ended[1]
+	assert.Equal(t, int64(http.StatusNotFound), attrs(notFound.Attributes())["http.response.status_code"].AsInt64())
+	assert.Equal(t, codes.Error, notFound.Status().Code)
+}
+
+func TestMiddleware_RetryCount(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	in := newInstrumented(t)
+
+	_, _, err := in.client.Company.Get(ctx)
+	assert.NoError(t, err)
+
+	_, _, err = in.client.Company.Get(harvest.WithAttempt(ctx, 2))
+	assert.NoError(t, err)
+
+	ended := in.spans.Ended()
+	assert.Len(t, ended, 2)
+	assert.NotContains(t, attrs(ended[0].Attributes()), attribute.Key("http.request.resend_count"))
+	assert.Equal(t, int64(1), attrs(ended[1].Attributes())["http.request.resend_count"].AsInt64())
+}
+
+func TestMiddleware_Metrics(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	in := newInstrumented(t, otelharvest.WithRateLimit(3, time.Minute))
+
+	for range 2 {
+		_, _, err := in.client.Company.Get(ctx)
+		assert.NoError(t, err)
+	}
+
+	var rm metricdata.ResourceMetrics
+
+	assert.NoError(t, in.reader.Collect(ctx, &rm))
+	assert.Len(t, rm.ScopeMetrics, 1)
+
+	metrics := map[string]metricdata.Aggregation{}
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		metrics[m.Name] = m.Data
+	}
+
+	duration, ok := metrics[otelharvest.MetricRequestDuration].(metricdata.Histogram[float64])
+	assert.True(t, ok)
+	assert.Len(t, duration.DataPoints, 1)
+	assert.Equal(t, uint64(2), duration.DataPoints[0].Count)
+
+	service, _ := duration.DataPoints[0].Attributes.Value(otelharvest.ServiceKey)
+	assert.Equal(t, "Company", service.AsString())
+
+	remaining, ok := metrics[otelharvest.MetricRateLimitRemaining].(metricdata.Gauge[int64])
+	assert.True(t, ok)
+	assert.Len(t, remaining.DataPoints, 1)
+	assert.Equal(t, int64(1), remaining.DataPoints[0].Value)
+}
+
+func TestMiddleware_RateLimited(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	in := newInstrumented(t)
+	in.srv.SetRateLimit(1, time.Minute)
+
+	_, _, err := in.client.Company.Get(ctx)
+	assert.NoError(t, err)
+
+	_, _, err = in.client.Company.Get(ctx)
+	assert.Error(t, err)
+
+	var rm metricdata.ResourceMetrics
+
+	assert.NoError(t, in.reader.Collect(ctx, &rm))
+
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		if m.Name == otelharvest.MetricRateLimitRemaining {
+			gauge, _ := m.Data.(metricdata.Gauge[int64])
+			assert.Equal(t, int64(0), gauge.DataPoints[0].Value)
+		}
+	}
+}
+
+func TestMiddleware_NoopByDefault(t *testing.T) {
+	t.Parallel()
+
+	srv := harvesttest.NewServer()
+	defer srv.Close()
+
+	client := srv.Client()
+	client.Use(otelharvest.Middleware())
+
+	_, _, err := client.Company.Get(context.Background())
+	assert.NoError(t, err)
+}
@@ -0,0 +1,69 @@
+package otelharvest
+
+import (
+	"strconv"
+	"strings"
+)
+
+// services maps the first path segment of an API URL to the APIClient
+// service that calls it.
+var services = map[string]string{ //nolint: gochecknoglobals
+	"clients":                  "Client",
+	"contacts":                 "Client",
+	"company":                  "Company",
+	"estimates":                "Estimate",
+	"estimate_item_categories": "Estimate",
+	"expenses":                 "Expense",
+	"expense_categories":       "Expense",
+	"invoices":                 "Invoice",
+	"invoice_item_categories":  "Invoice",
+	"projects":                 "Project",
+	"roles":                    "Role",
+	"tasks":                    "Task",
+	"time_entries":             "Timesheet",
+	"users":                    "User",
+}
+
+// apiVersionPrefix precedes the resource path in API URLs.
+const apiVersionPrefix = "/v2/"
+
+// call describes the API call behind a request path.
+type call struct {
+	service  string
+	resource string
+	id       int64
+	route    string
+}
+
+// parseRoute derives the call from a path such as /v2/time_entries/1/stop:
+// service Timesheet, resource time_entries, ID 1 and route
+// time_entries/{id}/stop. Numeric segments are replaced in the route to keep
+// span names low-cardinality.
+func parseRoute(path string) call {
+	if i := strings.Index(path, apiVersionPrefix); i >= 0 {
+		path = path[i+len(apiVersionPrefix):]
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	c := call{resource: segments[0]}
+
+	for i, seg := range segments {
+		if id, err := strconv.ParseInt(seg, 10, 64); err == nil {
+			if c.id == 0 {
+				c.id = id
+			}
+
+			segments[i] = "{id}"
+		}
+	}
+
+	c.route = strings.Join(segments, "/")
+
+	c.service = services[c.resource]
+	if c.service == "" {
+		c.service = "Unknown"
+	}
+
+	return c
+}
@@ -0,0 +1,78 @@
+package recorder
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+)
+
+// A Matcher reports whether a recorded request answers r.
+type Matcher func(r *http.Request, recorded *Request) bool
+
+// DefaultMatcher matches on method, path and query, the rules that identify
+// a Harvest API call.
+var DefaultMatcher = MatchAll(MatchMethod, MatchPath, MatchQuery) //nolint: gochecknoglobals
+
+// MatchAll combines matchers; a request matches if all of them match.
+func MatchAll(matchers ...Matcher) Matcher {
+	return func(r *http.Request, recorded *Request) bool {
+		for _, m := range matchers {
+			if !m(r, recorded) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// MatchMethod matches on the HTTP method.
+func MatchMethod(r *http.Request, recorded *Request) bool {
+	return r.Method == recorded.Method
+}
+
+// MatchPath matches on the URL path, ignoring scheme and host so cassettes
+// recorded against the real API replay against any base URL.
+func MatchPath(r *http.Request, recorded *Request) bool {
+	u, err := url.Parse(recorded.URL)
+	if err != nil {
+		return false
+	}
+
+	return r.URL.Path == u.Path
+}
+
+// MatchQuery matches on the query parameters, regardless of their order.
+func MatchQuery(r *http.Request, recorded *Request) bool {
+	return MatchQueryIgnoring()(r, recorded)
+}
+
+// MatchQueryIgnoring matches on the query parameters except the given ones.
+// Use it for parameters that change between runs, such as updated_since.
+func MatchQueryIgnoring(params ...string) Matcher {
+	return func(r *http.Request, recorded *Request) bool {
+		u, err := url.Parse(recorded.URL)
+		if err != nil {
+			return false
+		}
+
+		got, want := r.URL.Query(), u.Query()
+
+		for _, p := range params {
+			got.Del(p)
+			want.Del(p)
+		}
+
+		if len(got) != len(want) {
+			return false
+		}
+
+		for k, v := range want {
+			if !slices.Equal(got[k], v) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
@@ -0,0 +1,215 @@
+package recorder_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/becoded/go-harvest/harvest"
+	"github.com/becoded/go-harvest/harvesttest"
+	"github.com/becoded/go-harvest/recorder"
+)
+
+// authTransport adds credentials the way an oauth2 or token transport would.
+type authTransport struct {
+	next http.RoundTripper
+}
+
+func (t authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer secret-token")
+
+	return t.next.RoundTrip(req)
+}
+
+func TestRecorder_Replay(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	rec, err := recorder.New("harvest_session")
+	assert.NoError(t, err)
+	assert.Equal(t, recorder.ModeReplay, rec.Mode())
+
+	client := harvest.NewAPIClient(rec.Client())
+
+	company, _, err := client.Company.Get(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "organisation", company.GetName())
+
+	clients, _, err := client.Client.List(ctx, &harvest.ClientListOptions{IsActive: true})
+	assert.NoError(t, err)
+	assert.Len(t, clients.Clients, 2)
+
+	_, _, err = client.Client.List(ctx, &harvest.ClientListOptions{})
+	assert.ErrorIs(t, err, recorder.ErrNoInteraction)
+
+	assert.NoError(t, rec.Stop())
+}
+
+func TestRecorder_MissingCassette(t *testing.T) {
+	t.Parallel()
+
+	_, err := recorder.New("missing", recorder.WithDir(t.TempDir()))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestRecorder_RecordAndReplay(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	srv := harvesttest.NewServer()
+
+	newClient := func(rec *recorder.Recorder) *harvest.APIClient {
+		c := harvest.NewAPIClient(&http.Client{Transport: authTransport{next: rec}})
+		c.BaseURL, _ = url.Parse(srv.URL())
+		c.AccountID = "123456"
+
+		return c
+	}
+
+	rec, err := recorder.New("session", recorder.WithDir(dir), recorder.WithMode(recorder.ModeAuto))
+	assert.NoError(t, err)
+	assert.Equal(t, recorder.ModeRecord, rec.Mode())
+
+	client := newClient(rec)
+
+	created, _, err := client.Client.Create(ctx, &harvest.ClientCreateRequest{Name: harvest.String("Acme")})
+	assert.NoError(t, err)
+
+	_, _, err = client.Client.List(ctx, &harvest.ClientListOptions{IsActive: true})
+	assert.NoError(t, err)
+	assert.NoError(t, rec.Stop())
+
+	data, err := os.ReadFile(rec.Path())
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "secret-token")
+	assert.NotContains(t, string(data), "123456")
+	assert.Contains(t, string(data), recorder.Redacted)
+
+	// Replay without the server.
+	srv.Close()
+
+	rec, err = recorder.New("session", recorder.WithDir(dir), recorder.WithMode(recorder.ModeAuto))
+	assert.NoError(t, err)
+	assert.Equal(t, recorder.ModeReplay, rec.Mode())
+
+	client = newClient(rec)
+
+	replayed, _, err := client.Client.Create(ctx, &harvest.ClientCreateRequest{Name: harvest.String("Acme")})
+	assert.NoError(t, err)
+	assert.Equal(t, created.GetID(), replayed.GetID())
+
+	list, _, err := client.Client.List(ctx, &harvest.ClientListOptions{IsActive: true})
+	assert.NoError(t, err)
+	assert.Len(t, list.Clients, 1)
+
+	_, _, err = client.Client.Create(ctx, &harvest.ClientCreateRequest{Name: harvest.String("Acme")})
+	assert.ErrorIs(t, err, recorder.ErrNoInteraction, "each interaction replays once")
+}
+
+func TestRecorder_RequestBodyUntouched(t *testing.T) {
+	t.Parallel()
+
+	srv := harvesttest.NewServer()
+	defer srv.Close()
+
+	rec, err := recorder.New("session", recorder.WithDir(t.TempDir()), recorder.WithMode(recorder.ModeRecord))
+	assert.NoError(t, err)
+
+	for _, getBody := range []bool{true, false} {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodPost,
+			srv.URL()+"clients", strings.NewReader(`{"name":"Acme"}`))
+		assert.NoError(t, err)
+
+		if !getBody {
+			req.GetBody = nil
+			req.Body = io.NopCloser(req.Body)
+		}
+
+		body := req.Body
+
+		resp, err := rec.RoundTrip(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.NoError(t, resp.Body.Close())
+		assert.Equal(t, body, req.Body, "the caller's request isn't modified")
+	}
+
+	assert.NoError(t, rec.Stop())
+
+	data, err := os.ReadFile(rec.Path())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(string(data), `{\"name\":\"Acme\"}`), "both bodies are recorded")
+}
+
+func TestMatchers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		matcher  recorder.Matcher
+		method   string
+		url      string
+		recorded recorder.Request
+		want     bool
+	}{
+		{
+			name:     "default matches regardless of host and query order",
+			matcher:  recorder.DefaultMatcher,
+			method:   http.MethodGet,
+			url:      "http://127.0.0.1:8080/v2/time_entries?to=2024-03-10&from=2024-03-04",
+			recorded: recorder.Request{Method: http.MethodGet, URL: "https://api.harvestapp.com/v2/time_entries?from=2024-03-04&to=2024-03-10"},
+			want:     true,
+		},
+		{
+			name:     "method differs",
+			matcher:  recorder.DefaultMatcher,
+			method:   http.MethodPost,
+			url:      "https://api.harvestapp.com/v2/clients",
+			recorded: recorder.Request{Method: http.MethodGet, URL: "https://api.harvestapp.com/v2/clients"},
+			want:     false,
+		},
+		{
+			name:     "path differs",
+			matcher:  recorder.DefaultMatcher,
+			method:   http.MethodGet,
+			url:      "https://api.harvestapp.com/v2/clients/1",
+			recorded: recorder.Request{Method: http.MethodGet, URL: "https://api.harvestapp.com/v2/clients/2"},
+			want:     false,
+		},
+		{
+			name:     "query differs",
+			matcher:  recorder.DefaultMatcher,
+			method:   http.MethodGet,
+			url:      "https://api.harvestapp.com/v2/clients?page=2",
+			recorded: recorder.Request{Method: http.MethodGet, URL: "https://api.harvestapp.com/v2/clients?page=1"},
+			want:     false,
+		},
+		{
+			name:     "ignored query parameter",
+			matcher:  recorder.MatchAll(recorder.MatchPath, recorder.MatchQueryIgnoring("updated_since")),
+			method:   http.MethodGet,
+			url:      "https://api.harvestapp.com/v2/clients?updated_since=2024-03-04T10%3A00%3A00Z",
+			recorded: recorder.Request{Method: http.MethodGet, URL: "https://api.harvestapp.com/v2/clients?updated_since=2017-01-01T00%3A00%3A00Z"},
+			want:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req, err := http.NewRequestWithContext(context.Background(), tt.method, tt.url, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, tt.matcher(req, &tt.recorded))
+		})
+	}
+}
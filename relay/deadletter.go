@@ -0,0 +1,84 @@
+package relay
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/becoded/go-harvest/watch"
+)
+
+// A DeadLetter is an event that couldn't be delivered to a subscription.
+type DeadLetter struct {
+	SubscriptionID string      `json:"subscription_id"`
+	URL            string      `json:"url"`
+	DeliveryID     string      `json:"delivery_id"`
+	Event          watch.Event `json:"event"`
+	Attempts       int         `json:"attempts"`
+	// Error describes the last attempt: a status code or a transport error.
+	Error    string    `json:"error"`
+	FailedAt time.Time `json:"failed_at"`
+}
+
+// DeadLetters stores the events that couldn't be delivered, so that they can
+// be inspected and replayed.
+type DeadLetters interface {
+	// Add records a failed delivery. It is called concurrently.
+	Add(ctx context.Context, l DeadLetter) error
+}
+
+// MemoryDeadLetters keeps dead letters in memory.
+type MemoryDeadLetters struct {
+	mu      sync.Mutex
+	letters []DeadLetter
+}
+
+// Add implements DeadLetters.
+func (d *MemoryDeadLetters) Add(_ context.Context, l DeadLetter) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.letters = append(d.letters, l)
+
+	return nil
+}
+
+// Letters returns the dead letters recorded so far.
+func (d *MemoryDeadLetters) Letters() []DeadLetter {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return append([]DeadLetter(nil), d.letters...)
+}
+
+// FileDeadLetters is a DeadLetters that appends dead letters to the file at
+// the path as JSON, one per line.
+type FileDeadLetters string
+
+var fileDeadLettersMu sync.Mutex //nolint: gochecknoglobals
+
+// Add implements DeadLetters.
+func (d FileDeadLetters) Add(_ context.Context, l DeadLetter) error {
+	line, err := json.Marshal(l)
+	if err != nil {
+		return err
+	}
+
+	fileDeadLettersMu.Lock()
+	defer fileDeadLettersMu.Unlock()
+
+	f, err := os.OpenFile(string(d), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600) //nolint: mnd
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		_ = f.Close()
+
+		return err
+	}
+
+	return f.Close()
+}
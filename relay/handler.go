@@ -0,0 +1,68 @@
+package relay
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Handler returns an http.Handler that manages the subscriptions:
+//
+//	POST   /subscriptions       registers the Subscription in the JSON body
+//	GET    /subscriptions       lists the subscriptions
+//	DELETE /subscriptions/{id}  removes a subscription
+//
+// Secrets are accepted but never returned. The handler doesn't authenticate
+// requests; serve it on an address only trusted clients can reach.
+func (r *Relay) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("POST /subscriptions", r.createSubscription)
+	mux.HandleFunc("GET /subscriptions", r.listSubscriptions)
+	mux.HandleFunc("DELETE /subscriptions/{id}", r.deleteSubscription)
+
+	return mux
+}
+
+func (r *Relay) createSubscription(w http.ResponseWriter, req *http.Request) {
+	var s Subscription
+	if err := json.NewDecoder(req.Body).Decode(&s); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body")
+
+		return
+	}
+
+	s, err := r.Subscribe(s)
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+
+		return
+	}
+
+	s.Secret = ""
+	writeJSON(w, http.StatusCreated, s)
+}
+
+func (r *Relay) listSubscriptions(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string][]Subscription{"subscriptions": r.Subscriptions()})
+}
+
+func (r *Relay) deleteSubscription(w http.ResponseWriter, req *http.Request) {
+	if !r.Unsubscribe(req.PathValue("id")) {
+		writeError(w, http.StatusNotFound, "Not Found")
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"message": message})
+}
@@ -0,0 +1,479 @@
+// Package relay forwards Harvest change events to HTTP callbacks, for
+// services that would rather receive webhooks than poll.
+//
+// A Relay runs a watch.Poller and posts every event, as JSON, to the URLs
+// subscribed to it. Each subscription has its own queue and worker, so a slow
+// or unreachable receiver doesn't hold up polling or the other
+// subscriptions. Each request is signed with the subscription's secret (see
+// Verify), failed deliveries are retried with exponential backoff, and those
+// that still fail, or don't fit in a full queue, are recorded as dead
+// letters:
+//
+//	p := watch.New([]sync.Resource{sync.TimeEntries(service), sync.Invoices(service)})
+//	r := relay.New(p, relay.WithDeadLetters(relay.FileDeadLetters("dead-letters.jsonl")))
+//
+//	_, err := r.Subscribe(relay.Subscription{
+//		URL:    "https://billing.internal/hooks/harvest",
+//		Secret: os.Getenv("HOOK_SECRET"),
+//		Events: []watch.EventType{watch.InvoiceStateChanged},
+//	})
+//
+//	go http.ListenAndServe("localhost:8080", r.Handler()) // manage subscriptions over HTTP
+//	err = r.Run(ctx)
+package relay
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/becoded/go-harvest/watch"
+)
+
+// Headers of callback requests.
+const (
+	// SignatureHeader holds "sha256=" and the hex HMAC-SHA256 of the body,
+	// keyed with the subscription's secret.
+	SignatureHeader = "X-Harvest-Relay-Signature"
+	// EventHeader holds the event type, such as "created".
+	EventHeader = "X-Harvest-Relay-Event"
+	// DeliveryHeader holds an ID that is the same for all attempts to deliver
+	// an event to a subscription, to recognize retries.
+	DeliveryHeader = "X-Harvest-Relay-Delivery"
+)
+
+// DefaultMaxAttempts is the number of times a delivery is attempted.
+const DefaultMaxAttempts = 5
+
+// DefaultBackoff is the wait before the first retry. It doubles with every
+// retry.
+const DefaultBackoff = time.Second
+
+// DefaultQueueSize is the number of deliveries a subscription's queue holds.
+const DefaultQueueSize = 100
+
+// ErrQueueFull is the error of dead letters for events that arrived while the
+// subscription's queue was full.
+var ErrQueueFull = errors.New("delivery queue is full")
+
+// ErrClosed is returned by HandleEvent once the relay is closed.
+var ErrClosed = errors.New("relay is closed")
+
+// ErrInvalidURL is returned by Subscribe for a callback URL that isn't an
+// absolute http or https URL.
+var ErrInvalidURL = errors.New("callback URL must be an absolute http or https URL")
+
+// A Subscription registers a callback URL for events.
+type Subscription struct {
+	// ID is assigned by Subscribe if empty.
+	ID  string `json:"id"`
+	URL string `json:"url"`
+	// Secret signs the requests. It is never included in responses.
+	Secret string `json:"secret,omitempty"`
+	// Events limits the subscription to these event types. Empty means all.
+	Events []watch.EventType `json:"events,omitempty"`
+	// Resources limits the subscription to these resources, such as
+	// "invoices". Empty means all.
+	Resources []string `json:"resources,omitempty"`
+}
+
+func (s *Subscription) matches(e watch.Event) bool {
+	return (len(s.Events) == 0 || slices.Contains(s.Events, e.Type)) &&
+		(len(s.Resources) == 0 || slices.Contains(s.Resources, e.Resource))
+}
+
+// Relay delivers the events of a poller to subscribed URLs. Create one with
+// New.
+type Relay struct {
+	poller      *watch.Poller
+	client      *http.Client
+	maxAttempts int
+	backoff     time.Duration
+	queueSize   int
+	deadLetters DeadLetters
+	logger      *slog.Logger
+
+	// ctx is the context of the workers, canceled by Close when it can't
+	// wait for them.
+	ctx     context.Context
+	cancel  context.CancelFunc
+	workers sync.WaitGroup
+
+	mu     sync.Mutex
+	subs   []*Subscription
+	queues map[string]chan delivery
+	closed bool
+}
+
+// A delivery is an event queued for a subscription.
+type delivery struct {
+	sub   Subscription
+	event watch.Event
+	body  []byte
+}
+
+// Option configures a Relay.
+type Option func(*Relay)
+
+// WithHTTPClient sets the client that makes the callback requests. Defaults
+// to a client with a 10 second timeout.
+func WithHTTPClient(c *http.Client) Option {
+	return func(r *Relay) {
+		r.client = c
+	}
+}
+
+// WithMaxAttempts sets the number of times a delivery is attempted. Defaults
+// to DefaultMaxAttempts.
+func WithMaxAttempts(n int) Option {
+	return func(r *Relay) {
+		r.maxAttempts = max(n, 1)
+	}
+}
+
+// WithBackoff sets the wait before the first retry. Defaults to
+// DefaultBackoff.
+func WithBackoff(d time.Duration) Option {
+	return func(r *Relay) {
+		r.backoff = d
+	}
+}
+
+// WithQueueSize sets the number of deliveries each subscription's queue
+// holds. Defaults to DefaultQueueSize.
+func WithQueueSize(n int) Option {
+	return func(r *Relay) {
+		r.queueSize = max(n, 1)
+	}
+}
+
+// WithDeadLetters sets where failed deliveries are recorded. By default they
+// are only logged.
+func WithDeadLetters(d DeadLetters) Option {
+	return func(r *Relay) {
+		r.deadLetters = d
+	}
+}
+
+// WithLogger sets the logger that failed deliveries are reported to.
+func WithLogger(logger *slog.Logger) Option {
+	return func(r *Relay) {
+		r.logger = logger
+	}
+}
+
+// New returns a Relay for the events of poller.
+func New(poller *watch.Poller, opts ...Option) *Relay {
+	r := &Relay{
+		poller:      poller,
+		client:      &http.Client{Timeout: 10 * time.Second}, //nolint: mnd
+		maxAttempts: DefaultMaxAttempts,
+		backoff:     DefaultBackoff,
+		queueSize:   DefaultQueueSize,
+		logger:      slog.New(slog.DiscardHandler),
+		queues:      map[string]chan delivery{},
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	r.ctx, r.cancel = context.WithCancel(context.Background())
+
+	return r
+}
+
+// Run runs the poller until ctx is done and delivers its events. It then
+// closes the relay, recording the deliveries still queued as dead letters,
+// and returns the context's error.
+func (r *Relay) Run(ctx context.Context) error {
+	err := r.poller.Run(ctx, r)
+
+	_ = r.Close(ctx) // ctx is done, so Close only returns its error.
+
+	return err
+}
+
+// Close stops accepting events and waits for the queued deliveries to be
+// made. If ctx is done first, the deliveries still running or queued are
+// recorded as dead letters and ctx's error is returned.
+func (r *Relay) Close(ctx context.Context) error {
+	r.mu.Lock()
+
+	if !r.closed {
+		r.closed = true
+
+		for id, q := range r.queues {
+			close(q)
+			delete(r.queues, id)
+		}
+	}
+
+	r.mu.Unlock()
+
+	done := make(chan struct{})
+
+	go func() {
+		r.workers.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		r.cancel()
+		<-done
+
+		return ctx.Err()
+	}
+}
+
+// Subscribe registers s and returns it with its ID.
+func (r *Relay) Subscribe(s Subscription) (Subscription, error) {
+	u, err := url.Parse(s.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return Subscription{}, fmt.Errorf("%w: %q", ErrInvalidURL, s.URL)
+	}
+
+	if s.ID == "" {
+		s.ID = randomID()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.subs = slices.DeleteFunc(r.subs, func(sub *Subscription) bool { return sub.ID == s.ID })
+	r.subs = append(r.subs, &s)
+
+	if _, ok := r.queues[s.ID]; !ok && !r.closed {
+		q := make(chan delivery, r.queueSize)
+		r.queues[s.ID] = q
+
+		r.workers.Go(func() {
+			r.work(q)
+		})
+	}
+
+	return s, nil
+}
+
+// Unsubscribe removes the subscription with the given ID and reports whether
+// it existed. Deliveries queued before are still made.
+func (r *Relay) Unsubscribe(id string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	n := len(r.subs)
+	r.subs = slices.DeleteFunc(r.subs, func(sub *Subscription) bool { return sub.ID == id })
+
+	if q, ok := r.queues[id]; ok {
+		close(q)
+		delete(r.queues, id)
+	}
+
+	return len(r.subs) < n
+}
+
+// Subscriptions returns the registered subscriptions, without their secrets.
+func (r *Relay) Subscriptions() []Subscription {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	subs := make([]Subscription, len(r.subs))
+	for i, s := range r.subs {
+		subs[i] = *s
+		subs[i].Secret = ""
+	}
+
+	return subs
+}
+
+// HandleEvent implements watch.Handler. It queues e for the matching
+// subscriptions and returns without waiting for the deliveries. Events that
+// don't fit in a subscription's queue are recorded as dead letters; it only
+// fails when such a dead letter can't be recorded, or with ErrClosed.
+func (r *Relay) HandleEvent(ctx context.Context, e watch.Event) error {
+	body, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	var full []Subscription
+
+	r.mu.Lock()
+
+	if r.closed {
+		r.mu.Unlock()
+
+		return ErrClosed
+	}
+
+	for _, s := range r.subs {
+		if !s.matches(e) {
+			continue
+		}
+
+		select {
+		case r.queues[s.ID] <- delivery{sub: *s, event: e, body: body}:
+		default:
+			full = append(full, *s)
+		}
+	}
+
+	r.mu.Unlock()
+
+	errs := make([]error, 0, len(full))
+
+	for _, s := range full {
+		errs = append(errs, r.deadLetter(ctx, s, e, randomID(), 0, ErrQueueFull))
+	}
+
+	return errors.Join(errs...)
+}
+
+// work makes the deliveries of a subscription's queue, one at a time, until
+// the queue is closed.
+func (r *Relay) work(q <-chan delivery) {
+	for d := range q {
+		if err := r.deliver(r.ctx, d.sub, d.event, d.body); err != nil {
+			r.logger.ErrorContext(r.ctx, "relay: dead letter not recorded",
+				"subscription", d.sub.ID, "url", d.sub.URL, "error", err)
+		}
+	}
+}
+
+// deliver posts body to the subscription, retrying failures that may be
+// temporary, and records a dead letter if it doesn't succeed.
+func (r *Relay) deliver(ctx context.Context, s Subscription, e watch.Event, body []byte) error {
+	id := randomID()
+	wait := r.backoff
+
+	var (
+		attempt int
+		lastErr error
+	)
+
+	for attempt = 1; ; attempt++ {
+		var retry bool
+
+		retry, lastErr = r.post(ctx, s, e, id, body)
+		if lastErr == nil {
+			return nil
+		}
+
+		if !retry || attempt == r.maxAttempts || ctx.Err() != nil {
+			break
+		}
+
+		timer := time.NewTimer(wait)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+		case <-timer.C:
+		}
+
+		wait *= 2
+	}
+
+	return r.deadLetter(ctx, s, e, id, attempt, lastErr)
+}
+
+// deadLetter logs a failed delivery and records it as a dead letter. It is
+// recorded even when ctx is canceled, so that nothing is lost on shutdown.
+func (r *Relay) deadLetter(
+	ctx context.Context,
+	s Subscription,
+	e watch.Event,
+	id string,
+	attempts int,
+	err error,
+) error {
+	r.logger.ErrorContext(ctx, "relay: delivery failed",
+		"subscription", s.ID, "url", s.URL, "delivery", id, "attempts", attempts, "error", err)
+
+	if r.deadLetters == nil {
+		return nil
+	}
+
+	return r.deadLetters.Add(context.WithoutCancel(ctx), DeadLetter{
+		SubscriptionID: s.ID,
+		URL:            s.URL,
+		DeliveryID:     id,
+		Event:          e,
+		Attempts:       attempts,
+		Error:          err.Error(),
+		FailedAt:       time.Now(),
+	})
+}
+
+// errStatus reports a callback that answered with a status other than 2xx.
+var errStatus = errors.New("unexpected status")
+
+// post makes one delivery attempt and reports whether a failure is worth
+// retrying: transport errors, timeouts, rate limits and server errors are.
+func (r *Relay) post(ctx context.Context, s Subscription, e watch.Event, id string, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(e.Type))
+	req.Header.Set(DeliveryHeader, id)
+	req.Header.Set(SignatureHeader, Sign(s.Secret, body))
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+		return false, nil
+	}
+
+	retry := resp.StatusCode >= http.StatusInternalServerError ||
+		resp.StatusCode == http.StatusRequestTimeout ||
+		resp.StatusCode == http.StatusTooManyRequests
+
+	return retry, fmt.Errorf("%w %d", errStatus, resp.StatusCode)
+}
+
+// Sign returns the value of the SignatureHeader for body.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature, the value of the SignatureHeader of a
+// callback request, matches body. Receivers written in Go can use it to
+// check that a request comes from the relay.
+func Verify(secret string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
+
+func randomID() string {
+	b := make([]byte, 16) //nolint: mnd
+	_, _ = rand.Read(b)   // crypto/rand.Read never returns an error.
+
+	return hex.EncodeToString(b)
+}
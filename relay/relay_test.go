@@ -0,0 +1,344 @@
+package relay_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/becoded/go-harvest/harvest"
+	"github.com/becoded/go-harvest/harvesttest"
+	"github.com/becoded/go-harvest/relay"
+	hsync "github.com/becoded/go-harvest/sync"
+	"github.com/becoded/go-harvest/watch"
+)
+
+// receiver is a callback endpoint that answers with the given statuses in
+// turn, and 200 once they run out.
+type receiver struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	statuses   []int
+	deliveries []*http.Request
+	bodies     [][]byte
+}
+
+func newReceiver(t *testing.T, statuses ...int) *receiver {
+	t.Helper()
+
+	rcv := &receiver{statuses: statuses}
+	rcv.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		rcv.mu.Lock()
+		defer rcv.mu.Unlock()
+
+		rcv.deliveries = append(rcv.deliveries, r)
+		rcv.bodies = append(rcv.bodies, body)
+
+		status := http.StatusOK
+		if len(rcv.statuses) > 0 {
+			status, rcv.statuses = rcv.statuses[0], rcv.statuses[1:]
+		}
+
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(rcv.Close)
+
+	return rcv
+}
+
+func (rcv *receiver) received() ([]*http.Request, [][]byte) {
+	rcv.mu.Lock()
+	defer rcv.mu.Unlock()
+
+	return rcv.deliveries, rcv.bodies
+}
+
+func TestRelay_HandleEvent(t *testing.T) {
+	t.Parallel()
+
+	ok := newReceiver(t)
+	flaky := newReceiver(t, http.StatusServiceUnavailable, http.StatusTooManyRequests)
+	down := newReceiver(t, http.StatusInternalServerError, http.StatusBadGateway, http.StatusInternalServerError)
+	rejecting := newReceiver(t, http.StatusBadRequest)
+	filtered := newReceiver(t)
+
+	deadLetters := &relay.MemoryDeadLetters{}
+	r := relay.New(watch.New(nil),
+		relay.WithMaxAttempts(3),
+		relay.WithBackoff(time.Millisecond),
+		relay.WithDeadLetters(deadLetters))
+
+	for _, s := range []relay.Subscription{
+		{ID: "ok", URL: ok.URL, Secret: "s3cret"},
+		{ID: "flaky", URL: flaky.URL},
+		{ID: "down", URL: down.URL},
+		{ID: "rejecting", URL: rejecting.URL},
+		{ID: "filtered", URL: filtered.URL, Events: []watch.EventType{watch.Deleted}},
+		{ID: "other resource", URL: filtered.URL, Resources: []string{"invoices"}},
+	} {
+		_, err := r.Subscribe(s)
+		assert.NoError(t, err)
+	}
+
+	e := watch.Event{
+		Type:     watch.Updated,
+		Resource: "clients",
+		ID:       42,
+		Changed:  []string{"name"},
+		Value:    &harvest.Client{ID: harvest.Int64(42), Name: harvest.String("Acme Inc")},
+	}
+
+	ctx := context.Background()
+
+	assert.NoError(t, r.HandleEvent(ctx, e))
+	assert.NoError(t, r.Close(ctx), "queued deliveries are made")
+	assert.ErrorIs(t, r.HandleEvent(ctx, e), relay.ErrClosed)
+
+	reqs, bodies := ok.received()
+	assert.Len(t, reqs, 1)
+	assert.True(t, relay.Verify("s3cret", bodies[0], reqs[0].Header.Get(relay.SignatureHeader)))
+	assert.False(t, relay.Verify("wrong", bodies[0], reqs[0].Header.Get(relay.SignatureHeader)))
+	assert.Equal(t, "updated", reqs[0].Header.Get(relay.EventHeader))
+	assert.Equal(t, "application/json", reqs[0].Header.Get("Content-Type"))
+
+	var payload map[string]interface{}
+
+	assert.NoError(t, json.Unmarshal(bodies[0], &payload))
+	assert.Equal(t, "clients", payload["resource"])
+	assert.Equal(t, []interface{}{"name"}, payload["changed"])
+	assert.Equal(t, "Acme Inc", payload["data"].(map[string]interface{})["name"])
+
+	reqs, _ = flaky.received()
+	assert.Len(t, reqs, 3, "temporary failures are retried")
+	assert.Equal(t, reqs[0].Header.Get(relay.DeliveryHeader), reqs[2].Header.Get(relay.DeliveryHeader))
+
+	reqs, _ = down.received()
+	assert.Len(t, reqs, 3)
+
+	reqs, _ = rejecting.received()
+	assert.Len(t, reqs, 1, "client errors aren't retried")
+
+	reqs, _ = filtered.received()
+	assert.Empty(t, reqs)
+
+	letters := deadLetters.Letters()
+	assert.Len(t, letters, 2)
+
+	byID := map[string]relay.DeadLetter{}
+	for _, l := range letters {
+		byID[l.SubscriptionID] = l
+	}
+
+	assert.Equal(t, 3, byID["down"].Attempts)
+	assert.Equal(t, "unexpected status 500", byID["down"].Error)
+	assert.Equal(t, int64(42), byID["down"].Event.ID)
+	assert.Equal(t, 1, byID["rejecting"].Attempts)
+	assert.Equal(t, rejecting.URL, byID["rejecting"].URL)
+}
+
+func TestRelay_HandleEvent_slowReceiver(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+
+	var received atomic.Int32
+
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		received.Add(1)
+		<-release
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(slow.Close)
+
+	ok := newReceiver(t)
+	deadLetters := &relay.MemoryDeadLetters{}
+	r := relay.New(watch.New(nil), relay.WithQueueSize(1), relay.WithDeadLetters(deadLetters))
+
+	for _, s := range []relay.Subscription{{ID: "slow", URL: slow.URL}, {ID: "ok", URL: ok.URL}} {
+		_, err := r.Subscribe(s)
+		assert.NoError(t, err)
+	}
+
+	ctx := context.Background()
+
+	assert.NoError(t, r.HandleEvent(ctx, watch.Event{ID: 1}))
+	assert.Eventually(t, func() bool { return received.Load() == 1 }, 5*time.Second, time.Millisecond)
+
+	// The slow receiver holds its worker, so the second event waits in its
+	// queue and the third doesn't fit.
+	assert.NoError(t, r.HandleEvent(ctx, watch.Event{ID: 2}))
+	assert.NoError(t, r.HandleEvent(ctx, watch.Event{ID: 3}))
+
+	assert.Eventually(t, func() bool {
+		reqs, _ := ok.received()
+
+		return len(reqs) == 3
+	}, 5*time.Second, time.Millisecond, "other subscriptions aren't held up")
+
+	letters := deadLetters.Letters()
+	if assert.Len(t, letters, 1) {
+		assert.Equal(t, "slow", letters[0].SubscriptionID)
+		assert.Equal(t, int64(3), letters[0].Event.ID)
+		assert.Equal(t, relay.ErrQueueFull.Error(), letters[0].Error)
+	}
+
+	close(release)
+	assert.NoError(t, r.Close(ctx))
+	assert.Equal(t, int32(2), received.Load())
+}
+
+func TestRelay_Close_canceled(t *testing.T) {
+	t.Parallel()
+
+	down := newReceiver(t, http.StatusServiceUnavailable)
+	deadLetters := &relay.MemoryDeadLetters{}
+	r := relay.New(watch.New(nil), relay.WithBackoff(time.Hour), relay.WithDeadLetters(deadLetters))
+
+	_, err := r.Subscribe(relay.Subscription{ID: "down", URL: down.URL})
+	assert.NoError(t, err)
+
+	for id := range int64(3) {
+		assert.NoError(t, r.HandleEvent(context.Background(), watch.Event{ID: id}))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	assert.ErrorIs(t, r.Close(ctx), context.DeadlineExceeded)
+	assert.Len(t, deadLetters.Letters(), 3, "unfinished deliveries are recorded")
+}
+
+func TestRelay_Run(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	srv := harvesttest.NewServer()
+
+	defer srv.Close()
+
+	srv.SetClock(func() time.Time { return time.Now().Add(-time.Hour) })
+
+	p := watch.New([]hsync.Resource{hsync.Clients(srv.Client())}, watch.WithInterval(10*time.Millisecond))
+
+	_, err := p.Poll(ctx)
+	assert.NoError(t, err)
+
+	var delivered atomic.Int32
+
+	rcv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if relay.Verify("s3cret", body, r.Header.Get(relay.SignatureHeader)) {
+			delivered.Add(1)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(rcv.Close)
+
+	r := relay.New(p)
+	_, err = r.Subscribe(relay.Subscription{URL: rcv.URL, Secret: "s3cret", Events: []watch.EventType{watch.Created}})
+	assert.NoError(t, err)
+
+	srv.SetClock(func() time.Time { return time.Now().Add(time.Hour) })
+	srv.AddClient(&harvest.Client{Name: harvest.String("Globex")})
+
+	done := make(chan error)
+
+	go func() {
+		done <- r.Run(ctx)
+	}()
+
+	assert.Eventually(t, func() bool { return delivered.Load() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+}
+
+func TestRelay_Handler(t *testing.T) {
+	t.Parallel()
+
+	r := relay.New(watch.New(nil))
+	api := httptest.NewServer(r.Handler())
+
+	defer api.Close()
+
+	resp, err := http.Post(api.URL+"/subscriptions", "application/json",
+		strings.NewReader(`{"url": "https://example.com/hook", "secret": "s3cret", "events": ["created"]}`))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var created relay.Subscription
+
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+	assert.NoError(t, resp.Body.Close())
+	assert.NotEmpty(t, created.ID)
+	assert.Empty(t, created.Secret, "secrets aren't returned")
+	assert.Equal(t, []watch.EventType{watch.Created}, created.Events)
+
+	resp, err = http.Post(api.URL+"/subscriptions", "application/json", strings.NewReader(`{"url": "/relative"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	assert.NoError(t, resp.Body.Close())
+
+	resp, err = http.Get(api.URL + "/subscriptions")
+	assert.NoError(t, err)
+
+	var list struct {
+		Subscriptions []relay.Subscription `json:"subscriptions"`
+	}
+
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&list))
+	assert.NoError(t, resp.Body.Close())
+	assert.Equal(t, []relay.Subscription{created}, list.Subscriptions)
+
+	for _, want := range []int{http.StatusNoContent, http.StatusNotFound} {
+		req, _ := http.NewRequest(http.MethodDelete, api.URL+"/subscriptions/"+created.ID, nil)
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+		assert.Equal(t, want, resp.StatusCode)
+		assert.NoError(t, resp.Body.Close())
+	}
+
+	assert.Empty(t, r.Subscriptions())
+}
+
+func TestFileDeadLetters(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	d := relay.FileDeadLetters(filepath.Join(t.TempDir(), "dead-letters.jsonl"))
+
+	assert.NoError(t, d.Add(ctx, relay.DeadLetter{SubscriptionID: "a", Event: watch.Event{ID: 1}}))
+	assert.NoError(t, d.Add(ctx, relay.DeadLetter{SubscriptionID: "b", Event: watch.Event{ID: 2}}))
+
+	f, err := os.Open(string(d))
+	assert.NoError(t, err)
+
+	defer f.Close()
+
+	var ids []string
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var l relay.DeadLetter
+
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &l))
+		ids = append(ids, l.SubscriptionID)
+	}
+
+	assert.Equal(t, []string{"a", "b"}, ids)
+}
@@ -0,0 +1,53 @@
+package resolve
+
+import (
+	"context"
+	"time"
+)
+
+const perPage = 100
+
+// A lister fetches one page of items updated since the given time, or of all
+// items if it is zero, and returns the number of the next page.
+type lister[T any] func(ctx context.Context, since time.Time, page int) ([]*T, *int, error)
+
+// cache holds the items of one list endpoint by ID.
+type cache[T any] struct {
+	id    func(*T) int64
+	list  lister[T]
+	items map[int64]*T
+	// synced is when the last refresh started, and the UpdatedSince of the
+	// next one.
+	synced time.Time
+}
+
+func newCache[T any](id func(*T) int64, list lister[T]) *cache[T] {
+	return &cache[T]{id: id, list: list, items: map[int64]*T{}}
+}
+
+// refresh fetches the items updated since the last refresh, or all items the
+// first time, and merges them into the cache.
+func (c *cache[T]) refresh(ctx context.Context, now time.Time) error {
+	page := 1
+
+	for {
+		items, next, err := c.list(ctx, c.synced, page)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			c.items[c.id(item)] = item
+		}
+
+		if next == nil {
+			break
+		}
+
+		page = *next
+	}
+
+	c.synced = now
+
+	return nil
+}
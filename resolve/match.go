@@ -0,0 +1,172 @@
+package resolve
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// Reasons a name can't be resolved, wrapped by *MatchError.
+var (
+	ErrNotFound  = errors.New("no match")
+	ErrAmbiguous = errors.New("ambiguous match")
+)
+
+// A MatchError explains why a name didn't resolve to exactly one client,
+// project or task. Use errors.Is with ErrNotFound and ErrAmbiguous to tell
+// the reasons apart.
+type MatchError struct {
+	// Kind is "client", "project" or "task".
+	Kind  string
+	Query string
+	// Candidates are the names that matched an ambiguous query, sorted.
+	Candidates []string
+	Err        error
+}
+
+func (e *MatchError) Error() string {
+	if errors.Is(e.Err, ErrAmbiguous) {
+		return fmt.Sprintf("%s %q is ambiguous: %s", e.Kind, e.Query, strings.Join(e.Candidates, ", "))
+	}
+
+	return fmt.Sprintf("no %s matches %q", e.Kind, e.Query)
+}
+
+func (e *MatchError) Unwrap() error {
+	return e.Err
+}
+
+// A candidate is an item that a query can match by any of its keys, such as
+// a project's name and code.
+type candidate[T any] struct {
+	item  *T
+	keys  []string
+	label string
+}
+
+// match returns the single candidate that matches query. Matching goes from
+// strict to loose and stops at the first rule that matches anything: equal
+// keys, keys that start with the query, keys that contain it and, if fuzzy
+// is set, the keys closest to it by edit distance. Case and repeated spaces
+// are ignored throughout.
+func match[T any](kind, query string, candidates []candidate[T], fuzzy bool) (*T, error) {
+	q := normalize(query)
+	if q == "" {
+		return nil, &MatchError{Kind: kind, Query: query, Err: ErrNotFound}
+	}
+
+	rules := []func(key string) bool{
+		func(key string) bool { return key == q },
+		func(key string) bool { return strings.HasPrefix(key, q) },
+		func(key string) bool { return strings.Contains(key, q) },
+	}
+
+	for _, rule := range rules {
+		if found := filter(candidates, rule); len(found) > 0 {
+			return pick(kind, query, found)
+		}
+	}
+
+	if fuzzy {
+		if found := closest(q, candidates); len(found) > 0 {
+			return pick(kind, query, found)
+		}
+	}
+
+	return nil, &MatchError{Kind: kind, Query: query, Err: ErrNotFound}
+}
+
+func filter[T any](candidates []candidate[T], rule func(key string) bool) []candidate[T] {
+	var found []candidate[T]
+
+	for _, c := range candidates {
+		if slices.ContainsFunc(c.keys, func(key string) bool { return key != "" && rule(normalize(key)) }) {
+			found = append(found, c)
+		}
+	}
+
+	return found
+}
+
+// closest returns the candidates with the smallest edit distance to q, as
+// long as it is within a third of the length of q. That allows a typo or two
+// without matching unrelated names.
+func closest[T any](q string, candidates []candidate[T]) []candidate[T] {
+	limit := max(1, utf8.RuneCountInString(q)/3) //nolint: mnd
+
+	var (
+		found []candidate[T]
+		best  = limit + 1
+	)
+
+	for _, c := range candidates {
+		d := limit + 1
+
+		for _, key := range c.keys {
+			if key != "" {
+				d = min(d, distance(q, normalize(key)))
+			}
+		}
+
+		switch {
+		case d > limit:
+			continue
+		case d < best:
+			best = d
+			found = []candidate[T]{c}
+		case d == best:
+			found = append(found, c)
+		}
+	}
+
+	return found
+}
+
+func pick[T any](kind, query string, found []candidate[T]) (*T, error) {
+	if len(found) == 1 {
+		return found[0].item, nil
+	}
+
+	labels := make([]string, len(found))
+	for i, c := range found {
+		labels[i] = c.label
+	}
+
+	slices.Sort(labels)
+
+	return nil, &MatchError{Kind: kind, Query: query, Candidates: labels, Err: ErrAmbiguous}
+}
+
+func normalize(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), " "))
+}
+
+// distance returns the Levenshtein distance between a and b.
+func distance(a, b string) int {
+	ar, br := []rune(a), []rune(b)
+	prev := make([]int, len(br)+1)
+	curr := make([]int, len(br)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := range ar {
+		curr[0] = i + 1
+
+		for j := range br {
+			cost := 1
+			if ar[i] == br[j] {
+				cost = 0
+			}
+
+			curr[j+1] = min(prev[j+1]+1, curr[j]+1, prev[j]+cost)
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return prev[len(br)]
+}
@@ -0,0 +1,310 @@
+// Package resolve turns the names people type, such as
+// "Acme / Website / Design", into the clients, projects and tasks they mean.
+//
+// A Resolver caches the account's clients, projects and users and the task
+// assignments of each project it is asked about. Names are matched ignoring
+// case, projects also by their code, and small typos are forgiven. A name
+// that matches nothing or more than one item is reported as a *MatchError:
+//
+//	r := resolve.New(service)
+//
+//	m, err := r.Resolve(ctx, "Acme / Website / Design")
+//	if err != nil {
+//		return err // e.g. project "we" is ambiguous: Acme / Web app, Acme / Website
+//	}
+//
+//	_, _, err = service.Timesheet.CreateTimeEntryViaDuration(ctx, &harvest.TimeEntryCreateViaDuration{
+//		ProjectID: m.Project.ID,
+//		TaskID:    m.Task.ID,
+//		SpentDate: harvest.DateP(harvest.Date{Time: time.Now()}),
+//	})
+//
+// Cached items are refreshed with UpdatedSince, so only what changed is
+// fetched again. Items deleted from Harvest stay cached until Reset.
+package resolve
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/becoded/go-harvest/harvest"
+)
+
+// DefaultCacheTTL is how long cached items are used before they are refreshed.
+const DefaultCacheTTL = 5 * time.Minute
+
+// ErrPath is returned by Resolve for a path that doesn't have two or three
+// parts.
+var ErrPath = errors.New(`expected "client / project / task" or "project / task"`)
+
+// A Match is a resolved path.
+type Match struct {
+	Client  *harvest.Client
+	Project *harvest.Project
+	Task    *harvest.Task
+}
+
+// Resolver resolves names to clients, projects, tasks and users. It is safe for
+// concurrent use. Create one with New.
+type Resolver struct {
+	client *harvest.APIClient
+	ttl    time.Duration
+	fuzzy  bool
+	now    func() time.Time
+
+	mu       sync.Mutex
+	clients  *cache[harvest.Client]
+	projects *cache[harvest.Project]
+	users    *cache[harvest.User]
+	tasks    map[int64]*cache[harvest.ProjectTaskAssignment]
+}
+
+// Option configures a Resolver.
+type Option func(*Resolver)
+
+// WithCacheTTL sets how long cached items are used before they are
+// refreshed. Defaults to DefaultCacheTTL.
+func WithCacheTTL(ttl time.Duration) Option {
+	return func(r *Resolver) {
+		r.ttl = ttl
+	}
+}
+
+// WithFuzzy sets whether names that are a typo or two away from a query
+// match it. Defaults to true.
+func WithFuzzy(fuzzy bool) Option {
+	return func(r *Resolver) {
+		r.fuzzy = fuzzy
+	}
+}
+
+// New returns a Resolver that uses client.
+func New(client *harvest.APIClient, opts ...Option) *Resolver {
+	r := &Resolver{
+		client: client,
+		ttl:    DefaultCacheTTL,
+		fuzzy:  true,
+		now:    time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	r.Reset()
+
+	return r
+}
+
+// Reset drops all cached items, so that the next lookups load them again.
+func (r *Resolver) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.clients = newCache((*harvest.Client).GetID, r.listClients)
+	r.projects = newCache((*harvest.Project).GetID, r.listProjects)
+	r.users = newCache((*harvest.User).GetID, r.listUsers)
+	r.tasks = map[int64]*cache[harvest.ProjectTaskAssignment]{}
+}
+
+// Resolve resolves a path of names separated by slashes: "client / project /
+// task", or "project / task" to match projects of any client.
+func (r *Resolver) Resolve(ctx context.Context, path string) (*Match, error) {
+	parts := strings.Split(path, "/")
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+
+	var (
+		m   Match
+		err error
+	)
+
+	switch len(parts) {
+	case 3: //nolint: mnd
+		if m.Client, err = r.Client(ctx, parts[0]); err != nil {
+			return nil, err
+		}
+
+		parts = parts[1:]
+	case 2: //nolint: mnd
+	default:
+		return nil, ErrPath
+	}
+
+	if m.Project, err = r.Project(ctx, m.Client.GetID(), parts[0]); err != nil {
+		return nil, err
+	}
+
+	if m.Task, err = r.Task(ctx, m.Project.GetID(), parts[1]); err != nil {
+		return nil, err
+	}
+
+	if m.Client == nil {
+		m.Client = m.Project.Client
+	}
+
+	return &m, nil
+}
+
+// Client returns the active client whose name matches query.
+func (r *Resolver) Client(ctx context.Context, query string) (*harvest.Client, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return find(ctx, r, r.clients, "client", query, func(c *harvest.Client) (candidate[harvest.Client], bool) {
+		return candidate[harvest.Client]{item: c, keys: []string{c.GetName()}, label: c.GetName()}, c.GetIsActive()
+	})
+}
+
+// Project returns the active project whose name or code matches query. If
+// clientID isn't 0, only projects of that client are considered.
+func (r *Resolver) Project(ctx context.Context, clientID int64, query string) (*harvest.Project, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return find(ctx, r, r.projects, "project", query, func(p *harvest.Project) (candidate[harvest.Project], bool) {
+		c := candidate[harvest.Project]{
+			item:  p,
+			keys:  []string{p.GetName(), p.GetCode()},
+			label: p.GetClient().GetName() + " / " + p.GetName(),
+		}
+
+		return c, p.GetIsActive() && (clientID == 0 || p.GetClient().GetID() == clientID)
+	})
+}
+
+// Task returns the task whose name matches query among the active task
+// assignments of the project with the given ID.
+func (r *Resolver) Task(ctx context.Context, projectID int64, query string) (*harvest.Task, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tasks, ok := r.tasks[projectID]
+	if !ok {
+		tasks = newCache((*harvest.ProjectTaskAssignment).GetID, r.listTaskAssignments(projectID))
+		r.tasks[projectID] = tasks
+	}
+
+	ta, err := find(ctx, r, tasks, "task", query,
+		func(ta *harvest.ProjectTaskAssignment) (candidate[harvest.ProjectTaskAssignment], bool) {
+			name := ta.GetTask().GetName()
+
+			return candidate[harvest.ProjectTaskAssignment]{item: ta, keys: []string{name}, label: name}, ta.GetIsActive()
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return ta.Task, nil
+}
+
+// User returns the active user whose full name or email address matches
+// query.
+func (r *Resolver) User(ctx context.Context, query string) (*harvest.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return find(ctx, r, r.users, "user", query, func(u *harvest.User) (candidate[harvest.User], bool) {
+		name := strings.TrimSpace(u.GetFirstName() + " " + u.GetLastName())
+
+		return candidate[harvest.User]{item: u, keys: []string{name, u.GetEmail()}, label: name}, u.GetIsActive()
+	})
+}
+
+// find matches query against the items of c for which keep returns true. A
+// stale cache is refreshed first, and a fresh one once more if nothing
+// matches, in case the item was added since.
+func find[T any](
+	ctx context.Context,
+	r *Resolver,
+	c *cache[T],
+	kind, query string,
+	keep func(*T) (candidate[T], bool),
+) (*T, error) {
+	refreshed := false
+
+	if c.synced.IsZero() || r.now().Sub(c.synced) >= r.ttl {
+		if err := c.refresh(ctx, r.now()); err != nil {
+			return nil, err
+		}
+
+		refreshed = true
+	}
+
+	candidates := func() []candidate[T] {
+		var cs []candidate[T]
+
+		for _, item := range c.items {
+			if cand, ok := keep(item); ok {
+				cs = append(cs, cand)
+			}
+		}
+
+		return cs
+	}
+
+	item, err := match(kind, query, candidates(), r.fuzzy)
+	if err == nil || refreshed || !errors.Is(err, ErrNotFound) {
+		return item, err
+	}
+
+	if err := c.refresh(ctx, r.now()); err != nil {
+		return nil, err
+	}
+
+	return match(kind, query, candidates(), r.fuzzy)
+}
+
+func (r *Resolver) listClients(ctx context.Context, since time.Time, page int) ([]*harvest.Client, *int, error) {
+	list, _, err := r.client.Client.List(ctx, &harvest.ClientListOptions{
+		UpdatedSince: since,
+		ListOptions:  harvest.ListOptions{Page: page, PerPage: perPage},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return list.Clients, list.NextPage, nil
+}
+
+func (r *Resolver) listProjects(ctx context.Context, since time.Time, page int) ([]*harvest.Project, *int, error) {
+	list, _, err := r.client.Project.List(ctx, &harvest.ProjectListOptions{
+		UpdatedSince: since,
+		ListOptions:  harvest.ListOptions{Page: page, PerPage: perPage},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return list.Projects, list.NextPage, nil
+}
+
+func (r *Resolver) listUsers(ctx context.Context, since time.Time, page int) ([]*harvest.User, *int, error) {
+	list, _, err := r.client.User.List(ctx, &harvest.UserListOptions{
+		UpdatedSince: since,
+		ListOptions:  harvest.ListOptions{Page: page, PerPage: perPage},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return list.Users, list.NextPage, nil
+}
+
+func (r *Resolver) listTaskAssignments(projectID int64) lister[harvest.ProjectTaskAssignment] {
+	return func(ctx context.Context, since time.Time, page int) ([]*harvest.ProjectTaskAssignment, *int, error) {
+		list, _, err := r.client.Project.ListTaskAssignments(ctx, projectID, &harvest.ProjectTaskAssignmentListOptions{
+			UpdatedSince: since,
+			ListOptions:  harvest.ListOptions{Page: page, PerPage: perPage},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return list.TaskAssignments, list.NextPage, nil
+	}
+}
@@ -0,0 +1,228 @@
+package resolve_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/becoded/go-harvest/harvest"
+	"github.com/becoded/go-harvest/harvesttest"
+	"github.com/becoded/go-harvest/resolve"
+)
+
+func TestResolver_Resolve(t *testing.T) {
+	t.Parallel()
+
+	srv := harvesttest.NewServer()
+	t.Cleanup(srv.Close)
+
+	acme := srv.AddClient(&harvest.Client{Name: harvest.String("Acme")})
+	acmeCorp := srv.AddClient(&harvest.Client{Name: harvest.String("Acme Corp")})
+	globex := srv.AddClient(&harvest.Client{Name: harvest.String("Globex")})
+	srv.AddClient(&harvest.Client{Name: harvest.String("Initech"), IsActive: harvest.Bool(false)})
+
+	website := srv.AddProject(&harvest.Project{Client: acme, Name: harvest.String("Website"), Code: harvest.String("WEB")})
+	webApp := srv.AddProject(&harvest.Project{Client: acme, Name: harvest.String("Web app")})
+	srv.AddProject(&harvest.Project{Client: acmeCorp, Name: harvest.String("Website")})
+	intranet := srv.AddProject(&harvest.Project{Client: globex, Name: harvest.String("Intranet")})
+	srv.AddProject(&harvest.Project{Client: globex, Name: harvest.String("Legacy"), IsActive: harvest.Bool(false)})
+
+	design := srv.AddTask(&harvest.Task{Name: harvest.String("Design")})
+	dev := srv.AddTask(&harvest.Task{Name: harvest.String("Development")})
+	devOps := srv.AddTask(&harvest.Task{Name: harvest.String("DevOps")})
+
+	for _, p := range []*harvest.Project{website, webApp, intranet} {
+		srv.AssignTask(p.GetID(), design.GetID())
+		srv.AssignTask(p.GetID(), dev.GetID())
+	}
+
+	srv.SetTaskAssignmentActive(srv.AssignTask(website.GetID(), devOps.GetID()).GetID(), false)
+
+	tests := []struct {
+		name        string
+		path        string
+		wantProject *harvest.Project
+		wantTask    *harvest.Task
+		wantErr     error
+		wantMsg     string
+	}{
+		{name: "exact", path: "Acme / Website / Design", wantProject: website, wantTask: design},
+		{name: "case and spaces", path: "acme/  website  /DESIGN", wantProject: website, wantTask: design},
+		{name: "project code", path: "Acme / web / Design", wantProject: website, wantTask: design},
+		{name: "prefix", path: "Glob / Intra / Des", wantProject: intranet, wantTask: design},
+		{name: "contains", path: "Globex / net / sign", wantProject: intranet, wantTask: design},
+		{name: "typo", path: "Acme / Webiste / Desgin", wantProject: website, wantTask: design},
+		{name: "project of any client", path: "Intranet / Development", wantProject: intranet, wantTask: dev},
+		{
+			name:    "ambiguous client",
+			path:    "Acm / Website / Design",
+			wantErr: resolve.ErrAmbiguous,
+			wantMsg: `client "Acm" is ambiguous: Acme, Acme Corp`,
+		},
+		{
+			name:    "ambiguous project",
+			path:    "Acme / We / Design",
+			wantErr: resolve.ErrAmbiguous,
+			wantMsg: `project "We" is ambiguous: Acme / Web app, Acme / Website`,
+		},
+		{
+			name:    "ambiguous project of any client",
+			path:    "Website / Design",
+			wantErr: resolve.ErrAmbiguous,
+			wantMsg: `project "Website" is ambiguous: Acme / Website, Acme Corp / Website`,
+		},
+		{name: "ambiguous task", path: "Acme / Website / De", wantErr: resolve.ErrAmbiguous},
+		{name: "archived task assignment", path: "Acme / Website / DevOps", wantErr: resolve.ErrNotFound},
+		{name: "archived client", path: "Initech / Website / Design", wantErr: resolve.ErrNotFound},
+		{name: "archived project", path: "Globex / Legacy / Design", wantErr: resolve.ErrNotFound},
+		{name: "unknown task", path: "Acme / Website / Meetings", wantErr: resolve.ErrNotFound},
+		{name: "empty", path: "Acme / / Design", wantErr: resolve.ErrNotFound},
+		{name: "too short", path: "Website", wantErr: resolve.ErrPath},
+	}
+
+	r := resolve.New(srv.Client())
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := r.Resolve(context.Background(), tt.path)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				if tt.wantMsg != "" {
+					assert.EqualError(t, err, tt.wantMsg)
+				}
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantProject.GetID(), m.Project.GetID())
+			assert.Equal(t, tt.wantProject.GetClient().GetID(), m.Client.GetID())
+			assert.Equal(t, tt.wantTask.GetID(), m.Task.GetID())
+		})
+	}
+}
+
+func TestResolver_WithFuzzy(t *testing.T) {
+	t.Parallel()
+
+	srv := harvesttest.NewServer()
+	defer srv.Close()
+
+	srv.AddClient(&harvest.Client{Name: harvest.String("Acme")})
+
+	_, err := resolve.New(srv.Client(), resolve.WithFuzzy(false)).Client(context.Background(), "Acne")
+	assert.ErrorIs(t, err, resolve.ErrNotFound)
+	assert.EqualError(t, err, `no client matches "Acne"`)
+
+	c, err := resolve.New(srv.Client()).Client(context.Background(), "Acne")
+	assert.NoError(t, err)
+	assert.Equal(t, "Acme", c.GetName())
+}
+
+func TestResolver_User(t *testing.T) {
+	t.Parallel()
+
+	srv := harvesttest.NewServer()
+	t.Cleanup(srv.Close)
+
+	jane := srv.AddUser(&harvest.User{
+		FirstName: harvest.String("Jane"),
+		LastName:  harvest.String("Doe"),
+		Email:     harvest.String("jane@example.com"),
+	})
+	srv.AddUser(&harvest.User{FirstName: harvest.String("Janet"), LastName: harvest.String("Roe")})
+	srv.AddUser(&harvest.User{
+		FirstName: harvest.String("John"),
+		LastName:  harvest.String("Doe"),
+		IsActive:  harvest.Bool(false),
+	})
+
+	tests := []struct {
+		name    string
+		query   string
+		want    *harvest.User
+		wantErr error
+	}{
+		{name: "full name", query: "jane doe", want: jane},
+		{name: "email", query: "Jane@Example.com", want: jane},
+		{name: "ambiguous", query: "Jan", wantErr: resolve.ErrAmbiguous},
+		{name: "archived user", query: "John Doe", wantErr: resolve.ErrNotFound},
+	}
+
+	r := resolve.New(srv.Client(), resolve.WithFuzzy(false))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			u, err := r.User(context.Background(), tt.query)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want.GetID(), u.GetID())
+		})
+	}
+}
+
+func TestResolver_refresh(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := harvesttest.NewServer()
+
+	defer srv.Close()
+
+	var (
+		mu      sync.Mutex
+		queries []string
+	)
+
+	client := srv.Client()
+	client.Use(func(next harvest.Doer) harvest.Doer {
+		return harvest.DoerFunc(func(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
+			mu.Lock()
+			queries = append(queries, req.URL.Path+"?"+req.URL.Query().Get("updated_since"))
+			mu.Unlock()
+
+			return next.Do(ctx, req, v)
+		})
+	})
+
+	acme := srv.AddClient(&harvest.Client{Name: harvest.String("Acme")})
+	r := resolve.New(client, resolve.WithCacheTTL(time.Hour))
+
+	_, err := r.Client(ctx, "Acme")
+	assert.NoError(t, err)
+
+	_, err = r.Client(ctx, "Acme")
+	assert.NoError(t, err)
+	assert.Len(t, queries, 1, "clients are cached")
+	assert.True(t, strings.HasSuffix(queries[0], "/clients?"), "the first load fetches everything")
+
+	srv.AddClient(&harvest.Client{Name: harvest.String("Globex")})
+
+	c, err := r.Client(ctx, "Globex")
+	assert.NoError(t, err, "an unknown name refreshes the cache")
+	assert.Equal(t, "Globex", c.GetName())
+	assert.Len(t, queries, 2)
+	assert.False(t, strings.HasSuffix(queries[1], "/clients?"), "refreshes only fetch updates")
+
+	_, _, err = client.Client.Update(ctx, acme.GetID(), &harvest.ClientUpdateRequest{IsActive: harvest.Bool(false)})
+	assert.NoError(t, err)
+
+	r = resolve.New(client, resolve.WithCacheTTL(0))
+
+	_, err = r.Client(ctx, "Acme")
+	assert.ErrorIs(t, err, resolve.ErrNotFound, "archived clients don't match")
+
+	r.Reset()
+
+	_, err = r.Client(ctx, "Globex")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,91 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrNoWindow is returned by Reconcile for resources that can't be listed by
+// spent date.
+var ErrNoWindow = errors.New("resource can't be reconciled")
+
+// A Tombstone records that a record was deleted from Harvest.
+type Tombstone struct {
+	Resource string
+	ID       int64
+	// DetectedAt is when the deletion was noticed, not when it happened.
+	DetectedAt time.Time
+}
+
+// A Deleter is a Store that can delete records. Reconcile deletes the records
+// it finds tombstones for from Stores that implement it.
+type Deleter interface {
+	Delete(ctx context.Context, resource string, ids []int64) error
+}
+
+// Reconcile finds which of the known records, the IDs of the records the
+// caller has with a spent date from from to to, were deleted from Harvest,
+// and returns a tombstone for each.
+//
+// It lists the window, which costs one request per 2000 records, and then
+// gets each known record that wasn't listed: it may have been deleted, or
+// moved out of the window since the caller last synced it.
+func (s *Syncer) Reconcile(ctx context.Context, r Resource, from, to time.Time, known []int64) ([]Tombstone, error) {
+	if r.Window == nil || r.Exists == nil {
+		return nil, fmt.Errorf("%w: %s", ErrNoWindow, r.Name)
+	}
+
+	listed := map[int64]bool{}
+
+	for page := 1; ; {
+		p, err := r.Window(ctx, from, to, page)
+		if err != nil {
+			return nil, fmt.Errorf("reconcile %s: %w", r.Name, err)
+		}
+
+		for _, rec := range p.Records {
+			listed[rec.ID] = true
+		}
+
+		if p.NextPage == nil {
+			break
+		}
+
+		page = *p.NextPage
+	}
+
+	var (
+		tombstones []Tombstone
+		ids        []int64
+	)
+
+	for _, id := range known {
+		if listed[id] {
+			continue
+		}
+
+		listed[id] = true
+
+		ok, err := r.Exists(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("reconcile %s: %w", r.Name, err)
+		}
+
+		if ok {
+			continue
+		}
+
+		tombstones = append(tombstones, Tombstone{Resource: r.Name, ID: id, DetectedAt: s.now()})
+		ids = append(ids, id)
+	}
+
+	if d, ok := s.store.(Deleter); ok && len(ids) > 0 {
+		if err := d.Delete(ctx, r.Name, ids); err != nil {
+			return nil, err
+		}
+	}
+
+	return tombstones, nil
+}
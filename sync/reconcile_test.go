@@ -0,0 +1,81 @@
+package sync_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/becoded/go-harvest/harvest"
+	"github.com/becoded/go-harvest/harvesttest"
+	"github.com/becoded/go-harvest/sync"
+)
+
+func TestSyncer_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := harvesttest.NewServer()
+
+	defer srv.Close()
+
+	day := func(d int) *harvest.Date {
+		return harvest.DateP(harvest.Date{Time: time.Date(2024, 3, d, 0, 0, 0, 0, time.UTC)})
+	}
+
+	project := srv.AddProject(&harvest.Project{Name: harvest.String("Website")})
+	task := srv.AddTask(&harvest.Task{Name: harvest.String("Development")})
+	srv.AssignTask(project.GetID(), task.GetID())
+	srv.AssignUser(project.GetID(), srv.CurrentUser().GetID())
+
+	var entries []*harvest.TimeEntry
+	for _, d := range []int{4, 5, 6, 20} {
+		entries = append(entries, srv.AddTimeEntry(&harvest.TimeEntry{
+			Project:   project,
+			Task:      task,
+			SpentDate: day(d),
+			Hours:     harvest.HoursP(1),
+		}))
+	}
+
+	client := srv.Client()
+	store := sync.NewMemoryStore()
+	s := sync.New(store)
+
+	_, err := s.Sync(ctx, sync.TimeEntries(client))
+	assert.NoError(t, err)
+
+	// The second entry is deleted and the third moved out of the window.
+	srv.DeleteTimeEntry(entries[1].GetID())
+
+	_, _, err = client.Timesheet.UpdateTimeEntry(ctx, entries[2].GetID(), &harvest.TimeEntryUpdate{SpentDate: day(12)})
+	assert.NoError(t, err)
+
+	known := []int64{entries[0].GetID(), entries[1].GetID(), entries[2].GetID()}
+	from := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	tombstones, err := s.Reconcile(ctx, sync.TimeEntries(client), from, to, known)
+	assert.NoError(t, err)
+	assert.Len(t, tombstones, 1)
+	assert.Equal(t, "time_entries", tombstones[0].Resource)
+	assert.Equal(t, entries[1].GetID(), tombstones[0].ID)
+	assert.WithinDuration(t, time.Now(), tombstones[0].DetectedAt, time.Second)
+
+	_, ok := store.Get("time_entries", entries[1].GetID())
+	assert.False(t, ok, "deleted records are removed from the store")
+	assert.Len(t, store.Records("time_entries"), 3)
+
+	expense := srv.AddExpense(&harvest.Expense{Project: project, SpentDate: day(5), TotalCost: harvest.Float64(10)})
+
+	_, err = client.Expense.Delete(ctx, expense.GetID())
+	assert.NoError(t, err)
+
+	tombstones, err = s.Reconcile(ctx, sync.Expenses(client), from, to, []int64{expense.GetID()})
+	assert.NoError(t, err)
+	assert.Len(t, tombstones, 1)
+
+	_, err = s.Reconcile(ctx, sync.Clients(client), from, to, nil)
+	assert.ErrorIs(t, err, sync.ErrNoWindow)
+}
@@ -0,0 +1,304 @@
+package sync
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/becoded/go-harvest/harvest"
+)
+
+// perPage is the page size of the built-in resources, the most Harvest
+// allows.
+const perPage = 2000
+
+// Clients returns the clients resource, with *harvest.Client values.
+func Clients(client *harvest.APIClient) Resource {
+	return resource("clients", (*harvest.Client).GetID, (*harvest.Client).GetUpdatedAt,
+		func(
+			ctx context.Context,
+			since time.Time,
+			page harvest.ListOptions,
+		) ([]*harvest.Client, *int, *http.Response, error) {
+			list, resp, err := client.Client.List(ctx, &harvest.ClientListOptions{UpdatedSince: since, ListOptions: page})
+			if err != nil {
+				return nil, nil, resp, err
+			}
+
+			return list.Clients, list.NextPage, resp, nil
+		})
+}
+
+// Contacts returns the client contacts resource, with *harvest.ClientContact
+// values.
+func Contacts(client *harvest.APIClient) Resource {
+	return resource("contacts", (*harvest.ClientContact).GetID, (*harvest.ClientContact).GetUpdatedAt,
+		func(
+			ctx context.Context,
+			since time.Time,
+			page harvest.ListOptions,
+		) ([]*harvest.ClientContact, *int, *http.Response, error) {
+			list, resp, err := client.Client.ListContacts(ctx, &harvest.ClientContactListOptions{
+				UpdatedSince: since,
+				ListOptions:  page,
+			})
+			if err != nil {
+				return nil, nil, resp, err
+			}
+
+			return list.ClientContacts, list.NextPage, resp, nil
+		})
+}
+
+// Projects returns the projects resource, with *harvest.Project values.
+func Projects(client *harvest.APIClient) Resource {
+	return resource("projects", (*harvest.Project).GetID, (*harvest.Project).GetUpdatedAt,
+		func(
+			ctx context.Context,
+			since time.Time,
+			page harvest.ListOptions,
+		) ([]*harvest.Project, *int, *http.Response, error) {
+			list, resp, err := client.Project.List(ctx, &harvest.ProjectListOptions{UpdatedSince: since, ListOptions: page})
+			if err != nil {
+				return nil, nil, resp, err
+			}
+
+			return list.Projects, list.NextPage, resp, nil
+		})
+}
+
+// Tasks returns the tasks resource, with *harvest.Task values.
+func Tasks(client *harvest.APIClient) Resource {
+	return resource("tasks", (*harvest.Task).GetID, (*harvest.Task).GetUpdatedAt,
+		func(
+			ctx context.Context,
+			since time.Time,
+			page harvest.ListOptions,
+		) ([]*harvest.Task, *int, *http.Response, error) {
+			list, resp, err := client.Task.List(ctx, &harvest.TaskListOptions{UpdatedSince: since, ListOptions: page})
+			if err != nil {
+				return nil, nil, resp, err
+			}
+
+			return list.Tasks, list.NextPage, resp, nil
+		})
+}
+
+// Users returns the users resource, with *harvest.User values.
+func Users(client *harvest.APIClient) Resource {
+	return resource("users", (*harvest.User).GetID, (*harvest.User).GetUpdatedAt,
+		func(
+			ctx context.Context,
+			since time.Time,
+			page harvest.ListOptions,
+		) ([]*harvest.User, *int, *http.Response, error) {
+			list, resp, err := client.User.List(ctx, &harvest.UserListOptions{UpdatedSince: since, ListOptions: page})
+			if err != nil {
+				return nil, nil, resp, err
+			}
+
+			return list.Users, list.NextPage, resp, nil
+		})
+}
+
+// TimeEntries returns the time entries resource, with *harvest.TimeEntry
+// values. It can be reconciled.
+func TimeEntries(client *harvest.APIClient) Resource {
+	r := resource("time_entries", (*harvest.TimeEntry).GetID, (*harvest.TimeEntry).GetUpdatedAt,
+		func(
+			ctx context.Context,
+			since time.Time,
+			page harvest.ListOptions,
+		) ([]*harvest.TimeEntry, *int, *http.Response, error) {
+			list, resp, err := client.Timesheet.List(ctx, &harvest.TimeEntryListOptions{
+				UpdatedSince: timeP(since),
+				ListOptions:  page,
+			})
+			if err != nil {
+				return nil, nil, resp, err
+			}
+
+			return list.TimeEntries, list.NextPage, resp, nil
+		})
+
+	r.Window = window((*harvest.TimeEntry).GetID, (*harvest.TimeEntry).GetUpdatedAt,
+		func(ctx context.Context, from, to *harvest.Date, page harvest.ListOptions) ([]*harvest.TimeEntry, *int, error) {
+			list, _, err := client.Timesheet.List(ctx, &harvest.TimeEntryListOptions{From: from, To: to, ListOptions: page})
+			if err != nil {
+				return nil, nil, err
+			}
+
+			return list.TimeEntries, list.NextPage, nil
+		})
+	r.Exists = exists(func(ctx context.Context, id int64) (*http.Response, error) {
+		_, resp, err := client.Timesheet.Get(ctx, id)
+
+		return resp, err
+	})
+
+	return r
+}
+
+// Expenses returns the expenses resource, with *harvest.Expense values. It
+// can be reconciled.
+func Expenses(client *harvest.APIClient) Resource {
+	r := resource("expenses", (*harvest.Expense).GetID, (*harvest.Expense).GetUpdatedAt,
+		func(
+			ctx context.Context,
+			since time.Time,
+			page harvest.ListOptions,
+		) ([]*harvest.Expense, *int, *http.Response, error) {
+			list, resp, err := client.Expense.List(ctx, &harvest.ExpenseListOptions{
+				UpdatedSince: timeP(since),
+				ListOptions:  page,
+			})
+			if err != nil {
+				return nil, nil, resp, err
+			}
+
+			return list.Expenses, list.NextPage, resp, nil
+		})
+
+	r.Window = window((*harvest.Expense).GetID, (*harvest.Expense).GetUpdatedAt,
+		func(ctx context.Context, from, to *harvest.Date, page harvest.ListOptions) ([]*harvest.Expense, *int, error) {
+			list, _, err := client.Expense.List(ctx, &harvest.ExpenseListOptions{From: from, To: to, ListOptions: page})
+			if err != nil {
+				return nil, nil, err
+			}
+
+			return list.Expenses, list.NextPage, nil
+		})
+	r.Exists = exists(func(ctx context.Context, id int64) (*http.Response, error) {
+		_, resp, err := client.Expense.Get(ctx, id)
+
+		return resp, err
+	})
+
+	return r
+}
+
+// ExpenseCategories returns the expense categories resource, with
+// *harvest.ExpenseCategory values.
+func ExpenseCategories(client *harvest.APIClient) Resource {
+	return resource("expense_categories", (*harvest.ExpenseCategory).GetID, (*harvest.ExpenseCategory).GetUpdatedAt,
+		func(
+			ctx context.Context,
+			since time.Time,
+			page harvest.ListOptions,
+		) ([]*harvest.ExpenseCategory, *int, *http.Response, error) {
+			list, resp, err := client.Expense.ListExpenseCategories(ctx, &harvest.ExpenseCategoryListOptions{
+				UpdatedSince: timeP(since),
+				ListOptions:  page,
+			})
+			if err != nil {
+				return nil, nil, resp, err
+			}
+
+			return list.ExpenseCategories, list.NextPage, resp, nil
+		})
+}
+
+// Invoices returns the invoices resource, with *harvest.Invoice values.
+func Invoices(client *harvest.APIClient) Resource {
+	return resource("invoices", (*harvest.Invoice).GetID, (*harvest.Invoice).GetUpdatedAt,
+		func(
+			ctx context.Context,
+			since time.Time,
+			page harvest.ListOptions,
+		) ([]*harvest.Invoice, *int, *http.Response, error) {
+			list, resp, err := client.Invoice.List(ctx, &harvest.InvoiceListOptions{UpdatedSince: since, ListOptions: page})
+			if err != nil {
+				return nil, nil, resp, err
+			}
+
+			return list.Invoices, list.NextPage, resp, nil
+		})
+}
+
+// Estimates returns the estimates resource, with *harvest.Estimate values.
+func Estimates(client *harvest.APIClient) Resource {
+	return resource("estimates", (*harvest.Estimate).GetID, (*harvest.Estimate).GetUpdatedAt,
+		func(
+			ctx context.Context,
+			since time.Time,
+			page harvest.ListOptions,
+		) ([]*harvest.Estimate, *int, *http.Response, error) {
+			list, resp, err := client.Estimate.List(ctx, &harvest.EstimateListOptions{UpdatedSince: since, ListOptions: page})
+			if err != nil {
+				return nil, nil, resp, err
+			}
+
+			return list.Estimates, list.NextPage, resp, nil
+		})
+}
+
+// resource adapts a typed list call to a Resource.
+func resource[T any](
+	name string,
+	id func(*T) int64,
+	updatedAt func(*T) time.Time,
+	list func(ctx context.Context, since time.Time, page harvest.ListOptions) ([]*T, *int, *http.Response, error),
+) Resource {
+	return Resource{
+		Name: name,
+		List: func(ctx context.Context, since time.Time, page int) (*Page, error) {
+			items, next, resp, err := list(ctx, since, harvest.ListOptions{Page: page, PerPage: perPage})
+			if err != nil {
+				return nil, err
+			}
+
+			return &Page{Records: records(items, id, updatedAt), NextPage: next, Response: resp}, nil
+		},
+	}
+}
+
+// window adapts a typed list call filtered by spent date to a WindowFunc.
+func window[T any](
+	id func(*T) int64,
+	updatedAt func(*T) time.Time,
+	list func(ctx context.Context, from, to *harvest.Date, page harvest.ListOptions) ([]*T, *int, error),
+) WindowFunc {
+	return func(ctx context.Context, from, to time.Time, page int) (*Page, error) {
+		items, next, err := list(ctx,
+			harvest.DateP(harvest.Date{Time: from}),
+			harvest.DateP(harvest.Date{Time: to}),
+			harvest.ListOptions{Page: page, PerPage: perPage})
+		if err != nil {
+			return nil, err
+		}
+
+		return &Page{Records: records(items, id, updatedAt), NextPage: next}, nil
+	}
+}
+
+// exists adapts a get call to an ExistsFunc: a 404 means the record was
+// deleted.
+func exists(get func(ctx context.Context, id int64) (*http.Response, error)) ExistsFunc {
+	return func(ctx context.Context, id int64) (bool, error) {
+		resp, err := get(ctx, id)
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return false, nil
+		}
+
+		return err == nil, err
+	}
+}
+
+func records[T any](items []*T, id func(*T) int64, updatedAt func(*T) time.Time) []Record {
+	records := make([]Record, len(items))
+	for i, item := range items {
+		records[i] = Record{ID: id(item), UpdatedAt: updatedAt(item), Value: item}
+	}
+
+	return records
+}
+
+// timeP returns nil for the zero time, for list options whose UpdatedSince
+// is a pointer.
+func timeP(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
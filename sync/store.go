@@ -0,0 +1,99 @@
+package sync
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	stdsync "sync"
+)
+
+// MemoryStore is a Store that keeps everything in memory. It is safe for
+// concurrent use.
+type MemoryStore struct {
+	mu      stdsync.Mutex
+	records map[string]map[int64]Record
+	states  map[string]State
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		records: map[string]map[int64]Record{},
+		states:  map[string]State{},
+	}
+}
+
+// Upsert implements Store.
+func (s *MemoryStore) Upsert(_ context.Context, resource string, records []Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m, ok := s.records[resource]
+	if !ok {
+		m = map[int64]Record{}
+		s.records[resource] = m
+	}
+
+	for _, r := range records {
+		m[r.ID] = r
+	}
+
+	return nil
+}
+
+// State implements Store.
+func (s *MemoryStore) State(_ context.Context, resource string) (State, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.states[resource], nil
+}
+
+// SetState implements Store.
+func (s *MemoryStore) SetState(_ context.Context, resource string, state State) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.states[resource] = state
+
+	return nil
+}
+
+// Delete implements Deleter.
+func (s *MemoryStore) Delete(_ context.Context, resource string, ids []int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, id := range ids {
+		delete(s.records[resource], id)
+	}
+
+	return nil
+}
+
+// Get returns the record of the resource with the given ID.
+func (s *MemoryStore) Get(resource string, id int64) (Record, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.records[resource][id]
+
+	return r, ok
+}
+
+// Records returns the records of the resource, ordered by ID.
+func (s *MemoryStore) Records(resource string) []Record {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	records := make([]Record, 0, len(s.records[resource]))
+	for _, r := range s.records[resource] {
+		records = append(records, r)
+	}
+
+	slices.SortFunc(records, func(a, b Record) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return records
+}
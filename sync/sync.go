@@ -0,0 +1,202 @@
+// Package sync keeps a local mirror of Harvest resources up to date.
+//
+// Each run fetches only the records that changed since the previous run,
+// using the UpdatedSince filter of the list endpoints, and upserts them into
+// a Store. The Store also keeps a high-water mark per resource, and the
+// progress of a run, so a run that was interrupted picks up where it
+// stopped:
+//
+//	store := sync.NewMemoryStore()
+//	s := sync.New(store)
+//
+//	results, err := s.Sync(ctx, sync.Clients(service), sync.Projects(service), sync.TimeEntries(service))
+//
+// Deleted records are never reported by UpdatedSince. Reconcile finds the
+// time entries and expenses that were deleted from a date window.
+package sync
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// A Record is one synced item of a resource.
+type Record struct {
+	ID        int64
+	UpdatedAt time.Time
+	// Value is the item as returned by the API, such as a *harvest.TimeEntry.
+	Value interface{}
+}
+
+// A Page is one page of a list call.
+type Page struct {
+	Records  []Record
+	NextPage *int
+	Response *http.Response
+}
+
+// A ListFunc fetches a page of the records updated since the given time, or
+// of all records if it is zero.
+type ListFunc func(ctx context.Context, since time.Time, page int) (*Page, error)
+
+// A WindowFunc fetches a page of the records with a spent date from from to
+// to, inclusive.
+type WindowFunc func(ctx context.Context, from, to time.Time, page int) (*Page, error)
+
+// An ExistsFunc reports whether the record with the given ID still exists.
+type ExistsFunc func(ctx context.Context, id int64) (bool, error)
+
+// A Resource is a list endpoint to sync, such as the one returned by
+// TimeEntries.
+type Resource struct {
+	// Name identifies the resource in the Store, such as "time_entries".
+	Name string
+	List ListFunc
+	// Window and Exists are used by Reconcile. They are only set for
+	// resources with a spent date: time entries and expenses.
+	Window WindowFunc
+	Exists ExistsFunc
+}
+
+// State is what a Store keeps about a resource between runs.
+type State struct {
+	// Mark is the UpdatedSince of the next run: the time at which the last
+	// complete run started, according to the Harvest servers. It is zero
+	// before the first run.
+	Mark time.Time `json:"mark"`
+	// Run is the progress of a run that hasn't completed, if any.
+	Run *Progress `json:"run,omitempty"`
+}
+
+// Progress is the position of a run that hasn't completed.
+type Progress struct {
+	// Started becomes the Mark once the run completes.
+	Started time.Time `json:"started"`
+	// Page is the last page whose records were stored.
+	Page int `json:"page"`
+}
+
+// A Store keeps the synced records and the State of each resource.
+// Implementations must make Upsert idempotent: records can be stored more
+// than once when a run is resumed.
+type Store interface {
+	// Upsert inserts the records of the resource, replacing those with the
+	// same ID.
+	Upsert(ctx context.Context, resource string, records []Record) error
+	// State returns the state of the resource, or the zero State if it has
+	// never been synced.
+	State(ctx context.Context, resource string) (State, error)
+	// SetState saves the state of the resource.
+	SetState(ctx context.Context, resource string, state State) error
+}
+
+// A Result describes the run of one resource.
+type Result struct {
+	Resource string
+	// Since is the UpdatedSince the records were fetched with.
+	Since time.Time
+	// Mark is the new high-water mark.
+	Mark time.Time
+	// Resumed is set when the run continued an interrupted one.
+	Resumed  bool
+	Pages    int
+	Upserted int
+}
+
+// Syncer runs syncs against a Store. Create one with New.
+type Syncer struct {
+	store Store
+	now   func() time.Time
+}
+
+// New returns a Syncer that stores records and state in store.
+func New(store Store) *Syncer {
+	return &Syncer{store: store, now: time.Now}
+}
+
+// Sync brings the store up to date with each of the resources in turn. It
+// stops at the first error and returns the results of the resources that
+// completed.
+func (s *Syncer) Sync(ctx context.Context, resources ...Resource) ([]*Result, error) {
+	results := make([]*Result, 0, len(resources))
+
+	for _, r := range resources {
+		res, err := s.sync(ctx, r)
+		if err != nil {
+			return results, fmt.Errorf("sync %s: %w", r.Name, err)
+		}
+
+		results = append(results, res)
+	}
+
+	return results, nil
+}
+
+func (s *Syncer) sync(ctx context.Context, r Resource) (*Result, error) {
+	state, err := s.store.State(ctx, r.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Result{Resource: r.Name, Since: state.Mark}
+	run := state.Run
+	page := 1
+
+	if run != nil {
+		// Start again at the last stored page rather than the one after it:
+		// records deleted in the meantime shift the later ones to earlier
+		// pages.
+		res.Resumed = true
+		page = max(1, run.Page)
+	}
+
+	for {
+		p, err := r.List(ctx, state.Mark, page)
+		if err != nil {
+			return nil, err
+		}
+
+		if run == nil {
+			run = &Progress{Started: serverTime(p.Response, s.now)}
+		}
+
+		if len(p.Records) > 0 {
+			if err := s.store.Upsert(ctx, r.Name, p.Records); err != nil {
+				return nil, err
+			}
+		}
+
+		res.Pages++
+		res.Upserted += len(p.Records)
+		run.Page = page
+
+		if p.NextPage == nil {
+			break
+		}
+
+		if err := s.store.SetState(ctx, r.Name, State{Mark: state.Mark, Run: run}); err != nil {
+			return nil, err
+		}
+
+		page = *p.NextPage
+	}
+
+	res.Mark = run.Started
+
+	return res, s.store.SetState(ctx, r.Name, State{Mark: run.Started})
+}
+
+// serverTime returns the Date of resp, falling back to the local clock. Using
+// the server's clock keeps the mark consistent with the updated_at of the
+// records, whatever the local clock says.
+func serverTime(resp *http.Response, now func() time.Time) time.Time {
+	if resp != nil {
+		if t, err := http.ParseTime(resp.Header.Get("Date")); err == nil {
+			return t
+		}
+	}
+
+	return now().UTC()
+}
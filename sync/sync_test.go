@@ -0,0 +1,145 @@
+package sync_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/becoded/go-harvest/harvest"
+	"github.com/becoded/go-harvest/harvesttest"
+	"github.com/becoded/go-harvest/sync"
+)
+
+func TestSyncer_Sync(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := harvesttest.NewServer()
+
+	defer srv.Close()
+
+	// Records are stamped by the server's clock, the mark by the Date header.
+	past := time.Now().Add(-time.Hour)
+	srv.SetClock(func() time.Time { return past })
+
+	acme := srv.AddClient(&harvest.Client{Name: harvest.String("Acme")})
+	globex := srv.AddClient(&harvest.Client{Name: harvest.String("Globex")})
+	website := srv.AddProject(&harvest.Project{Client: acme, Name: harvest.String("Website")})
+	dev := srv.AddTask(&harvest.Task{Name: harvest.String("Development")})
+	srv.AssignTask(website.GetID(), dev.GetID())
+	srv.AssignUser(website.GetID(), srv.CurrentUser().GetID())
+
+	entry := srv.AddTimeEntry(&harvest.TimeEntry{
+		Project:   website,
+		Task:      dev,
+		SpentDate: harvest.DateP(harvest.Date{Time: past}),
+		Hours:     harvest.HoursP(1),
+	})
+
+	client := srv.Client()
+	store := sync.NewMemoryStore()
+	s := sync.New(store)
+
+	results, err := s.Sync(ctx, sync.Clients(client), sync.TimeEntries(client))
+	assert.NoError(t, err)
+	assert.Len(t, results, 2)
+	assert.Equal(t, 2, results[0].Upserted)
+	assert.Equal(t, 1, results[1].Upserted)
+	assert.True(t, results[0].Since.IsZero())
+	assert.WithinDuration(t, time.Now(), results[0].Mark, 2*time.Second)
+	assert.Len(t, store.Records("clients"), 2)
+
+	state, err := store.State(ctx, "clients")
+	assert.NoError(t, err)
+	assert.Equal(t, sync.State{Mark: results[0].Mark}, state)
+
+	future := time.Now().Add(time.Hour)
+	srv.SetClock(func() time.Time { return future })
+
+	_, _, err = client.Client.Update(ctx, globex.GetID(), &harvest.ClientUpdateRequest{Name: harvest.String("Globex Inc")})
+	assert.NoError(t, err)
+
+	_, _, err = client.Timesheet.UpdateTimeEntry(ctx, entry.GetID(), &harvest.TimeEntryUpdate{Notes: harvest.String("Fixed")})
+	assert.NoError(t, err)
+
+	results, err = s.Sync(ctx, sync.Clients(client), sync.TimeEntries(client))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, results[0].Upserted, "only changed clients are fetched")
+	assert.Equal(t, 1, results[1].Upserted)
+	assert.Equal(t, state.Mark, results[0].Since)
+
+	r, ok := store.Get("clients", globex.GetID())
+	assert.True(t, ok)
+	assert.Equal(t, "Globex Inc", r.Value.(*harvest.Client).GetName())
+	assert.Equal(t, future.UTC().Truncate(time.Second), r.UpdatedAt)
+
+	r, ok = store.Get("time_entries", entry.GetID())
+	assert.True(t, ok)
+	assert.Equal(t, "Fixed", r.Value.(*harvest.TimeEntry).GetNotes())
+}
+
+func TestSyncer_resume(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	errDown := errors.New("down")
+
+	var (
+		pages []int
+		fail  = true
+		now   = time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	)
+
+	res := sync.Resource{
+		Name: "items",
+		List: func(_ context.Context, _ time.Time, page int) (*sync.Page, error) {
+			pages = append(pages, page)
+
+			if page == 3 && fail {
+				fail = false
+
+				return nil, errDown
+			}
+
+			p := &sync.Page{
+				Records:  []sync.Record{{ID: int64(page)}},
+				Response: &http.Response{Header: http.Header{"Date": {now.Format(http.TimeFormat)}}},
+			}
+
+			if page < 4 {
+				p.NextPage = harvest.Int(page + 1)
+			}
+
+			now = now.Add(time.Minute)
+
+			return p, nil
+		},
+	}
+
+	store := sync.NewMemoryStore()
+	s := sync.New(store)
+
+	_, err := s.Sync(ctx, res)
+	assert.ErrorIs(t, err, errDown)
+	assert.EqualError(t, err, "sync items: down")
+
+	state, err := store.State(ctx, "items")
+	assert.NoError(t, err)
+	assert.True(t, state.Mark.IsZero())
+	assert.Equal(t, &sync.Progress{Started: time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC), Page: 2}, state.Run)
+
+	results, err := s.Sync(ctx, res)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 2, 3, 4}, pages, "a resumed run starts at the last stored page")
+	assert.True(t, results[0].Resumed)
+	assert.Equal(t, state.Run.Started, results[0].Mark)
+	assert.Len(t, store.Records("items"), 4)
+
+	state, err = store.State(ctx, "items")
+	assert.NoError(t, err)
+	assert.Equal(t, sync.State{Mark: results[0].Mark}, state)
+}
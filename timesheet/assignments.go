@@ -0,0 +1,248 @@
+package timesheet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/becoded/go-harvest/harvest"
+)
+
+// DefaultCacheTTL is how long a user's project assignments are reused.
+const DefaultCacheTTL = 5 * time.Minute
+
+// Reasons a time entry can't be created, wrapped by *AssignmentError.
+var (
+	ErrProjectInactive = errors.New("project is archived")
+	ErrTaskInactive    = errors.New("task is archived on the project")
+	ErrNotAssigned     = errors.New("not assigned")
+)
+
+// An AssignmentError explains why a user can't track time on a project and
+// task. Use errors.Is with ErrProjectInactive, ErrTaskInactive and
+// ErrNotAssigned to tell the reasons apart.
+type AssignmentError struct {
+	// UserID is 0 for the currently authenticated user.
+	UserID    int64
+	ProjectID int64
+	TaskID    int64
+	Err       error
+	msg       string
+}
+
+func (e *AssignmentError) Error() string {
+	return e.msg
+}
+
+func (e *AssignmentError) Unwrap() error {
+	return e.Err
+}
+
+// AssignmentChecker checks time entries against the project and task
+// assignments of their user before they are created, so that mistakes are
+// reported by name instead of as a 422 from the API. Assignments are cached
+// per user. Create one with NewAssignmentChecker.
+type AssignmentChecker struct {
+	client *harvest.APIClient
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu    sync.Mutex
+	cache map[int64]*userAssignments
+}
+
+type userAssignments struct {
+	fetched  time.Time
+	projects map[int64]*harvest.UserProjectAssignment
+	// missing holds when projects were last found missing from the list.
+	missing map[int64]time.Time
+}
+
+// CheckerOption configures an AssignmentChecker.
+type CheckerOption func(*AssignmentChecker)
+
+// WithCacheTTL sets how long assignments are reused. Defaults to
+// DefaultCacheTTL.
+func WithCacheTTL(ttl time.Duration) CheckerOption {
+	return func(c *AssignmentChecker) {
+		c.ttl = ttl
+	}
+}
+
+// NewAssignmentChecker returns an AssignmentChecker that uses client.
+func NewAssignmentChecker(client *harvest.APIClient, opts ...CheckerOption) *AssignmentChecker {
+	c := &AssignmentChecker{
+		client: client,
+		ttl:    DefaultCacheTTL,
+		now:    time.Now,
+		cache:  map[int64]*userAssignments{},
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// CheckDuration checks a request for CreateTimeEntryViaDuration.
+func (c *AssignmentChecker) CheckDuration(ctx context.Context, data *harvest.TimeEntryCreateViaDuration) error {
+	return c.Check(ctx, data.GetUserID(), data.GetProjectID(), data.GetTaskID())
+}
+
+// CheckStartEndTime checks a request for CreateTimeEntryViaStartEndTime.
+func (c *AssignmentChecker) CheckStartEndTime(ctx context.Context, data *harvest.TimeEntryCreateViaStartEndTime) error {
+	return c.Check(ctx, data.GetUserID(), data.GetProjectID(), data.GetTaskID())
+}
+
+// Check returns an *AssignmentError if the user with the given ID, or the
+// currently authenticated user if userID is 0, can't track time on the
+// project and task. Other errors come from the API.
+//
+// Only the user's active projects are listed, and only administrators can
+// read other projects, so an archived project the user isn't on is reported
+// with ErrNotAssigned.
+func (c *AssignmentChecker) Check(ctx context.Context, userID, projectID, taskID int64) error {
+	fail := func(err error, format string, args ...any) error {
+		return &AssignmentError{
+			UserID:    userID,
+			ProjectID: projectID,
+			TaskID:    taskID,
+			Err:       err,
+			msg:       fmt.Sprintf(format, args...),
+		}
+	}
+
+	pa, err := c.assignment(ctx, userID, projectID)
+	if err != nil {
+		return err
+	}
+
+	if pa == nil {
+		return fail(ErrNotAssigned, "user is not assigned to active project %d", projectID)
+	}
+
+	projectName := pa.GetProject().GetName()
+
+	if pa.Project != nil && pa.Project.IsActive != nil && !*pa.Project.IsActive {
+		return fail(ErrProjectInactive, "project %q is archived", projectName)
+	}
+
+	if pa.IsActive != nil && !*pa.IsActive {
+		return fail(ErrNotAssigned, "user assignment to project %q is archived", projectName)
+	}
+
+	for _, ta := range pa.GetTaskAssignments() {
+		if ta.GetTask().GetID() != taskID {
+			continue
+		}
+
+		if !ta.GetIsActive() {
+			return fail(ErrTaskInactive, "task %q is archived on project %q", ta.GetTask().GetName(), projectName)
+		}
+
+		return nil
+	}
+
+	return fail(ErrNotAssigned, "task %d is not assigned to project %q", taskID, projectName)
+}
+
+// Invalidate drops the cached assignments of the user with the given ID, or
+// of the currently authenticated user if userID is 0.
+func (c *AssignmentChecker) Invalidate(userID int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.cache, userID)
+}
+
+// assignment returns the user's assignment to the project, or nil. A cached
+// list that lacks the project is refreshed once, in case the user was
+// assigned since; if the project is still missing, that is cached for the
+// TTL as well.
+func (c *AssignmentChecker) assignment(
+	ctx context.Context,
+	userID, projectID int64,
+) (*harvest.UserProjectAssignment, error) {
+	now := c.now()
+
+	c.mu.Lock()
+	cached := c.cache[userID]
+	c.mu.Unlock()
+
+	if cached != nil && now.Sub(cached.fetched) < c.ttl {
+		if pa, ok := cached.projects[projectID]; ok {
+			return pa, nil
+		}
+
+		if missed, ok := cached.missing[projectID]; ok && now.Sub(missed) < c.ttl {
+			return nil, nil
+		}
+	}
+
+	fresh, err := c.fetch(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if cached != nil {
+		for id, missed := range cached.missing {
+			if _, ok := fresh.projects[id]; !ok && now.Sub(missed) < c.ttl {
+				fresh.missing[id] = missed
+			}
+		}
+	}
+
+	pa := fresh.projects[projectID]
+	if pa == nil {
+		fresh.missing[projectID] = fresh.fetched
+	}
+
+	c.mu.Lock()
+	c.cache[userID] = fresh
+	c.mu.Unlock()
+
+	return pa, nil
+}
+
+func (c *AssignmentChecker) fetch(ctx context.Context, userID int64) (*userAssignments, error) {
+	ua := &userAssignments{
+		fetched:  c.now(),
+		projects: map[int64]*harvest.UserProjectAssignment{},
+		missing:  map[int64]time.Time{},
+	}
+	page := harvest.ListOptions{Page: 1, PerPage: 100}
+
+	for {
+		var (
+			list *harvest.UserProjectAssignmentList
+			err  error
+		)
+
+		if userID == 0 {
+			list, _, err = c.client.User.GetMyProjectAssignments(ctx, &harvest.MyProjectAssignmentListOptions{
+				ListOptions: page,
+			})
+		} else {
+			list, _, err = c.client.User.ListProjectAssignments(ctx, userID, &harvest.UserProjectAssignmentListOptions{
+				ListOptions: page,
+			})
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pa := range list.ProjectAssignments {
+			ua.projects[pa.GetProject().GetID()] = pa
+		}
+
+		if list.NextPage == nil {
+			return ua, nil
+		}
+
+		page.Page = *list.NextPage
+	}
+}
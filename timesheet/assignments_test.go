@@ -0,0 +1,170 @@
+package timesheet_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/becoded/go-harvest/harvest"
+	"github.com/becoded/go-harvest/harvesttest"
+	"github.com/becoded/go-harvest/timesheet"
+)
+
+func TestAssignmentChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	srv := harvesttest.NewServer()
+	t.Cleanup(srv.Close)
+
+	me := srv.CurrentUser()
+	other := srv.AddUser(&harvest.User{FirstName: harvest.String("Other")})
+
+	website := srv.AddProject(&harvest.Project{Name: harvest.String("Website")})
+	internal := srv.AddProject(&harvest.Project{Name: harvest.String("Internal")})
+	legacy := srv.AddProject(&harvest.Project{Name: harvest.String("Legacy"), IsActive: harvest.Bool(false)})
+
+	dev := srv.AddTask(&harvest.Task{Name: harvest.String("Development")})
+	design := srv.AddTask(&harvest.Task{Name: harvest.String("Design")})
+	meetings := srv.AddTask(&harvest.Task{Name: harvest.String("Meetings")})
+
+	srv.AssignTask(website.GetID(), dev.GetID())
+	srv.SetTaskAssignmentActive(srv.AssignTask(website.GetID(), design.GetID()).GetID(), false)
+	srv.AssignTask(internal.GetID(), meetings.GetID())
+	srv.AssignUser(website.GetID(), me.GetID())
+	srv.AssignUser(internal.GetID(), other.GetID())
+
+	tests := []struct {
+		name    string
+		data    *harvest.TimeEntryCreateViaDuration
+		wantErr error
+		wantMsg string
+	}{
+		{
+			name: "assigned",
+			data: &harvest.TimeEntryCreateViaDuration{ProjectID: website.ID, TaskID: dev.ID},
+		},
+		{
+			name:    "inactive task",
+			data:    &harvest.TimeEntryCreateViaDuration{ProjectID: website.ID, TaskID: design.ID},
+			wantErr: timesheet.ErrTaskInactive,
+			wantMsg: `task "Design" is archived on project "Website"`,
+		},
+		{
+			name:    "task not on project",
+			data:    &harvest.TimeEntryCreateViaDuration{ProjectID: website.ID, TaskID: meetings.ID},
+			wantErr: timesheet.ErrNotAssigned,
+		},
+		{
+			name:    "user not on project",
+			data:    &harvest.TimeEntryCreateViaDuration{ProjectID: internal.ID, TaskID: meetings.ID},
+			wantErr: timesheet.ErrNotAssigned,
+			wantMsg: fmt.Sprintf("user is not assigned to active project %d", internal.GetID()),
+		},
+		{
+			name:    "inactive project",
+			data:    &harvest.TimeEntryCreateViaDuration{ProjectID: legacy.ID, TaskID: dev.ID},
+			wantErr: timesheet.ErrNotAssigned,
+		},
+		{
+			name: "other user",
+			data: &harvest.TimeEntryCreateViaDuration{UserID: other.ID, ProjectID: internal.ID, TaskID: meetings.ID},
+		},
+	}
+
+	checker := timesheet.NewAssignmentChecker(srv.Client())
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := checker.CheckDuration(context.Background(), tt.data)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			var aerr *timesheet.AssignmentError
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.ErrorAs(t, err, &aerr)
+			assert.Equal(t, tt.data.GetProjectID(), aerr.ProjectID)
+
+			if tt.wantMsg != "" {
+				assert.EqualError(t, err, tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestAssignmentChecker_cache(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := harvesttest.NewServer()
+
+	defer srv.Close()
+
+	me := srv.CurrentUser()
+	website := srv.AddProject(&harvest.Project{Name: harvest.String("Website")})
+	internal := srv.AddProject(&harvest.Project{Name: harvest.String("Internal")})
+	dev := srv.AddTask(&harvest.Task{Name: harvest.String("Development")})
+
+	srv.AssignTask(website.GetID(), dev.GetID())
+	srv.AssignTask(internal.GetID(), dev.GetID())
+	srv.AssignUser(website.GetID(), me.GetID())
+
+	checker := timesheet.NewAssignmentChecker(srv.Client())
+	data := &harvest.TimeEntryCreateViaStartEndTime{ProjectID: website.ID, TaskID: dev.ID}
+
+	assert.NoError(t, checker.CheckStartEndTime(ctx, data))
+	assert.NoError(t, checker.CheckStartEndTime(ctx, data))
+	assert.Equal(t, 1, srv.RequestCount(), "assignments are cached")
+
+	srv.AssignUser(internal.GetID(), me.GetID())
+
+	data.ProjectID = internal.ID
+	assert.NoError(t, checker.CheckStartEndTime(ctx, data), "an unknown project refreshes the cache")
+	assert.Equal(t, 2, srv.RequestCount())
+
+	checker.Invalidate(0)
+	assert.NoError(t, checker.CheckStartEndTime(ctx, data))
+	assert.Equal(t, 3, srv.RequestCount())
+}
+
+func TestAssignmentChecker_notAssigned(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := harvesttest.NewServer()
+
+	defer srv.Close()
+
+	website := srv.AddProject(&harvest.Project{Name: harvest.String("Website")})
+	dev := srv.AddTask(&harvest.Task{Name: harvest.String("Development")})
+
+	// Only administrators can read projects.
+	client := srv.Client()
+	client.Use(func(next harvest.Doer) harvest.Doer {
+		return harvest.DoerFunc(func(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
+			if strings.Contains(req.URL.Path, "/projects/") {
+				resp := &http.Response{StatusCode: http.StatusForbidden, Request: req}
+
+				return resp, &harvest.ErrorResponse{Response: resp}
+			}
+
+			return next.Do(ctx, req, v)
+		})
+	})
+
+	checker := timesheet.NewAssignmentChecker(client)
+	data := &harvest.TimeEntryCreateViaDuration{ProjectID: website.ID, TaskID: dev.ID}
+
+	assert.ErrorIs(t, checker.CheckDuration(ctx, data), timesheet.ErrNotAssigned)
+	assert.ErrorIs(t, checker.CheckDuration(ctx, data), timesheet.ErrNotAssigned)
+	assert.Equal(t, 1, srv.RequestCount(), "a missing assignment is cached")
+}
@@ -0,0 +1,274 @@
+// Package timesheet builds views over time entries, such as the weekly grid
+// of a user's hours per project and task.
+package timesheet
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/becoded/go-harvest/harvest"
+)
+
+// DaysPerWeek is the number of columns of a Week.
+const DaysPerWeek = 7
+
+// DefaultTarget is the number of hours a workday is expected to have.
+const DefaultTarget harvest.Hours = 8
+
+// ErrWeekStartDay is returned for a Company.WeekStartDay that isn't a day of
+// the week.
+var ErrWeekStartDay = errors.New("unknown week start day")
+
+// A Week is a user's time entries for one week laid out as a grid: a row per
+// project and task, and a column per day.
+type Week struct {
+	UserID int64
+	// Start is the first day of the week, at midnight.
+	Start time.Time
+	Days  [DaysPerWeek]Day
+	// Rows are ordered by client, project and task name.
+	Rows  []*Row
+	Total harvest.Hours
+}
+
+// A Day is a column of a Week.
+type Day struct {
+	Date  time.Time
+	Total harvest.Hours
+	// UnderTarget is set for workdays with fewer hours than the target.
+	UnderTarget bool
+}
+
+// A Row holds the hours tracked on one project and task.
+type Row struct {
+	Client  *harvest.Client
+	Project *harvest.Project
+	Task    *harvest.Task
+	Hours   [DaysPerWeek]harvest.Hours
+	Total   harvest.Hours
+	Entries []*harvest.TimeEntry
+}
+
+// Builder fetches the data for Week views. Create one with NewBuilder.
+type Builder struct {
+	client   *harvest.APIClient
+	target   harvest.Hours
+	workdays map[time.Weekday]bool
+
+	mu        sync.Mutex
+	weekStart *time.Weekday
+}
+
+// Option configures a Builder.
+type Option func(*Builder)
+
+// WithTarget sets the hours a workday is expected to have. Defaults to
+// DefaultTarget.
+func WithTarget(hours harvest.Hours) Option {
+	return func(b *Builder) {
+		b.target = hours
+	}
+}
+
+// WithWorkdays sets the days that are checked against the target. Defaults
+// to Monday through Friday.
+func WithWorkdays(days ...time.Weekday) Option {
+	return func(b *Builder) {
+		b.workdays = map[time.Weekday]bool{}
+		for _, d := range days {
+			b.workdays[d] = true
+		}
+	}
+}
+
+// WithWeekStart sets the first day of the week instead of reading
+// Company.WeekStartDay.
+func WithWeekStart(day time.Weekday) Option {
+	return func(b *Builder) {
+		b.weekStart = &day
+	}
+}
+
+// NewBuilder returns a Builder that uses client.
+func NewBuilder(client *harvest.APIClient, opts ...Option) *Builder {
+	b := &Builder{
+		client: client,
+		target: DefaultTarget,
+		workdays: map[time.Weekday]bool{
+			time.Monday: true, time.Tuesday: true, time.Wednesday: true, time.Thursday: true, time.Friday: true,
+		},
+	}
+
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	return b
+}
+
+// Week returns the view of the week that contains day for the user with the
+// given ID. The first day of the week is read from the company settings once
+// and then reused.
+func (b *Builder) Week(ctx context.Context, userID int64, day time.Time) (*Week, error) {
+	startDay, err := b.startDay(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	start := WeekStart(day, startDay)
+	end := start.AddDate(0, 0, DaysPerWeek-1)
+
+	opt := &harvest.TimeEntryListOptions{
+		UserID:      harvest.Int64(userID),
+		From:        harvest.DateP(harvest.Date{Time: start}),
+		To:          harvest.DateP(harvest.Date{Time: end}),
+		ListOptions: harvest.ListOptions{Page: 1, PerPage: 100},
+	}
+
+	var entries []*harvest.TimeEntry
+
+	for {
+		list, _, err := b.client.Timesheet.List(ctx, opt)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, list.TimeEntries...)
+
+		if list.NextPage == nil {
+			break
+		}
+
+		opt.Page = *list.NextPage
+	}
+
+	w := NewWeek(start, entries, b.target, b.workdays)
+	w.UserID = userID
+
+	return w, nil
+}
+
+func (b *Builder) startDay(ctx context.Context) (time.Weekday, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.weekStart != nil {
+		return *b.weekStart, nil
+	}
+
+	company, _, err := b.client.Company.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	day, err := ParseWeekday(company.GetWeekStartDay())
+	if err != nil {
+		return 0, err
+	}
+
+	b.weekStart = &day
+
+	return day, nil
+}
+
+// NewWeek lays out entries in the week starting at start. Entries outside
+// the week are ignored. Days in workdays with fewer hours than target are
+// flagged.
+func NewWeek(start time.Time, entries []*harvest.TimeEntry, target harvest.Hours, workdays map[time.Weekday]bool) *Week {
+	start = midnight(start)
+	w := &Week{Start: start}
+
+	for i := range w.Days {
+		w.Days[i].Date = start.AddDate(0, 0, i)
+	}
+
+	type key struct{ project, task int64 }
+
+	rows := map[key]*Row{}
+
+	for _, e := range entries {
+		if e.SpentDate == nil {
+			continue
+		}
+
+		day := dayIndex(start, e.SpentDate.Time)
+		if day < 0 || day >= DaysPerWeek {
+			continue
+		}
+
+		k := key{e.GetProject().GetID(), e.GetTask().GetID()}
+
+		row, ok := rows[k]
+		if !ok {
+			row = &Row{Client: e.Client, Project: e.Project, Task: e.Task}
+			rows[k] = row
+			w.Rows = append(w.Rows, row)
+		}
+
+		h := e.GetHours()
+		row.Hours[day] += h
+		row.Total += h
+		row.Entries = append(row.Entries, e)
+		w.Days[day].Total += h
+		w.Total += h
+	}
+
+	for i := range w.Days {
+		d := &w.Days[i]
+		d.UnderTarget = workdays[d.Date.Weekday()] && d.Total < target
+	}
+
+	slices.SortStableFunc(w.Rows, func(a, b *Row) int {
+		return cmp.Or(
+			strings.Compare(a.Client.GetName(), b.Client.GetName()),
+			strings.Compare(a.Project.GetName(), b.Project.GetName()),
+			strings.Compare(a.Task.GetName(), b.Task.GetName()),
+		)
+	})
+
+	return w
+}
+
+// WeekStart returns midnight of the first day of the week containing day,
+// for weeks that start on startDay.
+func WeekStart(day time.Time, startDay time.Weekday) time.Time {
+	offset := (int(day.Weekday()) - int(startDay) + DaysPerWeek) % DaysPerWeek
+
+	return midnight(day).AddDate(0, 0, -offset)
+}
+
+// ParseWeekday parses a day name such as Company.WeekStartDay ("Monday").
+func ParseWeekday(s string) (time.Weekday, error) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(d.String(), s) {
+			return d, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %q", ErrWeekStartDay, s)
+}
+
+func midnight(t time.Time) time.Time {
+	y, m, d := t.Date()
+
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// dayIndex returns the number of calendar days from start to t. Dates are
+// compared by their calendar day, so daylight saving changes and the
+// location of t don't matter.
+func dayIndex(start, t time.Time) int {
+	y, m, d := t.Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+
+	sy, sm, sd := start.Date()
+	first := time.Date(sy, sm, sd, 0, 0, 0, 0, time.UTC)
+
+	return int(day.Sub(first).Hours()) / 24 //nolint: mnd
+}
@@ -0,0 +1,135 @@
+package timesheet_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/becoded/go-harvest/harvest"
+	"github.com/becoded/go-harvest/harvesttest"
+	"github.com/becoded/go-harvest/timesheet"
+)
+
+func date(s string) time.Time {
+	d, err := time.ParseInLocation("2006-01-02", s, time.Local)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+func TestBuilder_Week(t *testing.T) {
+	t.Parallel()
+
+	srv := harvesttest.NewServer()
+	defer srv.Close()
+
+	srv.SetCompany(&harvest.Company{WeekStartDay: harvest.String("Sunday")})
+
+	acme := srv.AddClient(&harvest.Client{Name: harvest.String("Acme")})
+	website := srv.AddProject(&harvest.Project{Client: acme, Name: harvest.String("Website")})
+	app := srv.AddProject(&harvest.Project{Client: acme, Name: harvest.String("App")})
+	dev := srv.AddTask(&harvest.Task{Name: harvest.String("Development")})
+	design := srv.AddTask(&harvest.Task{Name: harvest.String("Design")})
+
+	me := srv.CurrentUser()
+	other := srv.AddUser(&harvest.User{FirstName: harvest.String("Other")})
+
+	add := func(user *harvest.User, project *harvest.Project, task *harvest.Task, day string, hours harvest.Hours) {
+		srv.AddTimeEntry(&harvest.TimeEntry{
+			User:      user,
+			Client:    acme,
+			Project:   project,
+			Task:      task,
+			SpentDate: &harvest.Date{Time: date(day)},
+			Hours:     harvest.HoursP(hours),
+		})
+	}
+
+	// The week of Wednesday 2024-03-06 starts on Sunday 2024-03-03.
+	add(me, website, dev, "2024-03-04", 6)
+	add(me, website, dev, "2024-03-04", 2)
+	add(me, website, design, "2024-03-05", 3)
+	add(me, app, dev, "2024-03-05", 4)
+	add(me, website, dev, "2024-03-09", 1)
+	add(me, website, dev, "2024-03-10", 5) // next week
+	add(other, app, dev, "2024-03-06", 8)  // another user
+	add(me, website, dev, "2024-03-02", 8) // previous week
+
+	b := timesheet.NewBuilder(srv.Client(), timesheet.WithTarget(7))
+
+	w, err := b.Week(context.Background(), me.GetID(), date("2024-03-06"))
+	assert.NoError(t, err)
+
+	assert.Equal(t, me.GetID(), w.UserID)
+	assert.Equal(t, date("2024-03-03"), w.Start)
+	assert.Equal(t, harvest.Hours(16), w.Total)
+
+	var totals [timesheet.DaysPerWeek]harvest.Hours
+
+	var under []time.Weekday
+
+	for i, d := range w.Days {
+		totals[i] = d.Total
+		if d.UnderTarget {
+			under = append(under, d.Date.Weekday())
+		}
+	}
+
+	assert.Equal(t, [timesheet.DaysPerWeek]harvest.Hours{0, 8, 7, 0, 0, 0, 1}, totals)
+	assert.Equal(t, []time.Weekday{time.Wednesday, time.Thursday, time.Friday}, under)
+
+	assert.Len(t, w.Rows, 3)
+
+	rows := make([]string, len(w.Rows))
+	for i, r := range w.Rows {
+		rows[i] = r.Project.GetName() + "/" + r.Task.GetName()
+	}
+
+	assert.Equal(t, []string{"App/Development", "Website/Design", "Website/Development"}, rows)
+
+	websiteDev := w.Rows[2]
+	assert.Equal(t, [timesheet.DaysPerWeek]harvest.Hours{0, 8, 0, 0, 0, 0, 1}, websiteDev.Hours)
+	assert.Equal(t, harvest.Hours(9), websiteDev.Total)
+	assert.Len(t, websiteDev.Entries, 3)
+}
+
+func TestWeekStart(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		day      string
+		startDay time.Weekday
+		want     string
+	}{
+		{name: "monday week", day: "2024-03-06", startDay: time.Monday, want: "2024-03-04"},
+		{name: "sunday week", day: "2024-03-06", startDay: time.Sunday, want: "2024-03-03"},
+		{name: "saturday week", day: "2024-03-06", startDay: time.Saturday, want: "2024-03-02"},
+		{name: "first day", day: "2024-03-04", startDay: time.Monday, want: "2024-03-04"},
+		{name: "last day", day: "2024-03-10", startDay: time.Monday, want: "2024-03-04"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			day := date(tt.day).Add(15 * time.Hour)
+			assert.Equal(t, date(tt.want), timesheet.WeekStart(day, tt.startDay))
+		})
+	}
+}
+
+func TestParseWeekday(t *testing.T) {
+	t.Parallel()
+
+	day, err := timesheet.ParseWeekday("Saturday")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Saturday, day)
+
+	_, err = timesheet.ParseWeekday("Someday")
+	assert.ErrorIs(t, err, timesheet.ErrWeekStartDay)
+}
@@ -0,0 +1,186 @@
+package watch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/becoded/go-harvest/harvest"
+	"github.com/becoded/go-harvest/sync"
+)
+
+// EventType is the kind of change an Event reports.
+type EventType string
+
+// The types of events.
+const (
+	Created EventType = "created"
+	Updated EventType = "updated"
+	Deleted EventType = "deleted"
+	// InvoiceStateChanged follows the Updated event of an invoice whose
+	// state changed, such as from "draft" to "open".
+	InvoiceStateChanged EventType = "invoice.state_changed"
+)
+
+// An Event is a change to a record of a watched resource.
+type Event struct {
+	Type EventType `json:"type"`
+	// Resource is the name of the resource, such as "time_entries".
+	Resource string `json:"resource"`
+	ID       int64  `json:"id"`
+	// Changed lists the JSON names of the fields that changed, sorted, for
+	// Updated events.
+	Changed []string `json:"changed,omitempty"`
+	// FromState and ToState are set for InvoiceStateChanged events.
+	FromState string `json:"from_state,omitempty"`
+	ToState   string `json:"to_state,omitempty"`
+	// Value is the record, such as a *harvest.Invoice. For Deleted events it
+	// is the last version seen.
+	Value interface{} `json:"data"`
+	// Previous is the version of the record before an update.
+	Previous interface{} `json:"previous,omitempty"`
+	// DetectedAt is when the poller noticed the change.
+	DetectedAt time.Time `json:"detected_at"`
+}
+
+// A Handler receives the events of a Poller.
+type Handler interface {
+	HandleEvent(ctx context.Context, e Event) error
+}
+
+// HandlerFunc adapts a function to a Handler.
+type HandlerFunc func(ctx context.Context, e Event) error
+
+// HandleEvent calls f(ctx, e).
+func (f HandlerFunc) HandleEvent(ctx context.Context, e Event) error {
+	return f(ctx, e)
+}
+
+// snapshot is the last known version of the records of one resource. It is
+// the sync.Store the poller syncs into, and turns the upserts into events.
+type snapshot struct {
+	records map[int64]sync.Record
+	state   sync.State
+	// ready is set once the first sync has completed. The records of the
+	// first sync are the baseline and don't produce events.
+	ready   bool
+	pending []Event
+	now     func() time.Time
+}
+
+func newSnapshot(now func() time.Time) *snapshot {
+	return &snapshot{records: map[int64]sync.Record{}, now: now}
+}
+
+func (s *snapshot) Upsert(_ context.Context, resource string, records []sync.Record) error {
+	for _, rec := range records {
+		prev, ok := s.records[rec.ID]
+		s.records[rec.ID] = rec
+
+		if !ok {
+			s.emit(Event{Type: Created, Resource: resource, ID: rec.ID, Value: rec.Value})
+
+			continue
+		}
+
+		changed, err := diff(prev.Value, rec.Value)
+		if err != nil {
+			return err
+		}
+
+		// Records updated during the previous poll are fetched again.
+		if len(changed) == 0 {
+			continue
+		}
+
+		s.emit(Event{Type: Updated, Resource: resource, ID: rec.ID, Changed: changed, Value: rec.Value, Previous: prev.Value})
+
+		before, _ := prev.Value.(*harvest.Invoice)
+		after, _ := rec.Value.(*harvest.Invoice)
+
+		if before != nil && after != nil && before.GetState() != after.GetState() {
+			s.emit(Event{
+				Type:      InvoiceStateChanged,
+				Resource:  resource,
+				ID:        rec.ID,
+				FromState: before.GetState(),
+				ToState:   after.GetState(),
+				Value:     rec.Value,
+				Previous:  prev.Value,
+			})
+		}
+	}
+
+	return nil
+}
+
+func (s *snapshot) State(context.Context, string) (sync.State, error) {
+	return s.state, nil
+}
+
+func (s *snapshot) SetState(_ context.Context, _ string, state sync.State) error {
+	s.state = state
+
+	return nil
+}
+
+func (s *snapshot) emit(e Event) {
+	e.DetectedAt = s.now()
+	s.pending = append(s.pending, e)
+}
+
+// take returns and clears the pending events.
+func (s *snapshot) take() []Event {
+	events := s.pending
+	s.pending = nil
+
+	if !s.ready {
+		return nil
+	}
+
+	return events
+}
+
+// diff returns the JSON names of the fields that differ between a and b,
+// ignoring updated_at.
+func diff(a, b interface{}) ([]string, error) {
+	var fa, fb map[string]json.RawMessage
+
+	if err := remarshal(a, &fa); err != nil {
+		return nil, err
+	}
+
+	if err := remarshal(b, &fb); err != nil {
+		return nil, err
+	}
+
+	var changed []string
+
+	for k, v := range fb {
+		if old, ok := fa[k]; !ok || !bytes.Equal(old, v) {
+			changed = append(changed, k)
+		}
+	}
+
+	for k := range fa {
+		if _, ok := fb[k]; !ok {
+			changed = append(changed, k)
+		}
+	}
+
+	changed = slices.DeleteFunc(changed, func(k string) bool { return k == "updated_at" })
+	slices.Sort(changed)
+
+	return changed, nil
+}
+
+func remarshal(v interface{}, fields *map[string]json.RawMessage) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, fields)
+}
@@ -0,0 +1,372 @@
+// Package watch turns polling into a stream of change events, standing in
+// for the webhooks that Harvest API v2 doesn't have.
+//
+// A Poller syncs the watched resources with UpdatedSince at an interval,
+// compares the records with the versions it saw before, and reports what
+// changed as events: created, updated (with the names of the changed
+// fields), deleted, and invoice state transitions:
+//
+//	p := watch.New([]sync.Resource{sync.TimeEntries(service), sync.Invoices(service)},
+//		watch.WithInterval(30*time.Second))
+//
+//	for e := range p.Channel(ctx) {
+//		if e.Type == watch.InvoiceStateChanged {
+//			log.Printf("invoice %d is now %s", e.ID, e.ToState)
+//		}
+//	}
+//
+// The first poll only records the current state of the resources; events
+// are reported from the second poll on. UpdatedSince doesn't report
+// deletions, so every few polls the resources are listed again to find the
+// records that are gone. Time entries and expenses are only listed for the
+// recent spent dates of the deletion window; other resources are listed in
+// full, at one request per 2000 records.
+package watch
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/becoded/go-harvest/harvest"
+	"github.com/becoded/go-harvest/sync"
+)
+
+// DefaultInterval is the time between polls.
+const DefaultInterval = time.Minute
+
+// DefaultDeletionScan is the number of polls between the listings that find
+// deleted records.
+const DefaultDeletionScan = 10
+
+// DefaultDeletionWindow is how far back the spent dates of the time entries
+// and expenses checked for deletion go.
+const DefaultDeletionWindow = 31 * 24 * time.Hour
+
+// Poller polls resources for changes. Create one with New.
+type Poller struct {
+	resources  []sync.Resource
+	interval   time.Duration
+	rateLimit  int
+	rateWindow time.Duration
+	scanEvery  int
+	scanWindow time.Duration
+	logger     *slog.Logger
+	now        func() time.Time
+
+	snapshots map[string]*snapshot
+	polls     int
+	requests  int
+}
+
+// Option configures a Poller.
+type Option func(*Poller)
+
+// WithInterval sets the time between polls. Defaults to DefaultInterval.
+func WithInterval(d time.Duration) Option {
+	return func(p *Poller) {
+		p.interval = d
+	}
+}
+
+// WithRateLimit sets the request quota the poller stays within: polls that
+// take many requests are spaced out further than the interval. Defaults to
+// Harvest's quota; lower it when other clients share the same account.
+func WithRateLimit(requests int, window time.Duration) Option {
+	return func(p *Poller) {
+		p.rateLimit = requests
+		p.rateWindow = window
+	}
+}
+
+// WithDeletionScan sets the number of polls between the listings that find
+// deleted records. 0 disables them, and with them Deleted events. Defaults
+// to DefaultDeletionScan.
+func WithDeletionScan(every int) Option {
+	return func(p *Poller) {
+		p.scanEvery = every
+	}
+}
+
+// WithDeletionWindow sets how far back the spent dates of the time entries
+// and expenses checked for deletion go. Deleting older ones isn't reported.
+// 0 lists them in full, which for a long-lived account takes a request per
+// 2000 records on every scan. Defaults to DefaultDeletionWindow.
+func WithDeletionWindow(d time.Duration) Option {
+	return func(p *Poller) {
+		p.scanWindow = d
+	}
+}
+
+// WithLogger sets the logger that Run reports failed polls and handlers to.
+func WithLogger(logger *slog.Logger) Option {
+	return func(p *Poller) {
+		p.logger = logger
+	}
+}
+
+// New returns a Poller that watches resources, such as those returned by
+// sync.TimeEntries and sync.Invoices.
+func New(resources []sync.Resource, opts ...Option) *Poller {
+	p := &Poller{
+		resources:  resources,
+		interval:   DefaultInterval,
+		rateLimit:  harvest.DefaultRateLimit,
+		rateWindow: harvest.DefaultRateWindow,
+		scanEvery:  DefaultDeletionScan,
+		scanWindow: DefaultDeletionWindow,
+		logger:     slog.New(slog.DiscardHandler),
+		now:        time.Now,
+		snapshots:  map[string]*snapshot{},
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+// Run polls until ctx is done and passes the events to h, in the order they
+// were detected. Failed polls and handlers are logged and don't stop Run;
+// polls that were rate limited wait for as long as Harvest asks. Run returns
+// the context's error. A Poller must not be run more than once at a time.
+func (p *Poller) Run(ctx context.Context, h Handler) error {
+	for {
+		p.requests = 0
+
+		events, err := p.Poll(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		for _, e := range events {
+			if err := h.HandleEvent(ctx, e); err != nil {
+				p.logger.WarnContext(ctx, "watch: handler failed",
+					"type", e.Type, "resource", e.Resource, "id", e.ID, "error", err)
+			}
+		}
+
+		wait := p.interval
+		if p.rateLimit > 0 {
+			wait = max(wait, time.Duration(p.requests)*p.rateWindow/time.Duration(p.rateLimit))
+		}
+
+		if err != nil {
+			var rerr *harvest.AbuseRateLimitError
+			if errors.As(err, &rerr) && rerr.RetryAfter != nil {
+				wait = max(wait, *rerr.RetryAfter)
+			}
+
+			p.logger.WarnContext(ctx, "watch: poll failed", "error", err, "retry_in", wait)
+		}
+
+		timer := time.NewTimer(wait)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Channel runs the poller in a goroutine until ctx is done and returns the
+// channel it sends the events on. The channel is closed when the poller
+// stops.
+func (p *Poller) Channel(ctx context.Context) <-chan Event {
+	ch := make(chan Event)
+
+	go func() {
+		defer close(ch)
+
+		_ = p.Run(ctx, HandlerFunc(func(ctx context.Context, e Event) error {
+			select {
+			case ch <- e:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}))
+	}()
+
+	return ch
+}
+
+// Poll polls every resource once and returns the events found. It stops at
+// the first error and returns the events found so far; the changes it didn't
+// get to are found by the next poll.
+func (p *Poller) Poll(ctx context.Context) ([]Event, error) {
+	p.polls++
+
+	var events []Event
+
+	for _, r := range p.resources {
+		evs, err := p.poll(ctx, r)
+		events = append(events, evs...)
+
+		if err != nil {
+			return events, err
+		}
+	}
+
+	return events, nil
+}
+
+func (p *Poller) poll(ctx context.Context, r sync.Resource) ([]Event, error) {
+	snap, ok := p.snapshots[r.Name]
+	if !ok {
+		snap = newSnapshot(p.now)
+		p.snapshots[r.Name] = snap
+	}
+
+	list := r.List
+	r.List = func(ctx context.Context, since time.Time, page int) (*sync.Page, error) {
+		p.requests++
+
+		return list(ctx, since, page)
+	}
+
+	if window := r.Window; window != nil {
+		r.Window = func(ctx context.Context, from, to time.Time, page int) (*sync.Page, error) {
+			p.requests++
+
+			return window(ctx, from, to, page)
+		}
+	}
+
+	if exists := r.Exists; exists != nil {
+		r.Exists = func(ctx context.Context, id int64) (bool, error) {
+			p.requests++
+
+			return exists(ctx, id)
+		}
+	}
+
+	if _, err := sync.New(snap).Sync(ctx, r); err != nil {
+		return nil, err
+	}
+
+	events := snap.take()
+
+	if !snap.ready {
+		snap.ready = true
+
+		return nil, nil
+	}
+
+	if p.scanEvery > 0 && p.polls%p.scanEvery == 0 {
+		deleted, err := p.scan(ctx, r, snap)
+		if err != nil {
+			return events, err
+		}
+
+		events = append(events, deleted...)
+	}
+
+	return events, nil
+}
+
+// scan finds the records in snap that are gone and reports them.
+func (p *Poller) scan(ctx context.Context, r sync.Resource, snap *snapshot) ([]Event, error) {
+	var (
+		gone []int64
+		err  error
+	)
+
+	if p.scanWindow > 0 && r.Window != nil && r.Exists != nil {
+		gone, err = p.goneFromWindow(ctx, r, snap)
+	} else {
+		gone, err = goneFromList(ctx, r, snap)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]Event, 0, len(gone))
+
+	for _, id := range gone {
+		rec := snap.records[id]
+		delete(snap.records, id)
+		events = append(events, Event{Type: Deleted, Resource: r.Name, ID: id, Value: rec.Value, DetectedAt: p.now()})
+	}
+
+	slices.SortFunc(events, func(a, b Event) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return events, nil
+}
+
+// goneFromList lists all records of the resource and returns the IDs of the
+// ones in snap that weren't listed.
+func goneFromList(ctx context.Context, r sync.Resource, snap *snapshot) ([]int64, error) {
+	listed := map[int64]bool{}
+
+	for page := 1; ; {
+		pg, err := r.List(ctx, time.Time{}, page)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rec := range pg.Records {
+			listed[rec.ID] = true
+		}
+
+		if pg.NextPage == nil {
+			break
+		}
+
+		page = *pg.NextPage
+	}
+
+	var gone []int64
+
+	for id := range snap.records {
+		if !listed[id] {
+			gone = append(gone, id)
+		}
+	}
+
+	return gone, nil
+}
+
+// goneFromWindow reconciles the records in snap with a spent date in the
+// deletion window and returns the IDs of the deleted ones.
+func (p *Poller) goneFromWindow(ctx context.Context, r sync.Resource, snap *snapshot) ([]int64, error) {
+	to := p.now()
+	from := to.Add(-p.scanWindow)
+
+	first, last := from.Format(time.DateOnly), to.Format(time.DateOnly)
+
+	var known []int64
+
+	for id, rec := range snap.records {
+		spent, ok := rec.Value.(interface{ GetSpentDate() harvest.Date })
+		if !ok {
+			continue
+		}
+
+		if d := spent.GetSpentDate().Format(time.DateOnly); d >= first && d <= last {
+			known = append(known, id)
+		}
+	}
+
+	tombstones, err := sync.New(snap).Reconcile(ctx, r, from, to, known)
+	if err != nil {
+		return nil, err
+	}
+
+	gone := make([]int64, len(tombstones))
+	for i, t := range tombstones {
+		gone[i] = t.ID
+	}
+
+	return gone, nil
+}
@@ -0,0 +1,165 @@
+package watch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/becoded/go-harvest/harvest"
+	"github.com/becoded/go-harvest/harvesttest"
+	"github.com/becoded/go-harvest/sync"
+	"github.com/becoded/go-harvest/watch"
+)
+
+func TestPoller_Poll(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := harvesttest.NewServer()
+
+	defer srv.Close()
+
+	// Records are stamped by the server's clock, the high-water mark by the
+	// Date header, so changes after the first poll are made in the future.
+	srv.SetClock(func() time.Time { return time.Now().Add(-time.Hour) })
+
+	client := srv.Client()
+	acme := srv.AddClient(&harvest.Client{Name: harvest.String("Acme")})
+	initech := srv.AddClient(&harvest.Client{Name: harvest.String("Initech")})
+	project := srv.AddProject(&harvest.Project{Client: acme, Name: harvest.String("Website")})
+
+	invoice, _, err := client.Invoice.Create(ctx, &harvest.InvoiceCreateRequest{
+		ClientID: acme.ID,
+		LineItems: &[]harvest.InvoiceLineItemRequest{{
+			ProjectID: project.ID,
+			Kind:      harvest.String("Service"),
+			UnitPrice: harvest.Float64(100),
+		}},
+	})
+	assert.NoError(t, err)
+
+	p := watch.New([]sync.Resource{sync.Clients(client), sync.Invoices(client)}, watch.WithDeletionScan(1))
+
+	events, err := p.Poll(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, events, "the first poll is the baseline")
+
+	srv.SetClock(func() time.Time { return time.Now().Add(time.Hour) })
+
+	globex := srv.AddClient(&harvest.Client{Name: harvest.String("Globex")})
+
+	_, _, err = client.Client.Update(ctx, acme.GetID(), &harvest.ClientUpdateRequest{Name: harvest.String("Acme Inc")})
+	assert.NoError(t, err)
+
+	_, err = client.Client.Delete(ctx, initech.GetID())
+	assert.NoError(t, err)
+
+	_, _, err = client.Invoice.MarkAsSent(ctx, invoice.GetID())
+	assert.NoError(t, err)
+
+	events, err = p.Poll(ctx)
+	assert.NoError(t, err)
+
+	got := map[string]watch.Event{}
+	for _, e := range events {
+		got[fmt.Sprintf("%s %s %d", e.Type, e.Resource, e.ID)] = e
+		assert.WithinDuration(t, time.Now(), e.DetectedAt, time.Second)
+	}
+
+	assert.Len(t, events, 5)
+
+	created := got[fmt.Sprintf("created clients %d", globex.GetID())]
+	assert.Equal(t, "Globex", created.Value.(*harvest.Client).GetName())
+
+	updated := got[fmt.Sprintf("updated clients %d", acme.GetID())]
+	assert.Equal(t, []string{"name"}, updated.Changed)
+	assert.Equal(t, "Acme", updated.Previous.(*harvest.Client).GetName())
+	assert.Equal(t, "Acme Inc", updated.Value.(*harvest.Client).GetName())
+
+	deleted := got[fmt.Sprintf("deleted clients %d", initech.GetID())]
+	assert.Equal(t, "Initech", deleted.Value.(*harvest.Client).GetName())
+
+	updated = got[fmt.Sprintf("updated invoices %d", invoice.GetID())]
+	assert.Equal(t, []string{"sent_at", "state"}, updated.Changed)
+
+	transition := got[fmt.Sprintf("invoice.state_changed invoices %d", invoice.GetID())]
+	assert.Equal(t, harvesttest.InvoiceStateDraft, transition.FromState)
+	assert.Equal(t, harvesttest.InvoiceStateOpen, transition.ToState)
+
+	events, err = p.Poll(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, events, "records fetched again without changes produce no events")
+}
+
+func TestPoller_Poll_deletionWindow(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := harvesttest.NewServer()
+
+	defer srv.Close()
+
+	spent := func(days int) *harvest.Date {
+		return harvest.DateP(harvest.Date{Time: time.Now().UTC().AddDate(0, 0, -days)})
+	}
+
+	recent := srv.AddTimeEntry(&harvest.TimeEntry{SpentDate: spent(2), Hours: harvest.HoursP(1)})
+	old := srv.AddTimeEntry(&harvest.TimeEntry{SpentDate: spent(90), Hours: harvest.HoursP(1)})
+
+	p := watch.New([]sync.Resource{sync.TimeEntries(srv.Client())},
+		watch.WithDeletionScan(1), watch.WithDeletionWindow(30*24*time.Hour))
+
+	events, err := p.Poll(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, events)
+
+	srv.DeleteTimeEntry(recent.GetID())
+	srv.DeleteTimeEntry(old.GetID())
+
+	events, err = p.Poll(ctx)
+	assert.NoError(t, err)
+
+	if assert.Len(t, events, 1, "deleting entries older than the window isn't reported") {
+		assert.Equal(t, watch.Deleted, events[0].Type)
+		assert.Equal(t, recent.GetID(), events[0].ID)
+	}
+}
+
+func TestPoller_Channel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	srv := harvesttest.NewServer()
+
+	defer srv.Close()
+
+	srv.SetClock(func() time.Time { return time.Now().Add(-time.Hour) })
+	srv.AddClient(&harvest.Client{Name: harvest.String("Acme")})
+
+	p := watch.New([]sync.Resource{sync.Clients(srv.Client())}, watch.WithInterval(10*time.Millisecond))
+
+	_, err := p.Poll(ctx)
+	assert.NoError(t, err)
+
+	srv.SetClock(func() time.Time { return time.Now().Add(time.Hour) })
+	globex := srv.AddClient(&harvest.Client{Name: harvest.String("Globex")})
+
+	ch := p.Channel(ctx)
+
+	select {
+	case e := <-ch:
+		assert.Equal(t, watch.Created, e.Type)
+		assert.Equal(t, globex.GetID(), e.ID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event")
+	}
+
+	cancel()
+
+	for range ch {
+		t.Error("unexpected event")
+	}
+}